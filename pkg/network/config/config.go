@@ -252,6 +252,10 @@ type Config struct {
 	// HTTP2DynamicTableMapCleanerInterval is the interval to run the cleaner function.
 	HTTP2DynamicTableMapCleanerInterval time.Duration
 
+	// HTTP2MaxDynamicTableSize is the upper bound, in bytes, of the HPACK dynamic table honored per connection,
+	// whatever the size advertised by the peers.
+	HTTP2MaxDynamicTableSize uint32
+
 	// HTTPMapCleanerInterval is the interval to run the cleaner function.
 	HTTPMapCleanerInterval time.Duration
 
@@ -377,6 +381,7 @@ func New() *Config {
 		EnableRootNetNs: cfg.GetBool(sysconfig.FullKeyPath(netNS, "enable_root_netns")),
 
 		HTTP2DynamicTableMapCleanerInterval: time.Duration(cfg.GetInt(sysconfig.FullKeyPath(smNS, "http2_dynamic_table_map_cleaner_interval_seconds"))) * time.Second,
+		HTTP2MaxDynamicTableSize:            uint32(cfg.GetInt(sysconfig.FullKeyPath(smNS, "http2_max_dynamic_table_size"))),
 
 		HTTPMapCleanerInterval: time.Duration(cfg.GetInt(sysconfig.FullKeyPath(smNS, "http_map_cleaner_interval_in_s"))) * time.Second,
 		HTTPIdleConnectionTTL:  time.Duration(cfg.GetInt(sysconfig.FullKeyPath(smNS, "http_idle_connection_ttl_in_s"))) * time.Second,
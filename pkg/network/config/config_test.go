@@ -628,6 +628,32 @@ func TestHTTP2DynamicTableMapCleanerInterval(t *testing.T) {
 	})
 }
 
+func TestHTTP2MaxDynamicTableSize(t *testing.T) {
+	t.Run("via YAML", func(t *testing.T) {
+		mockSystemProbe := mock.NewSystemProbe(t)
+		mockSystemProbe.SetWithoutSource("service_monitoring_config.http2_max_dynamic_table_size", 1024)
+		cfg := New()
+
+		require.Equal(t, cfg.HTTP2MaxDynamicTableSize, uint32(1024))
+	})
+
+	t.Run("via ENV variable", func(t *testing.T) {
+		mock.NewSystemProbe(t)
+		t.Setenv("DD_SERVICE_MONITORING_CONFIG_HTTP2_MAX_DYNAMIC_TABLE_SIZE", "1024")
+		cfg := New()
+
+		require.Equal(t, cfg.HTTP2MaxDynamicTableSize, uint32(1024))
+	})
+
+	t.Run("Not enabled", func(t *testing.T) {
+		mock.NewSystemProbe(t)
+		cfg := New()
+
+		// Default value.
+		require.Equal(t, cfg.HTTP2MaxDynamicTableSize, uint32(4096))
+	})
+}
+
 func TestHTTPMapCleanerInterval(t *testing.T) {
 	t.Run("via deprecated YAML", func(t *testing.T) {
 		mockSystemProbe := mock.NewSystemProbe(t)
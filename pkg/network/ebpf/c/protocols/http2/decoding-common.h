@@ -22,6 +22,11 @@ static __always_inline bool is_method_index(const __u64 index) {
     return index == kGET || index == kPOST;
 }
 
+// Returns true if the given index represents a scheme index.
+static __always_inline bool is_scheme_index(const __u64 index) {
+    return index == kHTTPScheme || index == kHTTPSScheme;
+}
+
 // Returns true if the given index represents a status index.
 static __always_inline bool is_status_index(const __u64 index) {
     return k200 <= index && index <= k500;
@@ -30,7 +35,7 @@ static __always_inline bool is_status_index(const __u64 index) {
 // returns true if the given index is one of the relevant headers we care for in the static table.
 // The full table can be found in the user mode code `createStaticTable`.
 static __always_inline bool is_interesting_static_entry(const __u64 index) {
-    return (1 < index && index < 6) || (7 < index && index < 15);
+    return 1 < index && index < 15;
 }
 
 // returns true if the given index is below MAX_STATIC_TABLE_INDEX.
@@ -38,6 +43,12 @@ static __always_inline bool is_static_table_entry(const __u64 index) {
     return index <= MAX_STATIC_TABLE_INDEX;
 }
 
+// Returns true if the given frame type holds a fragment of a header block, a HEADERS frame being followed by
+// CONTINUATION frames until the END_HEADERS flag. See RFC 7540 section 4.3.
+static __always_inline bool is_header_block_frame(const frame_type_t type) {
+    return type == kHeadersFrame || type == kContinuationFrame;
+}
+
 // http2_fetch_stream returns the current http2 in flight stream.
 static __always_inline http2_stream_t *http2_fetch_stream(const http2_stream_key_t *http2_stream_key) {
     http2_stream_t *http2_stream_ptr = bpf_map_lookup_elem(&http2_in_flight, http2_stream_key);
@@ -52,10 +63,21 @@ static __always_inline http2_stream_t *http2_fetch_stream(const http2_stream_key
     }
     bpf_memset(http2_stream_ptr, 0, sizeof(http2_stream_t));
     http2_stream_ptr->request_started = bpf_ktime_get_ns();
+    // streams are idle until their headers are processed.
+    http2_stream_ptr->state = kStreamStateIdle;
     bpf_map_update_elem(&http2_in_flight, http2_stream_key, http2_stream_ptr, BPF_NOEXIST);
     return bpf_map_lookup_elem(&http2_in_flight, http2_stream_key);
 }
 
+// http2_max_dynamic_table_entries returns the maximum number of entries of the dynamic table of a connection.
+// The size of an entry being the size of its name and value plus 32 bytes (RFC 7541 section 4.1), a dynamic table
+// bounded to the configured size can't hold more entries, whatever the size advertised by the peers.
+static __always_inline __u64 http2_max_dynamic_table_entries() {
+    __u64 val = 0;
+    LOAD_CONSTANT("http2_max_dynamic_table_entries", val);
+    return val;
+}
+
 // get_dynamic_counter returns the current dynamic counter by the conn tuple.
 static __always_inline __u64 *get_dynamic_counter(conn_tuple_t *tup) {
     dynamic_counter_t empty = {0};
@@ -83,13 +105,15 @@ static __always_inline void parse_field_indexed(dynamic_table_index_t *dynamic_i
 
     // We change the index to match our internal dynamic table implementation index.
     // Our internal indexes start from 1, so we subtract 61 in order to match the given index.
-    dynamic_index->index = global_dynamic_counter - (index - MAX_STATIC_TABLE_INDEX);
+    const __u64 relative_index = index - MAX_STATIC_TABLE_INDEX;
+    dynamic_index->index = global_dynamic_counter - relative_index;
 
     headers_to_process->index = dynamic_index->index;
     headers_to_process->type = kExistingDynamicHeader;
     // If the entry exists, increase the counter. If the entry is missing, then we won't increase the counter.
     // This is a simple trick to spare if-clause, to reduce pressure on the complexity of the program.
-    *interesting_headers_counter += bpf_map_lookup_elem(&http2_dynamic_table, dynamic_index) != NULL;
+    // An entry above the maximum number of entries of the dynamic table is evicted, even if not cleaned up yet.
+    *interesting_headers_counter += relative_index <= http2_max_dynamic_table_entries() && bpf_map_lookup_elem(&http2_dynamic_table, dynamic_index) != NULL;
     return;
 }
 
@@ -115,6 +139,24 @@ static __always_inline void update_path_size_telemetry(http2_telemetry_t *http2_
     __sync_fetch_and_add(&http2_tel->path_size_bucket[bucket_idx], 1);
 }
 
+// enqueue_closed_stream closes the given stream, sends it to the user mode and removes it from the in-flight streams.
+static __always_inline void enqueue_closed_stream(http2_stream_t *current_stream, http2_stream_key_t *http2_stream_key_template) {
+    current_stream->response_last_seen = bpf_ktime_get_ns();
+    current_stream->state = kStreamStateClosed;
+
+    const __u32 zero = 0;
+    http2_event_t *event = bpf_map_lookup_elem(&http2_scratch_buffer, &zero);
+    if (event) {
+        event->tuple = http2_stream_key_template->tup;
+        event->stream = *current_stream;
+        event->stream_id = http2_stream_key_template->stream_id;
+        // enqueue
+        http2_batch_enqueue(event);
+    }
+
+    bpf_map_delete_elem(&http2_in_flight, http2_stream_key_template);
+}
+
 // handle_end_of_stream is called when we see a HTTP2 END_STREAM (EOS) flag in
 // a frame. When a stream is considered as ended, we can enqueue the stream's
 // in-flight data for batch processing.
@@ -123,27 +165,23 @@ static __always_inline void update_path_size_telemetry(http2_telemetry_t *http2_
 // sides must send an EOS, so this function should be called twice for each
 // stream, before it actually enqueues the stream's stats.
 //
+// The first EOS half-closes the stream on the side which sent it, is_response being true when it came from the
+// server, and the second one closes it.
+//
 // See RFC 7540 section 5.1: https://datatracker.ietf.org/doc/html/rfc7540#section-5.1
-static __always_inline void handle_end_of_stream(http2_stream_t *current_stream, http2_stream_key_t *http2_stream_key_template, http2_telemetry_t *http2_tel) {
+static __always_inline void handle_end_of_stream(http2_stream_t *current_stream, http2_stream_key_t *http2_stream_key_template, bool is_response, http2_telemetry_t *http2_tel) {
     // We want to see the EOS twice for a given stream: one for the client, one for the server.
     if (!current_stream->end_of_stream_seen) {
         current_stream->end_of_stream_seen = true;
+        // a stream reset by an RST_STREAM frame is already closed.
+        if (current_stream->state != kStreamStateClosed) {
+            current_stream->state = is_response ? kStreamStateHalfClosedRemote : kStreamStateHalfClosedLocal;
+        }
         return;
     }
 
     // response end of stream;
-    current_stream->response_last_seen = bpf_ktime_get_ns();
-
-    const __u32 zero = 0;
-    http2_event_t *event = bpf_map_lookup_elem(&http2_scratch_buffer, &zero);
-    if (event) {
-        event->tuple = http2_stream_key_template->tup;
-        event->stream = *current_stream;
-        // enqueue
-        http2_batch_enqueue(event);
-    }
-
-    bpf_map_delete_elem(&http2_in_flight, http2_stream_key_template);
+    enqueue_closed_stream(current_stream, http2_stream_key_template);
 }
 
 // A similar implementation of read_http2_frame_header, but instead of getting both a char array and an out parameter,
@@ -18,6 +18,9 @@
 // Currently we have up to 240 frames in a packet, thus 15 (15*16 = 240) tail calls is enough.
 #define HTTP2_MAX_TAIL_CALLS_FOR_HEADERS_PARSER 15
 #define HTTP2_MAX_FRAMES_FOR_HEADERS_PARSER (HTTP2_MAX_FRAMES_FOR_HEADERS_PARSER_PER_TAIL_CALL * HTTP2_MAX_TAIL_CALLS_FOR_HEADERS_PARSER)
+// Represents the maximum number of in-flight streams above the last stream id of a GOAWAY frame we'll abort when
+// handling the frame. The streams beyond that window are aborted by the user mode when flushing the in-flight map.
+#define HTTP2_MAX_STREAMS_ABORTED_BY_GOAWAY 16
 // Maximum number of frames to be processed in a single tail call.
 #define HTTP2_MAX_FRAMES_ITERATIONS 240
 // This represents a limit on the number of tail calls that can be executed
@@ -39,18 +42,15 @@
 // A limit of max pseudo headers which we process in the request/response.
 #define HTTP2_MAX_PSEUDO_HEADERS_COUNT_FOR_FILTERING 4
 
-// Threshold to to trigger the dynamic table cleanup.
-#define HTTP2_DYNAMIC_TABLE_CLEANUP_THRESHOLD 200
-// Represents the maximum number of dynamic_table entries to clean up in a single tail call.
-// This number is above the HTTP2_DYNAMIC_TABLE_CLEANUP_THRESHOLD to ensure that we clean up all entries, and not missing
-// anything.
+// Represents the maximum number of dynamic_table entries to clean up in a single tail call. The entries left are
+// cleaned up with the next packets of the connection.
 #define HTTP2_DYNAMIC_TABLE_CLEANUP_ITERATIONS 300
 
 
 // Per request or response we have fewer headers than HTTP2_MAX_HEADERS_COUNT_FOR_FILTERING that are interesting us.
-// For request - those are method, path. For response - status code.
+// For request - those are method, scheme, path. For response - status code.
 // Thus differentiating between the limits can allow reducing code size.
-#define HTTP2_MAX_HEADERS_COUNT_FOR_PROCESSING 2
+#define HTTP2_MAX_HEADERS_COUNT_FOR_PROCESSING 3
 
 // Maximum size for the path buffer.
 #define HTTP2_MAX_PATH_LEN 160
@@ -70,6 +70,15 @@
 // The flag which will be sent in the data/header frame that indicates end of stream.
 #define HTTP2_END_OF_STREAM 0x1
 
+// The flag which will be sent in the headers/continuation frame that indicates the end of the header block.
+#define HTTP2_END_OF_HEADERS 0x4
+
+// The flag which will be sent in the data/header frame that indicates the payload is padded.
+#define HTTP2_PADDED 0x8
+
+// The minimal length of a GOAWAY frame payload, the last stream id and the error code.
+#define HTTP2_GOAWAY_MIN_LENGTH 8
+
 // Http2 max batch size.
 #define HTTP2_BATCH_SIZE (MAX_BATCH_SIZE(http2_event_t))
 
@@ -98,6 +107,8 @@ typedef enum {
     kPOST = 3,
     kEmptyPath = 4,
     kIndexPath = 5,
+    kHTTPScheme = 6,
+    kHTTPSScheme = 7,
     k200 = 8,
     k204 = 9,
     k206 = 10,
@@ -109,6 +120,22 @@ typedef enum {
     __MAX_STATIC_TABLE_INDEX = 255,
 } __attribute__((packed)) static_table_value_t;
 
+// The states of an HTTP/2 stream, as defined in RFC 7540 section 5.1, from the point of view of the client.
+// Streams are idle until their request headers are processed, open until the first END_STREAM flag, half-closed
+// (local when the client ended the stream, remote when the server did) until the second one, and closed afterward
+// or once they were reset or left unprocessed by a GOAWAY frame. The reserved states, used by server push, are not
+// tracked.
+// kStreamStateUnknown is the default value of the streams whose state wasn't tracked.
+// See: https://datatracker.ietf.org/doc/html/rfc7540#section-5.1
+typedef enum {
+    kStreamStateUnknown = 0,
+    kStreamStateIdle = 1,
+    kStreamStateOpen = 2,
+    kStreamStateHalfClosedLocal = 3,
+    kStreamStateHalfClosedRemote = 4,
+    kStreamStateClosed = 5,
+} __attribute__((packed)) http2_stream_state_t;
+
 typedef struct {
     char buffer[HTTP2_MAX_PATH_LEN] __attribute__((aligned(8)));
     __u32 original_index;
@@ -132,6 +159,9 @@ typedef struct {
 // Max length of the method is 7.
 #define HTTP2_METHOD_MAX_LEN 7
 
+// Max length of the scheme is 5 (https).
+#define HTTP2_SCHEME_MAX_LEN 5
+
 typedef struct {
     __u8 raw_buffer[HTTP2_STATUS_CODE_MAX_LEN];
     bool is_huffman_encoded;
@@ -149,6 +179,15 @@ typedef struct {
     bool finalized;
 } method_t;
 
+typedef struct {
+    __u8 raw_buffer[HTTP2_SCHEME_MAX_LEN];
+    bool is_huffman_encoded;
+
+    __u8 static_table_entry;
+    __u8 length;
+    bool finalized;
+} scheme_t;
+
 typedef struct {
     __u8 raw_buffer[HTTP2_MAX_PATH_LEN];
     bool is_huffman_encoded;
@@ -156,22 +195,38 @@ typedef struct {
     __u8 static_table_entry;
     __u8 length;
     bool finalized;
+    // set when the path was retrieved from the dynamic table, for diagnostic purposes.
+    bool from_dynamic_table;
 } path_t;
 
 typedef struct {
     __u64 response_last_seen;
     __u64 request_started;
+    // body sizes, accumulated from the DATA frames of each direction, padding excluded.
+    __u64 request_body_bytes;
+    __u64 response_body_bytes;
     __u8 tags;
 
     status_code_t status_code;
     method_t request_method;
+    scheme_t scheme;
     path_t path;
     bool end_of_stream_seen;
+    // set when the stream was terminated by an RST_STREAM frame, or left unprocessed by a GOAWAY frame, rather than by an END_STREAM flag.
+    bool aborted;
+    http2_stream_state_t state;
+    // set when the END_STREAM flag was carried by a HEADERS frame whose header block continues in CONTINUATION frames,
+    // the stream ending with the header block, see RFC 7540 section 8.1.
+    bool end_of_stream_pending;
+    // set when a header field spanning two fragments of the header block couldn't be completed, the rest of the block
+    // being left undecoded.
+    bool header_block_split;
 } http2_stream_t;
 
 typedef struct {
     conn_tuple_t tuple;
     http2_stream_t stream;
+    __u32 stream_id;
 } http2_event_t;
 
 typedef struct {
@@ -191,10 +246,32 @@ typedef struct {
     __u32 index;
     __u32 new_dynamic_value_offset;
     __u32 new_dynamic_value_size;
+    // the size of the part of the new value held by the current fragment of the header block, smaller than
+    // new_dynamic_value_size when the value continues in the next fragment.
+    __u32 new_dynamic_value_fragment_size;
     http2_header_type_t type;
     bool is_huffman_encoded;
 } http2_header_t;
 
+// A header field spanning two fragments of a header block, the HEADERS (or CONTINUATION) frame and the CONTINUATION
+// frame following it. The values of the interesting header fields are reassembled, the other fields being skipped.
+typedef struct {
+    // the value, whose first bytes were held by the previous fragment, followed by the room needed to read the end
+    // of the value from the next fragment, as HTTP2_MAX_PATH_LEN bytes are read at once.
+    dynamic_table_entry_t value;
+    char value_end[HTTP2_MAX_PATH_LEN];
+    __u64 dynamic_index;
+    __u32 original_index;
+    // the number of bytes of the header field held by the next fragment.
+    __u32 remainder;
+    __u8 string_len;
+    __u8 received;
+    http2_header_type_t type;
+    bool is_huffman_encoded;
+    // set when the value has to be reassembled, rather than skipped.
+    bool reassemble;
+} http2_split_header_t;
+
 typedef struct {
     http2_frame_t frame;
     __u32 offset;
@@ -212,6 +289,13 @@ typedef struct {
     http2_frame_with_offset frames_array[HTTP2_MAX_FRAMES_ITERATIONS] __attribute__((aligned(8)));
 } http2_tail_call_state_t;
 
+// The body size carried by the consecutive DATA frames of a stream within a packet. It is accumulated while filtering
+// the frames, so that the in-flight stream is looked up and updated once per run of frames rather than once per frame.
+typedef struct {
+    __u64 length;
+    __u32 stream_id;
+} http2_pending_body_t;
+
 typedef struct {
     __u32 remainder;
     __u32 header_length;
@@ -223,9 +307,11 @@ typedef struct {
 // response_seen                        Count of HTTP/2 responses seen
 // end_of_stream                        Count of END STREAM flag seen
 // end_of_stream_rst                    Count of RST flags seen
-// literal_value_exceeds_frame          Count of times we couldn't retrieve the literal value due to reaching the end of the frame.
+// end_of_stream_goaway                 Count of streams aborted by a GOAWAY frame
+// literal_value_exceeds_frame          Count of times the literal value exceeded the end of the frame, it being then reassembled with the next fragment if possible.
 // exceeding_max_interesting_frames		Count of times we reached the max number of frames per iteration.
 // exceeding_max_frames_to_filter		Count of times we have left with more frames to filter than the max number of frames to filter.
+// header_block_split                   Count of header blocks we couldn't decode entirely due to a header field spanning two frames that couldn't be completed.
 // path_size_bucket                     Count of path sizes and divided into buckets.
 // frames_split_count                   Count of times we tried to read more data than the end of the data end.
 typedef struct {
@@ -233,9 +319,11 @@ typedef struct {
     __u64 response_seen;
     __u64 end_of_stream;
     __u64 end_of_stream_rst;
+    __u64 end_of_stream_goaway;
     __u64 literal_value_exceeds_frame;
     __u64 exceeding_max_interesting_frames;
     __u64 exceeding_max_frames_to_filter;
+    __u64 header_block_split;
     __u64 path_size_bucket[HTTP2_TELEMETRY_PATH_BUCKETS+1];
 } http2_telemetry_t;
 
@@ -123,8 +123,8 @@ static __always_inline void* get_telemetry(pktbuf_t pkt) {
 // dynamic table, and will skip headers that are not path headers.
 // Returns true if the header was successfully parsed, and false otherwise.
 // Increments the interesting_headers_counter if the header is a path header with a length in the range of [0, HTTP2_MAX_PATH_LEN],
-// and we don't exceed packet boundaries.
-static __always_inline bool pktbuf_parse_field_literal(pktbuf_t pkt, http2_header_t *headers_to_process, __u64 index, __u64 global_dynamic_counter, __u8 *interesting_headers_counter, http2_telemetry_t *http2_tel, bool save_header) {
+// and we don't exceed packet or frame boundaries.
+static __always_inline bool pktbuf_parse_field_literal(pktbuf_t pkt, http2_header_t *headers_to_process, __u64 index, __u64 global_dynamic_counter, __u8 *interesting_headers_counter, http2_telemetry_t *http2_tel, bool save_header, __u32 frame_end) {
     __u64 str_len = 0;
     bool is_huffman_encoded = false;
     // String length supposed to be represented with at least 7 bits representation -https://datatracker.ietf.org/doc/html/rfc7541#section-5.2
@@ -151,7 +151,7 @@ static __always_inline bool pktbuf_parse_field_literal(pktbuf_t pkt, http2_heade
     // we skip it.
     if (is_path_index(index)) {
         update_path_size_telemetry(http2_tel, str_len);
-    } else if ((!is_status_index(index)) && (!is_method_index(index))) {
+    } else if ((!is_status_index(index)) && (!is_method_index(index)) && (!is_scheme_index(index))) {
         goto end;
     }
 
@@ -163,9 +163,15 @@ static __always_inline bool pktbuf_parse_field_literal(pktbuf_t pkt, http2_heade
         goto end;
     }
 
-    if (pktbuf_data_offset(pkt) + str_len > pktbuf_data_end(pkt)) {
+    __u64 fragment_size = str_len;
+    if (pktbuf_data_offset(pkt) + str_len > pktbuf_data_end(pkt) || pktbuf_data_offset(pkt) + str_len > frame_end) {
         __sync_fetch_and_add(&http2_tel->literal_value_exceeds_frame, 1);
-        goto end;
+        // The value may continue in the next fragment of the header block, held by a CONTINUATION frame, in which
+        // case it is reassembled once that frame is processed. The fragment must be entirely held by the packet.
+        if (frame_end > pktbuf_data_end(pkt) || str_len > HTTP2_MAX_PATH_LEN) {
+            goto end;
+        }
+        fragment_size = frame_end - pktbuf_data_offset(pkt);
     }
 
     if (save_header) {
@@ -177,6 +183,7 @@ static __always_inline bool pktbuf_parse_field_literal(pktbuf_t pkt, http2_heade
     headers_to_process->original_index = index;
     headers_to_process->new_dynamic_value_offset = pktbuf_data_offset(pkt);
     headers_to_process->new_dynamic_value_size = str_len;
+    headers_to_process->new_dynamic_value_fragment_size = fragment_size;
     headers_to_process->is_huffman_encoded = is_huffman_encoded;
     *interesting_headers_counter += (str_len > 0 && str_len <= HTTP2_MAX_PATH_LEN);
 end:
@@ -269,7 +276,7 @@ static __always_inline __u8 pktbuf_filter_relevant_headers(pktbuf_t pkt, __u64 *
         // 6.2.1 Literal Header Field with Incremental Indexing
         // top two bits are 11
         // https://httpwg.org/specs/rfc7541.html#rfc.section.6.2.1
-        if (!pktbuf_parse_field_literal(pkt, current_header, index, *global_dynamic_counter, &interesting_headers, http2_tel, is_literal)) {
+        if (!pktbuf_parse_field_literal(pkt, current_header, index, *global_dynamic_counter, &interesting_headers, http2_tel, is_literal, frame_end)) {
             break;
         }
     }
@@ -319,10 +326,100 @@ static __always_inline __u8 pktbuf_filter_relevant_headers(pktbuf_t pkt, __u64 *
     return interesting_headers;
 }
 
+// Updates the stream with the new value of a literal header, according to the index of its name.
+static __always_inline void update_stream_with_literal_value(http2_stream_t *current_stream, dynamic_table_entry_t *dynamic_value) {
+    if (is_path_index(dynamic_value->original_index)) {
+        current_stream->path.length = dynamic_value->string_len;
+        current_stream->path.is_huffman_encoded = dynamic_value->is_huffman_encoded;
+        current_stream->path.finalized = true;
+        bpf_memcpy(current_stream->path.raw_buffer, dynamic_value->buffer, HTTP2_MAX_PATH_LEN);
+    } else if (is_status_index(dynamic_value->original_index)) {
+        bpf_memcpy(current_stream->status_code.raw_buffer, dynamic_value->buffer, HTTP2_STATUS_CODE_MAX_LEN);
+        current_stream->status_code.is_huffman_encoded = dynamic_value->is_huffman_encoded;
+        current_stream->status_code.finalized = true;
+    } else if (is_method_index(dynamic_value->original_index)) {
+        bpf_memcpy(current_stream->request_method.raw_buffer, dynamic_value->buffer, HTTP2_METHOD_MAX_LEN);
+        current_stream->request_method.is_huffman_encoded = dynamic_value->is_huffman_encoded;
+        current_stream->request_method.length = dynamic_value->string_len;
+        current_stream->request_method.finalized = true;
+    } else if (is_scheme_index(dynamic_value->original_index)) {
+        bpf_memcpy(current_stream->scheme.raw_buffer, dynamic_value->buffer, HTTP2_SCHEME_MAX_LEN);
+        current_stream->scheme.is_huffman_encoded = dynamic_value->is_huffman_encoded;
+        current_stream->scheme.length = dynamic_value->string_len;
+        current_stream->scheme.finalized = true;
+    }
+}
+
+// Saves the header field spanning the end of the current fragment of the header block, to be completed with the
+// beginning of the next fragment, held by a CONTINUATION frame. The value of an interesting header is reassembled
+// when current_header is set, the field being skipped otherwise.
+static __always_inline bool pktbuf_save_split_header(pktbuf_t pkt, http2_stream_key_t *http2_stream_key, http2_header_t *current_header, __u32 remainder) {
+    const __u32 zero = 0;
+    http2_split_header_t *split_header = bpf_map_lookup_elem(&http2_split_header_heap, &zero);
+    if (split_header == NULL) {
+        return false;
+    }
+
+    split_header->remainder = remainder;
+    split_header->reassemble = current_header != NULL;
+    if (current_header != NULL) {
+        pktbuf_read_into_buffer_path(split_header->value.buffer, pkt, current_header->new_dynamic_value_offset);
+        split_header->dynamic_index = current_header->index;
+        split_header->original_index = current_header->original_index;
+        split_header->string_len = current_header->new_dynamic_value_size;
+        split_header->received = current_header->new_dynamic_value_fragment_size;
+        split_header->type = current_header->type;
+        split_header->is_huffman_encoded = current_header->is_huffman_encoded;
+    }
+    return bpf_map_update_elem(&http2_split_headers, http2_stream_key, split_header, BPF_ANY) == 0;
+}
+
+// Completes the header field spanning the end of the previous fragment of the header block of the stream with the
+// beginning of the given CONTINUATION frame, if any, and reports the number of bytes of the frame it held.
+// Returns false if the header field couldn't be completed.
+static __always_inline bool pktbuf_complete_split_header(pktbuf_t pkt, http2_stream_key_t *http2_stream_key, dynamic_table_index_t *dynamic_index, http2_stream_t *current_stream, http2_frame_with_offset *current_frame, __u32 *remainder) {
+    *remainder = 0;
+    http2_split_header_t *split_header = bpf_map_lookup_elem(&http2_split_headers, http2_stream_key);
+    if (split_header == NULL) {
+        return true;
+    }
+
+    bool completed = false;
+    if (split_header->remainder > current_frame->frame.length || current_frame->offset + split_header->remainder > pktbuf_data_end(pkt)) {
+        goto end;
+    }
+
+    if (split_header->reassemble) {
+        __u8 received = split_header->received;
+        if (received >= HTTP2_MAX_PATH_LEN) {
+            goto end;
+        }
+        // The end of the value overrides the bytes read past the end of the previous fragment.
+        pktbuf_read_into_buffer_path(split_header->value.buffer + received, pkt, current_frame->offset);
+        split_header->value.original_index = split_header->original_index;
+        split_header->value.string_len = split_header->string_len;
+        split_header->value.is_huffman_encoded = split_header->is_huffman_encoded;
+        // If the value is indexed - add it to the dynamic table.
+        if (split_header->type == kNewDynamicHeader) {
+            dynamic_index->index = split_header->dynamic_index;
+            bpf_map_update_elem(&http2_dynamic_table, dynamic_index, &split_header->value, BPF_ANY);
+        }
+        update_stream_with_literal_value(current_stream, &split_header->value);
+    }
+
+    *remainder = split_header->remainder;
+    completed = true;
+end:
+    bpf_map_delete_elem(&http2_split_headers, http2_stream_key);
+    return completed;
+}
+
 // Processes the headers that were filtered in filter_relevant_headers,
-// looking for requests path, status code, and method.
-static __always_inline void pktbuf_process_headers(pktbuf_t pkt, dynamic_table_index_t *dynamic_index, http2_stream_t *current_stream, http2_header_t *headers_to_process, __u8 interesting_headers,  http2_telemetry_t *http2_tel) {
+// looking for requests path, status code, method and scheme.
+// Returns the header whose value continues in the next fragment of the header block, if any.
+static __always_inline http2_header_t *pktbuf_process_headers(pktbuf_t pkt, dynamic_table_index_t *dynamic_index, http2_stream_t *current_stream, http2_header_t *headers_to_process, __u8 interesting_headers,  http2_telemetry_t *http2_tel) {
     http2_header_t *current_header;
+    http2_header_t *split_header = NULL;
     dynamic_table_entry_t dynamic_value = {};
 
 #pragma unroll(HTTP2_MAX_HEADERS_COUNT_FOR_PROCESSING)
@@ -346,6 +443,9 @@ static __always_inline void pktbuf_process_headers(pktbuf_t pkt, dynamic_table_i
             } else if (is_path_index(current_header->index)) {
                 current_stream->path.static_table_entry = current_header->index;
                 current_stream->path.finalized = true;
+            } else if (is_scheme_index(current_header->index)) {
+                current_stream->scheme.static_table_entry = current_header->index;
+                current_stream->scheme.finalized = true;
             }
             continue;
         }
@@ -360,6 +460,7 @@ static __always_inline void pktbuf_process_headers(pktbuf_t pkt, dynamic_table_i
                 current_stream->path.length = dynamic_value->string_len;
                 current_stream->path.is_huffman_encoded = dynamic_value->is_huffman_encoded;
                 current_stream->path.finalized = true;
+                current_stream->path.from_dynamic_table = true;
                 bpf_memcpy(current_stream->path.raw_buffer, dynamic_value->buffer, HTTP2_MAX_PATH_LEN);
             } else if (is_status_index(dynamic_value->original_index)) {
                 bpf_memcpy(current_stream->status_code.raw_buffer, dynamic_value->buffer, HTTP2_STATUS_CODE_MAX_LEN);
@@ -370,34 +471,32 @@ static __always_inline void pktbuf_process_headers(pktbuf_t pkt, dynamic_table_i
                 current_stream->request_method.is_huffman_encoded = dynamic_value->is_huffman_encoded;
                 current_stream->request_method.length = dynamic_value->string_len;
                 current_stream->request_method.finalized = true;
+            } else if (is_scheme_index(dynamic_value->original_index)) {
+                bpf_memcpy(current_stream->scheme.raw_buffer, dynamic_value->buffer, HTTP2_SCHEME_MAX_LEN);
+                current_stream->scheme.is_huffman_encoded = dynamic_value->is_huffman_encoded;
+                current_stream->scheme.length = dynamic_value->string_len;
+                current_stream->scheme.finalized = true;
             }
         } else {
+            // The value continues in the next fragment of the header block, it is reassembled with it.
+            if (current_header->new_dynamic_value_fragment_size < current_header->new_dynamic_value_size) {
+                split_header = current_header;
+                continue;
+            }
             // create the new dynamic value which will be added to the internal table.
             pktbuf_read_into_buffer_path(dynamic_value.buffer, pkt, current_header->new_dynamic_value_offset);
+            dynamic_value.string_len = current_header->new_dynamic_value_size;
+            dynamic_value.is_huffman_encoded = current_header->is_huffman_encoded;
+            dynamic_value.original_index = current_header->original_index;
             // If the value is indexed - add it to the dynamic table.
             if (current_header->type == kNewDynamicHeader) {
-                dynamic_value.string_len = current_header->new_dynamic_value_size;
-                dynamic_value.is_huffman_encoded = current_header->is_huffman_encoded;
-                dynamic_value.original_index = current_header->original_index;
                 bpf_map_update_elem(&http2_dynamic_table, dynamic_index, &dynamic_value, BPF_ANY);
             }
-            if (is_path_index(current_header->original_index)) {
-                current_stream->path.length = current_header->new_dynamic_value_size;
-                current_stream->path.is_huffman_encoded = current_header->is_huffman_encoded;
-                current_stream->path.finalized = true;
-                bpf_memcpy(current_stream->path.raw_buffer, dynamic_value.buffer, HTTP2_MAX_PATH_LEN);
-            } else if (is_status_index(current_header->original_index)) {
-                bpf_memcpy(current_stream->status_code.raw_buffer, dynamic_value.buffer, HTTP2_STATUS_CODE_MAX_LEN);
-                current_stream->status_code.is_huffman_encoded = current_header->is_huffman_encoded;
-                current_stream->status_code.finalized = true;
-            } else if (is_method_index(current_header->original_index)) {
-                bpf_memcpy(current_stream->request_method.raw_buffer, dynamic_value.buffer, HTTP2_METHOD_MAX_LEN);
-                current_stream->request_method.is_huffman_encoded = current_header->is_huffman_encoded;
-                current_stream->request_method.length = current_header->new_dynamic_value_size;
-                current_stream->request_method.finalized = true;
-            }
+            update_stream_with_literal_value(current_stream, &dynamic_value);
         }
     }
+
+    return split_header;
 }
 
 // The function is trying to read the remaining of a split frame header. We have the first part in
@@ -522,6 +621,68 @@ static __always_inline bool pktbuf_get_first_frame(pktbuf_t pkt, incomplete_fram
     return false;
 }
 
+// pktbuf_data_frame_body_length returns the body size carried by the given DATA frame. The packet offset must point to
+// the payload of the frame. The padding of the frame (its pad length field and the padding octets) is not part of the
+// body.
+static __always_inline __u32 pktbuf_data_frame_body_length(pktbuf_t pkt, http2_frame_t *current_frame) {
+    __u32 body_length = current_frame->length;
+    if ((current_frame->flags & HTTP2_PADDED) == HTTP2_PADDED) {
+        // Check out https://datatracker.ietf.org/doc/html/rfc7540#section-6.1 for the padded data frame layout.
+        __u8 pad_length = 0;
+        if (pktbuf_data_offset(pkt) + sizeof(pad_length) > pktbuf_data_end(pkt)) {
+            return 0;
+        }
+        pktbuf_load_bytes(pkt, pktbuf_data_offset(pkt), &pad_length, sizeof(pad_length));
+        if ((__u32)pad_length + sizeof(pad_length) > body_length) {
+            return 0;
+        }
+        body_length -= pad_length + sizeof(pad_length);
+    }
+    return body_length;
+}
+
+// flush_pending_body adds the pending body size to the in-flight stream it belongs to, and resets it. The direction is
+// derived from the tuple, as the frames come from the server if the tuple had to be flipped to be normalized. Frames
+// of streams we have not seen the request of are ignored.
+static __always_inline void flush_pending_body(conn_tuple_t *tup, http2_pending_body_t *pending_body) {
+    if (pending_body->length == 0) {
+        return;
+    }
+
+    http2_stream_key_t http2_stream_key = {};
+    http2_stream_key.tup = *tup;
+    bool is_response = normalize_tuple(&http2_stream_key.tup);
+    http2_stream_key.stream_id = pending_body->stream_id;
+    __u64 body_length = pending_body->length;
+    pending_body->length = 0;
+
+    http2_stream_t *current_stream = bpf_map_lookup_elem(&http2_in_flight, &http2_stream_key);
+    if (current_stream == NULL) {
+        return;
+    }
+    if (is_response) {
+        __sync_fetch_and_add(&current_stream->response_body_bytes, body_length);
+    } else {
+        __sync_fetch_and_add(&current_stream->request_body_bytes, body_length);
+    }
+}
+
+// pktbuf_account_data_frame accumulates the body size carried by the given DATA frame in the pending body size. The
+// pending body size of the previous stream is flushed first if the frame belongs to another stream, so that
+// consecutive frames of a stream update it once.
+static __always_inline void pktbuf_account_data_frame(pktbuf_t pkt, conn_tuple_t *tup, http2_frame_t *current_frame, http2_pending_body_t *pending_body) {
+    __u32 body_length = pktbuf_data_frame_body_length(pkt, current_frame);
+    if (body_length == 0) {
+        return;
+    }
+
+    if (pending_body->stream_id != current_frame->stream_id) {
+        flush_pending_body(tup, pending_body);
+        pending_body->stream_id = current_frame->stream_id;
+    }
+    pending_body->length += body_length;
+}
+
 // Iterates over the packet and finds frames that are
 // relevant for us. The frames info and location are stored in the `iteration_value->frames_array` array,
 // and the number of frames found is being stored at iteration_value->frames_count.
@@ -532,10 +693,15 @@ static __always_inline bool pktbuf_get_first_frame(pktbuf_t pkt, incomplete_fram
 // We consider frames as relevant if they are either:
 // - HEADERS frames
 // - RST_STREAM frames
+// - GOAWAY frames
 // - DATA frames with the END_STREAM flag set
-static __always_inline bool pktbuf_find_relevant_frames(pktbuf_t pkt, http2_tail_call_state_t *iteration_value, http2_telemetry_t *http2_tel) {
-    bool is_headers_or_rst_frame, is_data_end_of_stream;
+//
+// DATA frames are not kept, their payload size is accounted to their stream while filtering, once per run of
+// consecutive frames of a stream.
+static __always_inline bool pktbuf_find_relevant_frames(pktbuf_t pkt, conn_tuple_t *tup, http2_tail_call_state_t *iteration_value, http2_telemetry_t *http2_tel) {
+    bool is_headers_rst_or_goaway_frame, is_data_end_of_stream;
     http2_frame_t current_frame = {};
+    http2_pending_body_t pending_body = {};
 
     // The following if-clause could have been "simplified" into
     // if (iteration_value->filter_iterations != 0) {
@@ -573,9 +739,14 @@ static __always_inline bool pktbuf_find_relevant_frames(pktbuf_t pkt, http2_tail
         // END_STREAM can appear only in Headers and Data frames.
         // Check out https://datatracker.ietf.org/doc/html/rfc7540#section-6.1 for data frame, and
         // https://datatracker.ietf.org/doc/html/rfc7540#section-6.2 for headers frame.
-        is_headers_or_rst_frame = current_frame.type == kHeadersFrame || current_frame.type == kRSTStreamFrame;
+        // The header block of a Headers frame may continue in Continuation frames, see
+        // https://datatracker.ietf.org/doc/html/rfc7540#section-6.10.
+        is_headers_rst_or_goaway_frame = is_header_block_frame(current_frame.type) || current_frame.type == kRSTStreamFrame || current_frame.type == kGoAwayFrame;
         is_data_end_of_stream = ((current_frame.flags & HTTP2_END_OF_STREAM) == HTTP2_END_OF_STREAM) && (current_frame.type == kDataFrame);
-        if (iteration_value->frames_count < HTTP2_MAX_FRAMES_ITERATIONS && (is_headers_or_rst_frame || is_data_end_of_stream)) {
+        if (current_frame.type == kDataFrame) {
+            pktbuf_account_data_frame(pkt, tup, &current_frame, &pending_body);
+        }
+        if (iteration_value->frames_count < HTTP2_MAX_FRAMES_ITERATIONS && (is_headers_rst_or_goaway_frame || is_data_end_of_stream)) {
             iteration_value->frames_array[iteration_value->frames_count].frame = current_frame;
             iteration_value->frames_array[iteration_value->frames_count].offset = pktbuf_data_offset(pkt);
             iteration_value->frames_count++;
@@ -589,6 +760,8 @@ static __always_inline bool pktbuf_find_relevant_frames(pktbuf_t pkt, http2_tail
         }
     }
 
+    flush_pending_body(tup, &pending_body);
+
     if (iteration_value->frames_count == HTTP2_MAX_FRAMES_ITERATIONS) {
         __sync_fetch_and_add(&http2_tel->exceeding_max_interesting_frames, 1);
     }
@@ -626,6 +799,7 @@ static __always_inline void handle_first_frame(pktbuf_t pkt, __u32 *external_dat
     }
 
     incomplete_frame_t *incomplete_frame = bpf_map_lookup_elem(&http2_incomplete_frames, tup);
+    bool is_cached_frame = incomplete_frame != NULL && incomplete_frame->header_length == HTTP2_FRAME_HEADER_SIZE;
     bool has_valid_first_frame = pktbuf_get_first_frame(pkt, incomplete_frame, &current_frame);
     // If we have a state and we consumed it, then delete it.
     if (incomplete_frame != NULL && incomplete_frame->remainder == 0) {
@@ -648,9 +822,16 @@ static __always_inline void handle_first_frame(pktbuf_t pkt, __u32 *external_dat
         return;
     }
 
-    bool is_headers_or_rst_frame = current_frame.type == kHeadersFrame || current_frame.type == kRSTStreamFrame;
+    // A cached frame header was already accounted for when it was first seen, this packet holds its remainder only.
+    if (current_frame.type == kDataFrame && !is_cached_frame) {
+        http2_pending_body_t pending_body = {};
+        pktbuf_account_data_frame(pkt, tup, &current_frame, &pending_body);
+        flush_pending_body(tup, &pending_body);
+    }
+
+    bool is_headers_rst_or_goaway_frame = is_header_block_frame(current_frame.type) || current_frame.type == kRSTStreamFrame || current_frame.type == kGoAwayFrame;
     bool is_data_end_of_stream = ((current_frame.flags & HTTP2_END_OF_STREAM) == HTTP2_END_OF_STREAM) && (current_frame.type == kDataFrame);
-    if (is_headers_or_rst_frame || is_data_end_of_stream) {
+    if (is_headers_rst_or_goaway_frame || is_data_end_of_stream) {
         iteration_value->frames_array[0].frame = current_frame;
         iteration_value->frames_array[0].offset = pktbuf_data_offset(pkt);
         iteration_value->frames_count = 1;
@@ -710,11 +891,13 @@ int socket__http2_handle_first_frame(struct __sk_buff *skb) {
         // Deleting the entry for the original tuple.
         bpf_map_delete_elem(&http2_incomplete_frames, &dispatcher_args_copy.tup);
         bpf_map_delete_elem(&http2_dynamic_counter_table, &dispatcher_args_copy.tup);
+        bpf_map_delete_elem(&http2_goaway_last_stream_id, &dispatcher_args_copy.tup);
         terminated_http2_batch_enqueue(&dispatcher_args_copy.tup);
         // In case of local host, the protocol will be deleted for both (client->server) and (server->client),
         // so we won't reach for that path again in the code, so we're deleting the opposite side as well.
         flip_tuple(&dispatcher_args_copy.tup);
         bpf_map_delete_elem(&http2_dynamic_counter_table, &dispatcher_args_copy.tup);
+        bpf_map_delete_elem(&http2_goaway_last_stream_id, &dispatcher_args_copy.tup);
         bpf_map_delete_elem(&http2_incomplete_frames, &dispatcher_args_copy.tup);
         return 0;
     }
@@ -747,7 +930,7 @@ static __always_inline void filter_frame(pktbuf_t pkt, void *map_key, conn_tuple
     // in a map, we cannot allow it to be modified. Thus, storing the original value of the offset.
     __u32 original_off = pktbuf_data_offset(pkt);
 
-    bool have_more_frames_to_process = pktbuf_find_relevant_frames(pkt, iteration_value, http2_tel);
+    bool have_more_frames_to_process = pktbuf_find_relevant_frames(pkt, tup, iteration_value, http2_tel);
     // We have found there are more frames to filter, so we will call frame_filter again.
     // Max current amount of tail calls would be 2, which will allow us to currently parse
     // HTTP2_MAX_TAIL_CALLS_FOR_FRAMES_FILTER*HTTP2_MAX_FRAMES_ITERATIONS.
@@ -897,6 +1080,8 @@ static __always_inline void headers_parser(pktbuf_t pkt, void *map_key, conn_tup
     bpf_memset(headers_to_process, 0, HTTP2_MAX_HEADERS_COUNT_FOR_PROCESSING * sizeof(http2_header_t));
 
     __u8 interesting_headers = 0;
+    http2_header_t *split_header = NULL;
+    __u32 fragment_offset = 0, fragment_length = 0, fragment_end = 0, split_remainder = 0;
 
     __u64 *global_dynamic_counter = get_dynamic_counter(tup);
     if (global_dynamic_counter == NULL) {
@@ -915,20 +1100,55 @@ static __always_inline void headers_parser(pktbuf_t pkt, void *map_key, conn_tup
         current_frame = frames_array[tail_call_state->iteration];
         tail_call_state->iteration += 1;
 
-        if (current_frame.frame.type != kHeadersFrame) {
+        if (!is_header_block_frame(current_frame.frame.type)) {
             continue;
         }
 
         http2_ctx->http2_stream_key.stream_id = current_frame.frame.stream_id;
-        current_stream = http2_fetch_stream(&http2_ctx->http2_stream_key);
-        if (current_stream == NULL) {
-            continue;
+        fragment_offset = current_frame.offset;
+        fragment_length = current_frame.frame.length;
+        if (current_frame.frame.type == kContinuationFrame) {
+            // A CONTINUATION frame holds the next fragment of the header block of a stream, whose first bytes may
+            // complete the header field spanning the end of the previous fragment.
+            current_stream = bpf_map_lookup_elem(&http2_in_flight, &http2_ctx->http2_stream_key);
+            if (current_stream == NULL || current_stream->header_block_split) {
+                continue;
+            }
+            if (!pktbuf_complete_split_header(pkt, &http2_ctx->http2_stream_key, &http2_ctx->dynamic_index, current_stream, &current_frame, &split_remainder)) {
+                current_stream->header_block_split = true;
+                __sync_fetch_and_add(&http2_tel->header_block_split, 1);
+                continue;
+            }
+            fragment_offset += split_remainder;
+            fragment_length -= split_remainder;
+        } else {
+            current_stream = http2_fetch_stream(&http2_ctx->http2_stream_key);
+            if (current_stream == NULL) {
+                continue;
+            }
+            current_stream->header_block_split = false;
+            bpf_map_delete_elem(&http2_split_headers, &http2_ctx->http2_stream_key);
         }
         current_stream->tags = tags;
-        pktbuf_set_offset(pkt, current_frame.offset);
-
-        interesting_headers = pktbuf_filter_relevant_headers(pkt, global_dynamic_counter, &http2_ctx->dynamic_index, headers_to_process, current_frame.frame.length, http2_tel);
-        pktbuf_process_headers(pkt, &http2_ctx->dynamic_index, current_stream, headers_to_process, interesting_headers, http2_tel);
+        pktbuf_set_offset(pkt, fragment_offset);
+
+        interesting_headers = pktbuf_filter_relevant_headers(pkt, global_dynamic_counter, &http2_ctx->dynamic_index, headers_to_process, fragment_length, http2_tel);
+        fragment_end = current_frame.offset + current_frame.frame.length;
+        split_remainder = pktbuf_data_offset(pkt) > fragment_end ? pktbuf_data_offset(pkt) - fragment_end : 0;
+        split_header = pktbuf_process_headers(pkt, &http2_ctx->dynamic_index, current_stream, headers_to_process, interesting_headers, http2_tel);
+        // The header field spanning the next fragment is completed with it, if the fragment is entirely held by the
+        // packet. Otherwise the header fields following it are left undecoded, so the dynamic table entries they
+        // insert are missing, like the ones of the headers above the filtering limits.
+        if (split_remainder > 0) {
+            if (fragment_end > pktbuf_data_end(pkt) || !pktbuf_save_split_header(pkt, &http2_ctx->http2_stream_key, split_header, split_remainder)) {
+                current_stream->header_block_split = true;
+                __sync_fetch_and_add(&http2_tel->header_block_split, 1);
+            }
+        }
+        // the headers open the stream, see RFC 7540 section 5.1.
+        if (current_stream->state == kStreamStateIdle) {
+            current_stream->state = kStreamStateOpen;
+        }
     }
 
     if (tail_call_state->iteration < HTTP2_MAX_FRAMES_ITERATIONS &&
@@ -1005,8 +1225,10 @@ static __always_inline void dynamic_table_cleaner(pktbuf_t pkt, conn_tuple_t *tu
     }
 
     // We're checking if the difference between the current value of the dynamic global table, to the previous index we
-    // cleaned, is bigger than our threshold. If so, we need to clean the table.
-    if (dynamic_counter->value - dynamic_counter->previous <= HTTP2_DYNAMIC_TABLE_CLEANUP_THRESHOLD) {
+    // cleaned, is bigger than the maximum number of entries of the table. If so, the oldest entries are evicted and we
+    // need to clean the table.
+    const __u64 max_entries = http2_max_dynamic_table_entries();
+    if (dynamic_counter->value - dynamic_counter->previous <= max_entries) {
         goto next;
     }
 
@@ -1016,10 +1238,9 @@ static __always_inline void dynamic_table_cleaner(pktbuf_t pkt, conn_tuple_t *tu
 
     #pragma unroll(HTTP2_DYNAMIC_TABLE_CLEANUP_ITERATIONS)
     for (__u16 index = 0; index < HTTP2_DYNAMIC_TABLE_CLEANUP_ITERATIONS; index++) {
-        // We should reserve the last HTTP2_DYNAMIC_TABLE_CLEANUP_THRESHOLD entries in the dynamic table.
-        // So if we're about to delete an entry that is in the last HTTP2_DYNAMIC_TABLE_CLEANUP_THRESHOLD entries,
-        // we should stop the cleanup.
-        if (dynamic_counter->previous + HTTP2_DYNAMIC_TABLE_CLEANUP_THRESHOLD >= dynamic_counter->value) {
+        // We should reserve the last max_entries entries in the dynamic table.
+        // So if we're about to delete an entry that is in the last max_entries entries, we should stop the cleanup.
+        if (dynamic_counter->previous + max_entries >= dynamic_counter->value) {
             break;
         }
         // Setting the current index.
@@ -1050,6 +1271,47 @@ int socket__http2_dynamic_table_cleaner(struct __sk_buff *skb) {
     return 0;
 }
 
+// pktbuf_handle_goaway aborts the in-flight streams of the connection whose id is above the last stream id of the
+// given GOAWAY frame, sent by the server, as it did not process them and they will never see an end of stream.
+// Only the streams having a complete request are reported, the other ones are dropped.
+// Only the first HTTP2_MAX_STREAMS_ABORTED_BY_GOAWAY odd stream ids above the last stream id are walked here, so the
+// last stream id is also recorded for the connection, letting the user mode abort the remaining streams when it
+// flushes the in-flight map.
+// See: https://datatracker.ietf.org/doc/html/rfc7540#section-6.8
+static __always_inline void pktbuf_handle_goaway(pktbuf_t pkt, http2_frame_with_offset *goaway_frame, http2_stream_key_t *http2_stream_key, http2_telemetry_t *http2_tel) {
+    __u32 last_stream_id = 0;
+    if (goaway_frame->frame.length < HTTP2_GOAWAY_MIN_LENGTH || goaway_frame->offset + sizeof(last_stream_id) > pktbuf_data_end(pkt)) {
+        return;
+    }
+    pktbuf_load_bytes(pkt, goaway_frame->offset, &last_stream_id, sizeof(last_stream_id));
+    // The first bit is reserved.
+    last_stream_id = bpf_ntohl(last_stream_id) & 0x7fffffff;
+    bpf_map_update_elem(&http2_goaway_last_stream_id, &http2_stream_key->tup, &last_stream_id, BPF_ANY);
+
+    // Requests are sent over the client initiated streams, which have odd ids.
+    __u32 stream_id = (last_stream_id % 2 == 0) ? last_stream_id + 1 : last_stream_id + 2;
+    http2_stream_t *current_stream = NULL;
+
+#pragma unroll(HTTP2_MAX_STREAMS_ABORTED_BY_GOAWAY)
+    for (__u16 index = 0; index < HTTP2_MAX_STREAMS_ABORTED_BY_GOAWAY; index++) {
+        http2_stream_key->stream_id = stream_id;
+        stream_id += 2;
+
+        current_stream = bpf_map_lookup_elem(&http2_in_flight, http2_stream_key);
+        if (current_stream == NULL) {
+            continue;
+        }
+        if (!current_stream->request_method.finalized || !current_stream->path.finalized) {
+            bpf_map_delete_elem(&http2_in_flight, http2_stream_key);
+            continue;
+        }
+
+        current_stream->aborted = true;
+        __sync_fetch_and_add(&http2_tel->end_of_stream_goaway, 1);
+        enqueue_closed_stream(current_stream, http2_stream_key);
+    }
+}
+
 static __always_inline void eos_parser(pktbuf_t pkt, void *map_key, conn_tuple_t *tup) {
     const __u32 zero = 0;
 
@@ -1088,9 +1350,11 @@ static __always_inline void eos_parser(pktbuf_t pkt, void *map_key, conn_tuple_t
     }
     bpf_memset(http2_ctx, 0, sizeof(http2_ctx_t));
     http2_ctx->http2_stream_key.tup = *tup;
-    normalize_tuple(&http2_ctx->http2_stream_key.tup);
+    // The tuple is flipped when the packet was sent by the server.
+    bool is_response = normalize_tuple(&http2_ctx->http2_stream_key.tup);
 
-    bool is_rst = false, is_end_of_stream = false;
+    bool is_rst = false, is_end_of_stream = false, is_goaway = false, is_continuation = false, is_end_of_headers = false;
+    http2_frame_with_offset goaway_frame = {};
     http2_stream_t *current_stream = NULL;
 
     #pragma unroll(HTTP2_MAX_FRAMES_FOR_EOS_PARSER_PER_TAIL_CALL)
@@ -1106,9 +1370,22 @@ static __always_inline void eos_parser(pktbuf_t pkt, void *map_key, conn_tuple_t
         }
         tail_call_state->iteration += 1;
 
+        // The streams left unprocessed by a GOAWAY frame are aborted once all the frames were handled, as the peer
+        // may still have completed some of them in the same packet.
+        // Only the GOAWAY frames sent by the server are handled, the last stream id of the ones sent by the client
+        // referring to the streams pushed by the server, not to the requests.
+        if (current_frame.frame.type == kGoAwayFrame) {
+            is_goaway = is_response;
+            goaway_frame = current_frame;
+            continue;
+        }
+
         is_rst = current_frame.frame.type == kRSTStreamFrame;
-        is_end_of_stream = (current_frame.frame.flags & HTTP2_END_OF_STREAM) == HTTP2_END_OF_STREAM;
-        if (!is_rst && !is_end_of_stream) {
+        // CONTINUATION frames don't define the END_STREAM flag, but end the header block with the END_HEADERS flag.
+        is_continuation = current_frame.frame.type == kContinuationFrame;
+        is_end_of_stream = !is_continuation && (current_frame.frame.flags & HTTP2_END_OF_STREAM) == HTTP2_END_OF_STREAM;
+        is_end_of_headers = (current_frame.frame.flags & HTTP2_END_OF_HEADERS) == HTTP2_END_OF_HEADERS;
+        if (!is_rst && !is_end_of_stream && !(is_continuation && is_end_of_headers)) {
             continue;
         }
 
@@ -1119,6 +1396,19 @@ static __always_inline void eos_parser(pktbuf_t pkt, void *map_key, conn_tuple_t
             continue;
         }
 
+        // The END_STREAM flag of a HEADERS frame takes effect once its header block ends, the path or status code
+        // possibly being held by the CONTINUATION frames.
+        if (is_continuation) {
+            if (!current_stream->end_of_stream_pending) {
+                continue;
+            }
+            current_stream->end_of_stream_pending = false;
+            is_end_of_stream = true;
+        } else if (is_end_of_stream && current_frame.frame.type == kHeadersFrame && !is_end_of_headers) {
+            current_stream->end_of_stream_pending = true;
+            continue;
+        }
+
         // When we accept an RST, it means that the current stream is terminated.
         // See: https://datatracker.ietf.org/doc/html/rfc7540#section-6.4
         // If rst, and stream is empty (no status code, or no response) then delete from inflight
@@ -1128,11 +1418,13 @@ static __always_inline void eos_parser(pktbuf_t pkt, void *map_key, conn_tuple_t
         }
 
         if (is_rst) {
+            current_stream->aborted = true;
+            current_stream->state = kStreamStateClosed;
             __sync_fetch_and_add(&http2_tel->end_of_stream_rst, 1);
-        } else if ((current_frame.frame.flags & HTTP2_END_OF_STREAM) == HTTP2_END_OF_STREAM) {
+        } else if (is_end_of_stream) {
             __sync_fetch_and_add(&http2_tel->end_of_stream, 1);
         }
-        handle_end_of_stream(current_stream, &http2_ctx->http2_stream_key, http2_tel);
+        handle_end_of_stream(current_stream, &http2_ctx->http2_stream_key, is_response, http2_tel);
 
         // If we reached here, it means that we saw End Of Stream. If the End of Stream came from a request,
         // thus we except it to have a valid path and method. If the End of Stream came from a response, we except it to
@@ -1142,6 +1434,10 @@ static __always_inline void eos_parser(pktbuf_t pkt, void *map_key, conn_tuple_t
         }
     }
 
+    if (is_goaway) {
+        pktbuf_handle_goaway(pkt, &goaway_frame, &http2_ctx->http2_stream_key, http2_tel);
+    }
+
     if (tail_call_state->iteration < HTTP2_MAX_FRAMES_ITERATIONS &&
         tail_call_state->iteration < tail_call_state->frames_count &&
         tail_call_state->iteration < HTTP2_MAX_FRAMES_FOR_EOS_PARSER) {
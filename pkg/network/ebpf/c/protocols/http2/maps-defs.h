@@ -18,6 +18,14 @@ BPF_HASH_MAP(http2_dynamic_counter_table, conn_tuple_t, dynamic_counter_t, 0)
 /* This map is used to keep track of in-flight HTTP2 transactions for each TCP connection */
 BPF_HASH_MAP(http2_in_flight, http2_stream_key_t, http2_stream_t, 0)
 
+// A map between a connection (normalized tuple) and the last stream id of the last GOAWAY frame seen on it.
+// The in-flight streams above that id are aborted by the user mode when the in-flight map is flushed.
+BPF_HASH_MAP(http2_goaway_last_stream_id, conn_tuple_t, __u32, 0)
+
+// A map between a stream and the header field spanning the last fragment of its header block and the next one,
+// held by a CONTINUATION frame. Entries are removed once the CONTINUATION frame was processed.
+BPF_LRU_MAP(http2_split_headers, http2_stream_key_t, http2_split_header_t, 0)
+
 /* This map serves the purpose of maintaining the current state of tail calls for each frame,
    identified by a tuple consisting of con_tup and skb_info.
    It allows retrieval of both the current offset and the number of iterations that have already been executed. */
@@ -40,6 +48,9 @@ BPF_PERCPU_ARRAY_MAP(http2_stream_heap, http2_stream_t, 1)
    enqueued. The primary motivation here is to save eBPF stack memory. */
 BPF_PERCPU_ARRAY_MAP(http2_scratch_buffer, http2_event_t, 1)
 
+/* Allocating a split header field on the heap, before being saved for the next fragment of the header block. */
+BPF_PERCPU_ARRAY_MAP(http2_split_header_heap, http2_split_header_t, 1)
+
 /* Allocating a ctx on the heap, in order to save the ctx between the current stream. */
 BPF_PERCPU_ARRAY_MAP(http2_ctx_heap, http2_ctx_t, 1)
 
@@ -0,0 +1,98 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux_bpf
+
+package http2
+
+import (
+	"golang.org/x/net/http2/hpack"
+)
+
+const (
+	// DefaultMaxDynamicTableSize is the default upper bound of the HPACK dynamic table, matching the initial value of
+	// SETTINGS_HEADER_TABLE_SIZE (RFC 7540 section 6.5.2).
+	DefaultMaxDynamicTableSize = 4096
+
+	// dynamicTableEntryOverhead is the size added to the sizes of the name and value of a dynamic table entry
+	// (RFC 7541 section 4.1).
+	dynamicTableEntryOverhead = 32
+)
+
+// staticTable is the HPACK static table (RFC 7541 appendix A). Index 0 is unused.
+var staticTable = [...]hpack.HeaderField{
+	{},
+	{Name: ":authority"},
+	{Name: ":method", Value: "GET"},
+	{Name: ":method", Value: "POST"},
+	{Name: ":path", Value: "/"},
+	{Name: ":path", Value: "/index.html"},
+	{Name: ":scheme", Value: "http"},
+	{Name: ":scheme", Value: "https"},
+	{Name: ":status", Value: "200"},
+	{Name: ":status", Value: "204"},
+	{Name: ":status", Value: "206"},
+	{Name: ":status", Value: "304"},
+	{Name: ":status", Value: "400"},
+	{Name: ":status", Value: "404"},
+	{Name: ":status", Value: "500"},
+	{Name: "accept-charset"},
+	{Name: "accept-encoding", Value: "gzip, deflate"},
+	{Name: "accept-language"},
+	{Name: "accept-ranges"},
+	{Name: "accept"},
+	{Name: "access-control-allow-origin"},
+	{Name: "age"},
+	{Name: "allow"},
+	{Name: "authorization"},
+	{Name: "cache-control"},
+	{Name: "content-disposition"},
+	{Name: "content-encoding"},
+	{Name: "content-language"},
+	{Name: "content-length"},
+	{Name: "content-location"},
+	{Name: "content-range"},
+	{Name: "content-type"},
+	{Name: "cookie"},
+	{Name: "date"},
+	{Name: "etag"},
+	{Name: "expect"},
+	{Name: "expires"},
+	{Name: "from"},
+	{Name: "host"},
+	{Name: "if-match"},
+	{Name: "if-modified-since"},
+	{Name: "if-none-match"},
+	{Name: "if-range"},
+	{Name: "if-unmodified-since"},
+	{Name: "last-modified"},
+	{Name: "link"},
+	{Name: "location"},
+	{Name: "max-forwards"},
+	{Name: "proxy-authenticate"},
+	{Name: "proxy-authorization"},
+	{Name: "range"},
+	{Name: "referer"},
+	{Name: "refresh"},
+	{Name: "retry-after"},
+	{Name: "server"},
+	{Name: "set-cookie"},
+	{Name: "strict-transport-security"},
+	{Name: "transfer-encoding"},
+	{Name: "user-agent"},
+	{Name: "vary"},
+	{Name: "via"},
+	{Name: "www-authenticate"},
+}
+
+// maxDynamicTableEntries returns the maximum number of entries of a dynamic table bounded to maxDynamicTableSize
+// bytes, each entry holding at least dynamicTableEntryOverhead bytes. If maxDynamicTableSize is 0,
+// DefaultMaxDynamicTableSize is used.
+func maxDynamicTableEntries(maxDynamicTableSize uint32) uint64 {
+	if maxDynamicTableSize == 0 {
+		maxDynamicTableSize = DefaultMaxDynamicTableSize
+	}
+	return uint64(maxDynamicTableSize / dynamicTableEntryOverhead)
+}
@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux_bpf
+
+package http2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2/hpack"
+)
+
+func TestMaxDynamicTableEntries(t *testing.T) {
+	// the kernel bounds the dynamic table by its number of entries, the smallest entry holding 32 bytes
+	assert.Equal(t, uint64(128), maxDynamicTableEntries(0))
+	assert.Equal(t, uint64(128), maxDynamicTableEntries(DefaultMaxDynamicTableSize))
+	assert.Equal(t, uint64(32), maxDynamicTableEntries(1024))
+	assert.Equal(t, uint64(1), maxDynamicTableEntries(63))
+	assert.Equal(t, uint64(0), maxDynamicTableEntries(16))
+}
+
+func TestNeverIndexedPath(t *testing.T) {
+	const path = "/api/v1/users/1234/secret-token"
+
+	// the :path name is referenced from the static table, index 4, with the never indexed prefix 0001
+	plain := append([]byte{0x14, byte(len(path))}, path...)
+
+	huffman := hpack.AppendHuffmanString(nil, path)
+	require.Less(t, len(huffman), 0x7f)
+	huffman = append([]byte{0x14, 0x80 | byte(len(huffman))}, huffman...)
+
+	for name, block := range map[string][]byte{"plain": plain, "huffman": huffman} {
+		t.Run(name, func(t *testing.T) {
+			// the value is captured as sent, after the name index and the string length
+			var raw [maxHTTP2Path]uint8
+			n := copy(raw[:], block[2:])
+
+			tx := &EbpfTx{
+				Stream: HTTP2Stream{
+					Path: http2Path{
+						Is_huffman_encoded: block[1]&0x80 != 0,
+						Raw_buffer:         raw,
+						Length:             uint8(n),
+					},
+				},
+			}
+
+			value, ok := tx.Path(make([]byte, maxHTTP2Path))
+			require.True(t, ok)
+			assert.Equal(t, path, string(value))
+			assert.NotEqual(t, PathSourceDynamicTable, tx.PathSource())
+		})
+	}
+}
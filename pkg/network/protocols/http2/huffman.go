@@ -0,0 +1,148 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux_bpf
+
+package http2
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/net/http2/hpack"
+)
+
+// huffmanNode is a node of the Huffman decoding tree. Each level of the tree consumes 8 bits of input, the leaves
+// hold the decoded symbol and the number of bits of the last level that are part of its code.
+type huffmanNode struct {
+	children *[256]*huffmanNode
+	codeLen  uint8
+	sym      byte
+}
+
+var (
+	huffmanRootOnce sync.Once
+	huffmanRoot     *huffmanNode
+)
+
+func newHuffmanInternalNode() *huffmanNode {
+	return &huffmanNode{children: new([256]*huffmanNode)}
+}
+
+// getHuffmanRoot returns the root of the Huffman decoding tree, building it on first use.
+func getHuffmanRoot() *huffmanNode {
+	huffmanRootOnce.Do(func() {
+		huffmanRoot = newHuffmanInternalNode()
+		for sym := 0; sym < 256; sym++ {
+			code, codeLen := huffmanCode(byte(sym))
+			addHuffmanNode(byte(sym), code, codeLen)
+		}
+	})
+	return huffmanRoot
+}
+
+// huffmanCode returns the code of the given symbol (RFC 7541 appendix B). The codes are taken from the hpack encoder
+// so that the decoder never diverges from it: 8 occurrences of a symbol are encoded on exactly codeLen bytes, and a
+// single occurrence is encoded as its code followed by a padding of ones.
+func huffmanCode(sym byte) (uint32, uint8) {
+	s := string([]byte{sym})
+	codeLen := uint8(hpack.HuffmanEncodeLength(strings.Repeat(s, 8)))
+
+	var padded uint64
+	encoded := hpack.AppendHuffmanString(nil, s)
+	for _, b := range encoded {
+		padded = padded<<8 | uint64(b)
+	}
+	return uint32(padded >> (uint(len(encoded))*8 - uint(codeLen))), codeLen
+}
+
+// addHuffmanNode inserts the given code in the decoding tree.
+func addHuffmanNode(sym byte, code uint32, codeLen uint8) {
+	cur := huffmanRoot
+	for codeLen > 8 {
+		codeLen -= 8
+		i := uint8(code >> codeLen)
+		if cur.children[i] == nil {
+			cur.children[i] = newHuffmanInternalNode()
+		}
+		cur = cur.children[i]
+	}
+
+	// every index starting with the remaining bits of the code leads to the symbol
+	shift := 8 - codeLen
+	start, end := int(uint8(code<<shift)), int(1<<shift)
+	leaf := &huffmanNode{sym: sym, codeLen: codeLen}
+	for i := start; i < start+end; i++ {
+		cur.children[i] = leaf
+	}
+}
+
+// huffmanDecode decodes the Huffman encoded string src into dst, without any intermediate allocation. The whole
+// input is validated, but the output is truncated to the size of dst. It returns the number of bytes written to dst
+// and whether the decoded string was truncated.
+func huffmanDecode(dst, src []byte) (int, bool, error) {
+	root := getHuffmanRoot()
+	node := root
+
+	var (
+		n int
+		// cur holds the pending input bits, cbits being the number of them not consumed yet and sbits the number of
+		// them consumed since the last decoded symbol.
+		cur          uint64
+		cbits, sbits uint8
+	)
+
+	emit := func(sym byte) {
+		if n < len(dst) {
+			dst[n] = sym
+		}
+		n++
+	}
+
+	for _, b := range src {
+		cur = cur<<8 | uint64(b)
+		cbits += 8
+		sbits += 8
+		for cbits >= 8 {
+			node = node.children[byte(cur>>(cbits-8))]
+			if node == nil {
+				return 0, false, hpack.ErrInvalidHuffman
+			}
+			if node.children == nil {
+				emit(node.sym)
+				cbits -= node.codeLen
+				node = root
+				sbits = cbits
+			} else {
+				cbits -= 8
+			}
+		}
+	}
+
+	for cbits > 0 {
+		node = node.children[byte(cur<<(8-cbits))]
+		if node == nil {
+			return 0, false, hpack.ErrInvalidHuffman
+		}
+		if node.children != nil || node.codeLen > cbits {
+			break
+		}
+		emit(node.sym)
+		cbits -= node.codeLen
+		node = root
+		sbits = cbits
+	}
+
+	// the padding must be shorter than 8 bits and made of the most significant bits of the EOS code, i.e. ones
+	// (RFC 7541 section 5.2)
+	if sbits > 7 {
+		return 0, false, hpack.ErrInvalidHuffman
+	}
+	if mask := uint64(1)<<cbits - 1; cur&mask != mask {
+		return 0, false, hpack.ErrInvalidHuffman
+	}
+
+	return min(n, len(dst)), n > len(dst), nil
+}
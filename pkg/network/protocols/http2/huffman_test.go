@@ -0,0 +1,95 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux_bpf
+
+package http2
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2/hpack"
+)
+
+func TestHuffmanDecode(t *testing.T) {
+	var all strings.Builder
+	for i := 0; i < 256; i++ {
+		all.WriteByte(byte(i))
+	}
+
+	inputs := []string{
+		"",
+		"/",
+		"/index.html",
+		"/api/v1/users?id=8f2b7c1e&expand=true",
+		"www.example.com",
+		"no-cache",
+		"custom-value",
+		"POST",
+		"404",
+		strings.Repeat("/aaaaaaaa", 20),
+		all.String(),
+	}
+
+	for _, input := range inputs {
+		encoded := hpack.AppendHuffmanString(nil, input)
+
+		out := make([]byte, len(input))
+		n, truncated, err := huffmanDecode(out, encoded)
+		require.NoError(t, err, input)
+		assert.False(t, truncated)
+		assert.Equal(t, input, string(out[:n]))
+	}
+
+	t.Run("output truncated", func(t *testing.T) {
+		encoded := hpack.AppendHuffmanString(nil, "/api/v1/users")
+
+		out := make([]byte, 4)
+		n, truncated, err := huffmanDecode(out, encoded)
+		require.NoError(t, err)
+		assert.True(t, truncated)
+		assert.Equal(t, "/api", string(out[:n]))
+	})
+
+	t.Run("invalid padding", func(t *testing.T) {
+		// "a" is encoded on 5 bits (00011), the padding must be made of ones
+		_, _, err := huffmanDecode(make([]byte, 8), []byte{0x18})
+		assert.ErrorIs(t, err, hpack.ErrInvalidHuffman)
+	})
+
+	t.Run("padding too long", func(t *testing.T) {
+		encoded := append(hpack.AppendHuffmanString(nil, "/"), 0xff)
+		_, _, err := huffmanDecode(make([]byte, 8), encoded)
+		assert.ErrorIs(t, err, hpack.ErrInvalidHuffman)
+	})
+}
+
+func BenchmarkHuffmanDecodePath(b *testing.B) {
+	path := "/api/v1/organizations/8f2b7c1e/users?expand=roles&limit=100"
+	encoded := hpack.AppendHuffmanString(nil, path)
+
+	b.Run("hpack", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := hpack.HuffmanDecodeToString(encoded); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("decoder", func(b *testing.B) {
+		out := make([]byte, len(path))
+
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, _, err := huffmanDecode(out, encoded); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -14,7 +14,6 @@ import (
 	"net"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	"golang.org/x/net/http2/hpack"
@@ -29,6 +28,14 @@ import (
 
 var oversizedLogLimit = log.NewLogLimit(10, time.Minute*10)
 
+// maxHTTP2MethodLength is the size of the buffer used to decode Huffman encoded methods, which is larger than any
+// supported method.
+const maxHTTP2MethodLength = 16
+
+// maxHTTP2SchemeLength is the size of the buffer used to decode Huffman encoded schemes, Huffman codes being at least
+// 5 bits long.
+const maxHTTP2SchemeLength = 8
+
 // validatePath validates the given path.
 func validatePath(str []byte) error {
 	if len(str) == 0 {
@@ -41,114 +48,212 @@ func validatePath(str []byte) error {
 	return nil
 }
 
-// validatePathSize validates the given path size.
-func validatePathSize(size uint8) error {
-	if size == 0 {
-		return errors.New("empty path")
-	}
-	if size > maxHTTP2Path {
-		return fmt.Errorf("path size has exceeded the maximum limit: %d", size)
-	}
-	return nil
-}
+// PathSource describes how the path of a transaction was retrieved
+type PathSource uint8
+
+const (
+	// PathSourceUnknown is used when no path was captured
+	PathSourceUnknown PathSource = iota
+	// PathSourceLiteral is used for a path sent as a plain literal
+	PathSourceLiteral
+	// PathSourceHuffman is used for a path sent as a Huffman encoded literal
+	PathSourceHuffman
+	// PathSourceStaticTable is used for a path referring to the static table
+	PathSourceStaticTable
+	// PathSourceDynamicTable is used for a path referring to a dynamic table entry
+	PathSourceDynamicTable
+)
 
-// Buffer pool to be used for decoding HTTP2 paths.
-// This is used to avoid allocating a new buffer for each path decoding.
-var bufPool = sync.Pool{
-	New: func() interface{} { return new(bytes.Buffer) },
+// String returns the name of the path source
+func (s PathSource) String() string {
+	switch s {
+	case PathSourceLiteral:
+		return "literal"
+	case PathSourceHuffman:
+		return "huffman"
+	case PathSourceStaticTable:
+		return "static_table"
+	case PathSourceDynamicTable:
+		return "dynamic_table"
+	default:
+		return "unknown"
+	}
 }
 
-// decodeHTTP2Path tries to decode (Huffman) the path from the given buffer.
-// Possible errors:
-// - If the given pathSize is 0.
-// - If the given pathSize is larger than the buffer size.
-// - If the Huffman decoding fails.
-// - If the decoded path doesn't start with a '/'.
-func decodeHTTP2Path(buf [maxHTTP2Path]byte, pathSize uint8, output []byte) ([]byte, error) {
-	if err := validatePathSize(pathSize); err != nil {
-		return nil, err
+// PathSource returns how the path of the transaction was retrieved. It is meant for diagnostic purposes only.
+func (tx *EbpfTx) PathSource() PathSource {
+	switch {
+	case tx.Stream.Path.Static_table_entry != 0:
+		return PathSourceStaticTable
+	case tx.Stream.Path.Length == 0:
+		return PathSourceUnknown
+	case tx.Stream.Path.From_dynamic_table:
+		return PathSourceDynamicTable
+	case tx.Stream.Path.Is_huffman_encoded:
+		return PathSourceHuffman
+	default:
+		return PathSourceLiteral
 	}
+}
 
-	tmpBuffer := bufPool.Get().(*bytes.Buffer)
-	tmpBuffer.Reset()
-	defer bufPool.Put(tmpBuffer)
+// Path returns the URL from the request fragment captured in eBPF.
+func (tx *EbpfTx) Path(buffer []byte) ([]byte, bool) {
+	path, _, ok := tx.PathWithTruncation(buffer)
+	return path, ok
+}
 
-	n, err := hpack.HuffmanDecode(tmpBuffer, buf[:pathSize])
-	if err != nil {
-		return nil, err
+// PathWithTruncation returns the URL from the request fragment captured in eBPF, along with whether it was truncated,
+// either because the path captured in eBPF exceeded maxHTTP2Path or because it didn't fit in the given buffer.
+// Query parameters are ignored, so a path is not reported as truncated if only its query parameters were cut.
+func (tx *EbpfTx) PathWithTruncation(buffer []byte) ([]byte, bool, bool) {
+	path, truncated, ok := tx.decodeHeader(":path", buffer)
+	if !ok {
+		return nil, false, false
 	}
-
-	if err = validatePath(tmpBuffer.Bytes()); err != nil {
-		return nil, err
+	if err := validatePath(path); err != nil {
+		if oversizedLogLimit.ShouldLog() {
+			// The error already contains the path, so we don't need to log it again.
+			log.Warn(err)
+		}
+		return nil, false, false
 	}
 
-	if n > len(output) {
-		n = len(output)
+	// Ignore query parameters
+	queryStart := bytes.IndexByte(path, byte('?'))
+	if queryStart == -1 {
+		queryStart = len(path)
+	} else {
+		truncated = false
+	}
+	return path[:queryStart], truncated, true
+}
+
+// HeaderOptions holds the options of the lookups of the headers by name
+type HeaderOptions struct {
+	// ExactCaseNames compares the header names as given. By default they are compared case insensitively: HPACK
+	// requires lowercase names, but non-conforming clients may send literal names in mixed case.
+	ExactCaseNames bool
+}
+
+// Header returns the value of the header of the transaction having the given name, compared case insensitively.
+// Only the :path, :method, :scheme and :status pseudo-headers are captured in eBPF, false being returned for the
+// other headers, like user-agent. Their values are resolved from the HPACK static table, or decoded from the literal
+// captured in eBPF, the references to the dynamic table being resolved to their literal in eBPF. Unlike Path, the
+// value of the :path header includes the query parameters.
+func (tx *EbpfTx) Header(name string) ([]byte, bool) {
+	return tx.HeaderWithOptions(name, HeaderOptions{})
+}
+
+// HeaderWithOptions returns the value of the header of the transaction having the given name, compared according to
+// opts. The names of the captured headers are lowercase, as HPACK requires. A value truncated in eBPF is rejected.
+func (tx *EbpfTx) HeaderWithOptions(name string, opts HeaderOptions) ([]byte, bool) {
+	if !opts.ExactCaseNames {
+		name = strings.ToLower(name)
+	}
+
+	value, truncated, ok := tx.decodeHeader(name, nil)
+	if !ok || truncated {
+		return nil, false
+	}
+	return value, true
+}
+
+// decodeHeader decodes the value of the captured header having the given name into buffer, allocated to the size of
+// the value if nil. It returns whether the value was truncated, and false if the header wasn't captured.
+func (tx *EbpfTx) decodeHeader(name string, buffer []byte) ([]byte, bool, bool) {
+	switch name {
+	case ":path":
+		path := &tx.Stream.Path
+		return decodeHeaderValue(name, path.Static_table_entry, path.Raw_buffer[:], path.Length, path.Is_huffman_encoded, buffer)
+	case ":method":
+		method := &tx.Stream.Request_method
+		return decodeHeaderValue(name, method.Static_table_entry, method.Raw_buffer[:], method.Length, method.Is_huffman_encoded, buffer)
+	case ":scheme":
+		scheme := &tx.Stream.Scheme
+		return decodeHeaderValue(name, scheme.Static_table_entry, scheme.Raw_buffer[:], scheme.Length, scheme.Is_huffman_encoded, buffer)
+	case ":status":
+		status := &tx.Stream.Status_code
+		// the length isn't captured: a huffman encoded status code fits in 2 bytes, a literal one in 3
+		length := uint8(http2RawStatusCodeMaxLength)
+		if status.Is_huffman_encoded {
+			length--
+		}
+		return decodeHeaderValue(name, status.Static_table_entry, status.Raw_buffer[:], length, status.Is_huffman_encoded, buffer)
+	default:
+		return nil, false, false
 	}
-	copy(output[:n], tmpBuffer.Bytes())
-	return output[:n], nil
 }
 
-// Path returns the URL from the request fragment captured in eBPF.
-func (tx *EbpfTx) Path(buffer []byte) ([]byte, bool) {
-	if tx.Stream.Path.Static_table_entry != 0 {
-		switch tx.Stream.Path.Static_table_entry {
-		case EmptyPathValue:
-			return []byte("/"), true
-		case IndexPathValue:
-			return []byte("/index.html"), true
-		default:
-			return nil, false
+// decodeHeaderValue decodes the value of a header into buffer, either from the given entry of the static table or
+// from its literal representation, and returns whether it was truncated, either because the literal exceeded the
+// captured buffer or because the value didn't fit in the given buffer. A nil buffer is allocated to the size of the
+// value.
+func decodeHeaderValue(name string, staticTableEntry uint8, raw []byte, length uint8, isHuffmanEncoded bool, buffer []byte) ([]byte, bool, bool) {
+	if staticTableEntry != 0 {
+		if int(staticTableEntry) >= len(staticTable) || staticTable[staticTableEntry].Name != name {
+			return nil, false, false
+		}
+		value := staticTable[staticTableEntry].Value
+		if buffer == nil {
+			return []byte(value), false, true
 		}
+		n := copy(buffer, value)
+		return buffer[:n], n < len(value), true
 	}
 
-	var err error
-	if tx.Stream.Path.Is_huffman_encoded {
-		buffer, err = decodeHTTP2Path(tx.Stream.Path.Raw_buffer, tx.Stream.Path.Length, buffer)
-		if err != nil {
-			if oversizedLogLimit.ShouldLog() {
-				log.Warnf("unable to decode HTTP2 path (%#v) due to: %s", tx.Stream.Path.Raw_buffer[:tx.Stream.Path.Length], err)
-			}
-			return nil, false
+	if length == 0 {
+		return nil, false, false
+	}
+
+	var truncated bool
+	if int(length) > len(raw) {
+		if oversizedLogLimit.ShouldLog() {
+			log.Warnf("%s length %d is longer than the captured buffer size %d, huffman encoded: %v", name, length, len(raw), isHuffmanEncoded)
 		}
-	} else {
-		if tx.Stream.Path.Length == 0 {
-			if oversizedLogLimit.ShouldLog() {
-				log.Warn("path size: 0 is invalid")
-			}
-			return nil, false
-		} else if int(tx.Stream.Path.Length) > len(tx.Stream.Path.Raw_buffer) {
-			if oversizedLogLimit.ShouldLog() {
-				log.Warnf("Truncating as path size: %d is greater than the buffer size: %d", tx.Stream.Path.Length, len(buffer))
-			}
-			tx.Stream.Path.Length = uint8(len(tx.Stream.Path.Raw_buffer))
+		// a truncated huffman literal can't be decoded
+		if isHuffmanEncoded {
+			return nil, false, false
 		}
-		n := copy(buffer, tx.Stream.Path.Raw_buffer[:tx.Stream.Path.Length])
-		// Truncating exceeding nulls.
-		buffer = buffer[:n]
-		if err = validatePath(buffer); err != nil {
-			if oversizedLogLimit.ShouldLog() {
-				// The error already contains the path, so we don't need to log it again.
-				log.Warn(err)
-			}
-			return nil, false
+		length = uint8(len(raw))
+		truncated = true
+	}
+	raw = raw[:length]
+
+	if !isHuffmanEncoded {
+		if buffer == nil {
+			buffer = make([]byte, len(raw))
 		}
+		n := copy(buffer, raw)
+		return buffer[:n], truncated || n < len(raw), true
 	}
 
-	// Ignore query parameters
-	queryStart := bytes.IndexByte(buffer, byte('?'))
-	if queryStart == -1 {
-		queryStart = len(buffer)
+	if buffer == nil {
+		// huffman codes are at least 5 bits long
+		buffer = make([]byte, len(raw)*8/5+1)
+	}
+	n, decodingTruncated, err := huffmanDecode(buffer, raw)
+	if err != nil {
+		if oversizedLogLimit.ShouldLog() {
+			log.Warnf("unable to decode HTTP2 %s (%#v) due to: %s", name, raw, err)
+		}
+		return nil, false, false
 	}
-	return buffer[:queryStart], true
+	return buffer[:n], decodingTruncated, true
 }
 
 // RequestLatency returns the latency of the request in nanoseconds
 func (tx *EbpfTx) RequestLatency() float64 {
-	if uint64(tx.Stream.Request_started) == 0 || uint64(tx.Stream.Response_last_seen) == 0 {
+	return protocols.NSTimestampToFloat(uint64(tx.Duration()))
+}
+
+// Duration returns the time elapsed between the start of the request and the last response frame of the stream, or
+// zero if one of the timestamps is missing
+func (tx *EbpfTx) Duration() time.Duration {
+	started, lastSeen := tx.Stream.Request_started, tx.Stream.Response_last_seen
+	if started == 0 || lastSeen == 0 || lastSeen < started {
 		return 0
 	}
-	return protocols.NSTimestampToFloat(tx.Stream.Response_last_seen - tx.Stream.Request_started)
+	return time.Duration(lastSeen - started)
 }
 
 // Incomplete returns true if the transaction contains only the request or response information
@@ -169,6 +274,35 @@ func (tx *EbpfTx) ConnTuple() types.ConnectionKey {
 	}
 }
 
+// StreamID returns the HTTP2 stream id of the transaction.
+func (tx *EbpfTx) StreamID() uint32 {
+	return tx.Id
+}
+
+// CorrelationKey identifies a single HTTP2 stream of a connection, regardless of the direction of the traffic.
+type CorrelationKey struct {
+	Connection types.ConnectionKey
+	StreamID   uint32
+}
+
+// CorrelationKey returns a comparable key pairing the request and the response of the transaction.
+// The connection tuple is normalized so that both halves of the stream produce the same key.
+func (tx *EbpfTx) CorrelationKey() CorrelationKey {
+	conn := tx.ConnTuple()
+	if conn.SrcIPHigh > conn.DstIPHigh ||
+		(conn.SrcIPHigh == conn.DstIPHigh && conn.SrcIPLow > conn.DstIPLow) ||
+		(conn.SrcIPHigh == conn.DstIPHigh && conn.SrcIPLow == conn.DstIPLow && conn.SrcPort > conn.DstPort) {
+		conn.SrcIPHigh, conn.DstIPHigh = conn.DstIPHigh, conn.SrcIPHigh
+		conn.SrcIPLow, conn.DstIPLow = conn.DstIPLow, conn.SrcIPLow
+		conn.SrcPort, conn.DstPort = conn.DstPort, conn.SrcPort
+	}
+
+	return CorrelationKey{
+		Connection: conn,
+		StreamID:   tx.StreamID(),
+	}
+}
+
 // stringToHTTPMethod converts a string to an HTTP method.
 func stringToHTTPMethod(method string) (http.Method, error) {
 	switch strings.ToUpper(method) {
@@ -198,86 +332,51 @@ func stringToHTTPMethod(method string) (http.Method, error) {
 
 // Method returns the HTTP method of the transaction.
 func (tx *EbpfTx) Method() http.Method {
-	var method string
-	var err error
-
-	// Case which the method is indexed.
-	if tx.Stream.Request_method.Static_table_entry != 0 {
-		switch tx.Stream.Request_method.Static_table_entry {
-		case GetValue:
-			return http.MethodGet
-		case PostValue:
-			return http.MethodPost
-		default:
-			return http.MethodUnknown
-		}
-	}
-
-	// if the length of the method is greater than the buffer, then we return 0.
-	if int(tx.Stream.Request_method.Length) > len(tx.Stream.Request_method.Raw_buffer) || tx.Stream.Request_method.Length == 0 {
-		if oversizedLogLimit.ShouldLog() {
-			log.Errorf("method length %d is longer than the size buffer: %v and is huffman encoded: %v",
-				tx.Stream.Request_method.Length, tx.Stream.Request_method.Raw_buffer, tx.Stream.Request_method.Is_huffman_encoded)
-		}
+	var buffer [maxHTTP2MethodLength]byte
+	method, truncated, ok := tx.decodeHeader(":method", buffer[:])
+	// a truncated method can't be a known one
+	if !ok || truncated {
 		return http.MethodUnknown
 	}
 
-	// Case which the method is literal.
-	if tx.Stream.Request_method.Is_huffman_encoded {
-		method, err = hpack.HuffmanDecodeToString(tx.Stream.Request_method.Raw_buffer[:tx.Stream.Request_method.Length])
-		if err != nil {
-			return http.MethodUnknown
-		}
-	} else {
-		method = string(tx.Stream.Request_method.Raw_buffer[:tx.Stream.Request_method.Length])
-	}
-	http2Method, err := stringToHTTPMethod(method)
+	http2Method, err := stringToHTTPMethod(string(method))
 	if err != nil {
 		return http.MethodUnknown
 	}
 	return http2Method
 }
 
-// StatusCode returns the status code of the transaction.
-// If the status code is indexed, then we return the corresponding value.
-// Otherwise, f the status code is huffman encoded, then we decode it and convert it from string to int.
-// Otherwise, we convert the status code from byte array to int.
-func (tx *EbpfTx) StatusCode() uint16 {
-	if tx.Stream.Status_code.Static_table_entry != 0 {
-		switch tx.Stream.Status_code.Static_table_entry {
-		case K200Value:
-			return 200
-		case K204Value:
-			return 204
-		case K206Value:
-			return 206
-		case K304Value:
-			return 304
-		case K400Value:
-			return 400
-		case K404Value:
-			return 404
-		case K500Value:
-			return 500
-		default:
-			return 0
-		}
+// Scheme returns the scheme of the request, either from the static table or from the literal captured in eBPF. It
+// returns false if the scheme wasn't captured.
+func (tx *EbpfTx) Scheme() (string, bool) {
+	var buffer [maxHTTP2SchemeLength]byte
+	scheme, truncated, ok := tx.decodeHeader(":scheme", buffer[:])
+	// a truncated scheme can't be entirely retrieved
+	if !ok || truncated {
+		return "", false
 	}
 
-	if tx.Stream.Status_code.Is_huffman_encoded {
-		// The final form of the status code is 3 characters.
-		statusCode, err := hpack.HuffmanDecodeToString(tx.Stream.Status_code.Raw_buffer[:http2RawStatusCodeMaxLength-1])
-		if err != nil {
-			return 0
-		}
-		code, err := strconv.Atoi(statusCode)
-		if err != nil {
-			return 0
-		}
-		return uint16(code)
+	switch string(scheme) {
+	case "http":
+		return "http", true
+	case "https":
+		return "https", true
+	default:
+		return string(scheme), true
+	}
+}
+
+// StatusCode returns the status code of the transaction, either from the static table or from the literal captured
+// in eBPF, or 0 if it wasn't captured.
+func (tx *EbpfTx) StatusCode() uint16 {
+	// The final form of the status code is 3 characters.
+	var buffer [http2RawStatusCodeMaxLength]byte
+	statusCode, truncated, ok := tx.decodeHeader(":status", buffer[:])
+	if !ok || truncated {
+		return 0
 	}
 
-	code, err := strconv.Atoi(string(tx.Stream.Status_code.Raw_buffer[:]))
+	code, err := strconv.Atoi(string(statusCode))
 	if err != nil {
 		return 0
 	}
@@ -309,6 +408,56 @@ func (tx *EbpfTx) RequestStarted() uint64 {
 	return tx.Stream.Request_started
 }
 
+// RequestBodyBytes returns the size of the request body, computed from the DATA frames sent by the client,
+// padding excluded. Returns 0 when unknown.
+func (tx *EbpfTx) RequestBodyBytes() uint64 {
+	return tx.Stream.Request_body_bytes
+}
+
+// ResponseBodyBytes returns the size of the response body, computed from the DATA frames sent by the server,
+// padding excluded. Returns 0 when unknown.
+func (tx *EbpfTx) ResponseBodyBytes() uint64 {
+	return tx.Stream.Response_body_bytes
+}
+
+// Aborted returns true if the stream was terminated by an RST_STREAM frame, or was above the last stream id of a
+// GOAWAY frame, rather than completed, in which case the transaction shouldn't be considered successful. The path and
+// method are still decoded for diagnostics, the status code being unset for the streams aborted by a GOAWAY frame.
+func (tx *EbpfTx) Aborted() bool {
+	return tx.Stream.Aborted
+}
+
+// String returns the name of the stream state, as used in RFC 7540 section 5.1
+func (s StreamState) String() string {
+	switch s {
+	case StreamStateIdle:
+		return "idle"
+	case StreamStateOpen:
+		return "open"
+	case StreamStateHalfClosedLocal:
+		return "half_closed_local"
+	case StreamStateHalfClosedRemote:
+		return "half_closed_remote"
+	case StreamStateClosed:
+		return "closed"
+	default:
+		return "unknown"
+	}
+}
+
+// State returns the state of the stream, from the point of view of the client, as tracked by the eBPF decoder. The
+// transactions are reported once their stream is closed, the other states being seen on the in-flight streams, for
+// instance in the map dumps.
+// StreamStateUnknown is returned when the state wasn't tracked or holds an unexpected value.
+// See RFC 7540 section 5.1: https://datatracker.ietf.org/doc/html/rfc7540#section-5.1
+func (tx *EbpfTx) State() StreamState {
+	state := StreamState(tx.Stream.State)
+	if state > StreamStateClosed {
+		return StreamStateUnknown
+	}
+	return state
+}
+
 // SetRequestMethod sets the HTTP method of the transaction.
 func (tx *EbpfTx) SetRequestMethod(_ http.Method) {
 	// if we set Static_table_entry to be different from 0, and no indexed value, it will default to 0 which is "UNKNOWN"
@@ -442,9 +591,11 @@ HTTP2Telemetry{
 	"responses seen": %d,
 	"end of stream seen": %d,
 	"reset frames seen": %d,
+	"streams aborted by goaway frames": %d,
 	"literal values exceed message count": %d,
 	"messages with more frames than we can filter": %d,
 	"messages with more interesting frames than we can process": %d,
+	"header blocks split within a header field": %d,
 	"path headers length distribution": {
 		"in range [0, 120)": %d,
 		"in range [120, 130)": %d,
@@ -455,8 +606,9 @@ HTTP2Telemetry{
 		"in range [170, 180)": %d,
 		"in range [180, infinity)": %d
 	}
-}`, t.Request_seen, t.Response_seen, t.End_of_stream, t.End_of_stream_rst, t.Literal_value_exceeds_frame,
-		t.Exceeding_max_frames_to_filter, t.Exceeding_max_interesting_frames, t.Path_size_bucket[0], t.Path_size_bucket[1],
+}`, t.Request_seen, t.Response_seen, t.End_of_stream, t.End_of_stream_rst, t.End_of_stream_goaway,
+		t.Literal_value_exceeds_frame,
+		t.Exceeding_max_frames_to_filter, t.Exceeding_max_interesting_frames, t.Header_block_split, t.Path_size_bucket[0], t.Path_size_bucket[1],
 		t.Path_size_bucket[2], t.Path_size_bucket[3], t.Path_size_bucket[4], t.Path_size_bucket[5], t.Path_size_bucket[6],
 		t.Path_size_bucket[7])
 }
@@ -11,11 +11,13 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/net/http2/hpack"
 
+	"github.com/DataDog/datadog-agent/pkg/network/protocols"
 	"github.com/DataDog/datadog-agent/pkg/network/protocols/http"
 )
 
@@ -26,6 +28,7 @@ func TestHTTP2LongPath(t *testing.T) {
 		expectedPath   string
 		huffmanEnabled bool
 		outBufSize     int
+		truncated      bool
 	}{
 		{
 			name:           "Long path with huffman with bigger out buffer",
@@ -38,18 +41,35 @@ func TestHTTP2LongPath(t *testing.T) {
 			expectedPath:   fmt.Sprintf("/%s", strings.Repeat("a", 19)),
 			huffmanEnabled: true,
 			outBufSize:     20,
+			truncated:      true,
 		},
 		{
 			name:    "Long path without huffman with bigger out buffer",
 			rawPath: fmt.Sprintf("/%s", strings.Repeat("a", maxHTTP2Path+1)),
 			// The path is truncated to maxHTTP2Path (including the leading '/')
 			expectedPath: fmt.Sprintf("/%s", strings.Repeat("a", maxHTTP2Path-1)),
+			truncated:    true,
 		},
 		{
 			name:         "Long path without huffman with shorter out buffer",
 			rawPath:      fmt.Sprintf("/%s", strings.Repeat("a", maxHTTP2Path+1)),
 			expectedPath: fmt.Sprintf("/%s", strings.Repeat("a", 19)),
 			outBufSize:   20,
+			truncated:    true,
+		},
+		{
+			name:    "Short path without huffman",
+			rawPath: "/hello.HelloService/SayHello",
+		},
+		{
+			name:           "Short path with huffman",
+			rawPath:        "/hello.HelloService/SayHello",
+			huffmanEnabled: true,
+		},
+		{
+			name:         "Long query string without huffman",
+			rawPath:      fmt.Sprintf("/foo?%s", strings.Repeat("a", maxHTTP2Path)),
+			expectedPath: "/foo",
 		},
 	}
 
@@ -79,13 +99,19 @@ func TestHTTP2LongPath(t *testing.T) {
 			}
 			outBuf := make([]byte, tt.outBufSize)
 
-			path, ok := request.Path(outBuf)
-			require.True(t, ok)
 			expectedPath := tt.rawPath
 			if tt.expectedPath != "" {
 				expectedPath = tt.expectedPath
 			}
+
+			path, ok := request.Path(outBuf)
+			require.True(t, ok)
+			assert.Equal(t, expectedPath, string(path))
+
+			path, truncated, ok := request.PathWithTruncation(outBuf)
+			require.True(t, ok)
 			assert.Equal(t, expectedPath, string(path))
+			assert.Equal(t, tt.truncated, truncated)
 		})
 	}
 }
@@ -159,6 +185,79 @@ func TestHTTP2Path(t *testing.T) {
 	}
 }
 
+func TestHTTP2PathSource(t *testing.T) {
+	const rawPath = "/hello.HelloService/SayHello"
+
+	newPath := func(huffmanEnabled bool) http2Path {
+		buf := []byte(rawPath)
+		if huffmanEnabled {
+			buf = hpack.AppendHuffmanString(nil, rawPath)
+		}
+		path := http2Path{
+			Is_huffman_encoded: huffmanEnabled,
+			Length:             uint8(len(buf)),
+		}
+		copy(path.Raw_buffer[:], buf)
+		return path
+	}
+
+	dynamicPath := newPath(true)
+	dynamicPath.From_dynamic_table = true
+
+	tests := []struct {
+		name         string
+		path         http2Path
+		expectedPath string
+		source       PathSource
+	}{
+		{
+			name:         "literal",
+			path:         newPath(false),
+			expectedPath: rawPath,
+			source:       PathSourceLiteral,
+		},
+		{
+			name:         "huffman",
+			path:         newPath(true),
+			expectedPath: rawPath,
+			source:       PathSourceHuffman,
+		},
+		{
+			name:         "dynamic_table",
+			path:         dynamicPath,
+			expectedPath: rawPath,
+			source:       PathSourceDynamicTable,
+		},
+		{
+			name:         "static_table",
+			path:         http2Path{Static_table_entry: IndexPathValue},
+			expectedPath: "/index.html",
+			source:       PathSourceStaticTable,
+		},
+		{
+			name:   "unknown",
+			source: PathSourceUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &EbpfTx{
+				Stream: HTTP2Stream{
+					Path: tt.path,
+				},
+			}
+
+			assert.Equal(t, tt.source, request.PathSource())
+			assert.Equal(t, tt.name, tt.source.String())
+
+			path, ok := request.Path(make([]byte, 200))
+			assert.Equal(t, tt.expectedPath != "", ok)
+			assert.Equal(t, tt.expectedPath, string(path))
+		})
+	}
+}
+
 func TestHTTP2Method(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -214,3 +313,397 @@ func TestHTTP2Method(t *testing.T) {
 		})
 	}
 }
+
+func FuzzHTTP2Method(f *testing.F) {
+	addSeed := func(raw []byte, length uint8, huffman bool, staticEntry uint8) {
+		f.Add(raw, length, huffman, staticEntry)
+	}
+
+	// seeds taken from TestHTTP2Method
+	addSeed([]byte{0x50, 0x55, 0x54}, 3, false, 0)
+	addSeed([]byte{1, 2}, 8, false, 0)
+	addSeed([]byte{1, 2}, 0, true, 0)
+	// indexed and huffman encoded methods
+	addSeed(nil, 0, false, uint8(GetValue))
+	addSeed(nil, 0, false, uint8(PostValue))
+	addSeed(nil, 0, false, 0xff)
+	for _, method := range []string{"GET", "DELETE", "OPTIONS", "CONNECT"} {
+		encoded := hpack.AppendHuffmanString(nil, method)
+		addSeed(encoded, uint8(len(encoded)), true, 0)
+	}
+
+	f.Fuzz(func(t *testing.T, raw []byte, length uint8, huffman bool, staticEntry uint8) {
+		tx := &EbpfTx{}
+		copy(tx.Stream.Request_method.Raw_buffer[:], raw)
+		tx.Stream.Request_method.Length = length
+		tx.Stream.Request_method.Is_huffman_encoded = huffman
+		tx.Stream.Request_method.Static_table_entry = staticEntry
+
+		method := tx.Method()
+		if method > http.MethodTrace {
+			t.Fatalf("invalid method %d", method)
+		}
+
+		if method == http.MethodUnknown || staticEntry != 0 {
+			return
+		}
+
+		// a known literal method is only returned when its encoded form fits in the buffer
+		if int(length) > len(tx.Stream.Request_method.Raw_buffer) {
+			t.Fatalf("method %s returned for length %d", method, length)
+		}
+	})
+}
+
+func TestHTTP2Scheme(t *testing.T) {
+	huffmanHTTPS := hpack.AppendHuffmanString(nil, "https")
+
+	tests := []struct {
+		name   string
+		scheme http2Scheme
+		want   string
+		found  bool
+	}{
+		{
+			name:   "static table https",
+			scheme: http2Scheme{Static_table_entry: HTTPSScheme, Finalized: true},
+			want:   "https",
+			found:  true,
+		},
+		{
+			name:   "static table http",
+			scheme: http2Scheme{Static_table_entry: HTTPScheme, Finalized: true},
+			want:   "http",
+			found:  true,
+		},
+		{
+			name: "literal",
+			scheme: http2Scheme{
+				Raw_buffer: [5]uint8{'h', 't', 't', 'p', 's'},
+				Length:     5,
+				Finalized:  true,
+			},
+			want:  "https",
+			found: true,
+		},
+		{
+			name: "huffman encoded",
+			scheme: http2Scheme{
+				Raw_buffer:         [5]uint8(append(huffmanHTTPS, make([]byte, 5-len(huffmanHTTPS))...)),
+				Is_huffman_encoded: true,
+				Length:             uint8(len(huffmanHTTPS)),
+				Finalized:          true,
+			},
+			want:  "https",
+			found: true,
+		},
+		{
+			name: "missing scheme",
+		},
+		{
+			name:   "length bigger than the buffer",
+			scheme: http2Scheme{Length: 6},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &EbpfTx{
+				Stream: HTTP2Stream{Scheme: tt.scheme},
+			}
+			scheme, found := tx.Scheme()
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, scheme)
+		})
+	}
+}
+
+func TestHTTP2CorrelationKey(t *testing.T) {
+	clientToServer := ConnTuple{
+		Saddr_l: 0x0100007f,
+		Daddr_l: 0x0200007f,
+		Sport:   54321,
+		Dport:   8080,
+	}
+	serverToClient := ConnTuple{
+		Saddr_l: clientToServer.Daddr_l,
+		Daddr_l: clientToServer.Saddr_l,
+		Sport:   clientToServer.Dport,
+		Dport:   clientToServer.Sport,
+	}
+
+	request := &EbpfTx{Tuple: clientToServer, Id: 3}
+	response := &EbpfTx{Tuple: serverToClient, Id: 3}
+	other := &EbpfTx{Tuple: clientToServer, Id: 5}
+
+	t.Run("stream id", func(t *testing.T) {
+		assert.Equal(t, uint32(3), request.StreamID())
+		assert.Equal(t, uint32(5), other.StreamID())
+	})
+
+	t.Run("same stream", func(t *testing.T) {
+		assert.Equal(t, request.CorrelationKey(), response.CorrelationKey())
+		assert.Equal(t, request.CorrelationKey(), request.CorrelationKey())
+	})
+
+	t.Run("different streams", func(t *testing.T) {
+		assert.NotEqual(t, request.CorrelationKey(), other.CorrelationKey())
+		assert.NotEqual(t, response.CorrelationKey(), other.CorrelationKey())
+	})
+
+	t.Run("same address different ports", func(t *testing.T) {
+		tuple := ConnTuple{Saddr_l: 0x0100007f, Daddr_l: 0x0100007f, Sport: 8080, Dport: 54321}
+		flipped := ConnTuple{Saddr_l: 0x0100007f, Daddr_l: 0x0100007f, Sport: 54321, Dport: 8080}
+
+		keys := map[CorrelationKey]struct{}{
+			(&EbpfTx{Tuple: tuple, Id: 1}).CorrelationKey():   {},
+			(&EbpfTx{Tuple: flipped, Id: 1}).CorrelationKey(): {},
+		}
+		assert.Len(t, keys, 1)
+	})
+}
+
+func TestHTTP2BodyBytes(t *testing.T) {
+	tests := []struct {
+		name             string
+		stream           HTTP2Stream
+		expectedRequest  uint64
+		expectedResponse uint64
+	}{
+		{
+			name: "unknown sizes",
+		},
+		{
+			name:             "request and response bodies",
+			stream:           HTTP2Stream{Request_body_bytes: 512, Response_body_bytes: 16384},
+			expectedRequest:  512,
+			expectedResponse: 16384,
+		},
+		{
+			name:             "response body only",
+			stream:           HTTP2Stream{Response_body_bytes: 42},
+			expectedResponse: 42,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &EbpfTx{Stream: tt.stream}
+			assert.Equal(t, tt.expectedRequest, tx.RequestBodyBytes())
+			assert.Equal(t, tt.expectedResponse, tx.ResponseBodyBytes())
+		})
+	}
+}
+
+func TestHTTP2Duration(t *testing.T) {
+	tests := []struct {
+		name     string
+		stream   HTTP2Stream
+		expected time.Duration
+	}{
+		{
+			name:     "request and response",
+			stream:   HTTP2Stream{Request_started: 1_000_000, Response_last_seen: 1_000_000 + uint64(25*time.Millisecond)},
+			expected: 25 * time.Millisecond,
+		},
+		{
+			name:   "request only",
+			stream: HTTP2Stream{Request_started: 1_000_000},
+		},
+		{
+			name:   "response only",
+			stream: HTTP2Stream{Response_last_seen: 1_000_000},
+		},
+		{
+			name:   "out of order timestamps",
+			stream: HTTP2Stream{Request_started: 2_000_000, Response_last_seen: 1_000_000},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tx := &EbpfTx{Stream: tt.stream}
+			assert.Equal(t, tt.expected, tx.Duration())
+			assert.Equal(t, protocols.NSTimestampToFloat(uint64(tt.expected)), tx.RequestLatency())
+		})
+	}
+}
+
+func TestHTTP2Aborted(t *testing.T) {
+	const rawPath = "/hello.HelloService/SayHello"
+
+	buf := hpack.AppendHuffmanString(nil, rawPath)
+	stream := HTTP2Stream{
+		Path: http2Path{
+			Is_huffman_encoded: true,
+			Length:             uint8(len(buf)),
+			Finalized:          true,
+		},
+		Request_method: http2requestMethod{Static_table_entry: GetValue, Finalized: true},
+	}
+	copy(stream.Path.Raw_buffer[:], buf)
+
+	t.Run("completed", func(t *testing.T) {
+		tx := &EbpfTx{Stream: stream}
+		assert.False(t, tx.Aborted())
+	})
+
+	t.Run("aborted", func(t *testing.T) {
+		aborted := stream
+		aborted.Aborted = true
+		tx := &EbpfTx{Stream: aborted}
+		assert.True(t, tx.Aborted())
+
+		path, ok := tx.Path(make([]byte, http.BufferSize))
+		require.True(t, ok)
+		assert.Equal(t, rawPath, string(path))
+		assert.Equal(t, http.MethodGet, tx.Method())
+	})
+}
+
+func TestHTTP2Header(t *testing.T) {
+	literal := func(value string, huffmanEnabled bool) ([]byte, uint8) {
+		buf := []byte(value)
+		if huffmanEnabled {
+			buf = hpack.AppendHuffmanString(nil, value)
+		}
+		return buf, uint8(len(buf))
+	}
+
+	t.Run(":path", func(t *testing.T) {
+		tx := &EbpfTx{}
+		buf, length := literal("/hello.HelloService/SayHello?id=1", true)
+		copy(tx.Stream.Path.Raw_buffer[:], buf)
+		tx.Stream.Path.Length = length
+		tx.Stream.Path.Is_huffman_encoded = true
+
+		value, ok := tx.Header(":path")
+		require.True(t, ok)
+		assert.Equal(t, "/hello.HelloService/SayHello?id=1", string(value))
+
+		path, ok := tx.Path(make([]byte, http.BufferSize))
+		require.True(t, ok)
+		assert.Equal(t, "/hello.HelloService/SayHello", string(path))
+
+		tx = &EbpfTx{}
+		tx.Stream.Path.Static_table_entry = IndexPathValue
+		value, ok = tx.Header(":PATH")
+		require.True(t, ok)
+		assert.Equal(t, "/index.html", string(value))
+	})
+
+	t.Run(":method", func(t *testing.T) {
+		tx := &EbpfTx{}
+		tx.Stream.Request_method.Static_table_entry = PostValue
+		value, ok := tx.Header(":method")
+		require.True(t, ok)
+		assert.Equal(t, "POST", string(value))
+		assert.Equal(t, http.MethodPost, tx.Method())
+
+		tx = &EbpfTx{}
+		buf, length := literal("PATCH", false)
+		copy(tx.Stream.Request_method.Raw_buffer[:], buf)
+		tx.Stream.Request_method.Length = length
+		value, ok = tx.Header(":method")
+		require.True(t, ok)
+		assert.Equal(t, "PATCH", string(value))
+		assert.Equal(t, http.MethodPatch, tx.Method())
+
+		// a static table entry of another header isn't a method
+		tx = &EbpfTx{}
+		tx.Stream.Request_method.Static_table_entry = K200Value
+		_, ok = tx.Header(":method")
+		assert.False(t, ok)
+	})
+
+	t.Run(":scheme", func(t *testing.T) {
+		tx := &EbpfTx{}
+		buf, length := literal("grpc", true)
+		copy(tx.Stream.Scheme.Raw_buffer[:], buf)
+		tx.Stream.Scheme.Length = length
+		tx.Stream.Scheme.Is_huffman_encoded = true
+
+		value, ok := tx.Header(":scheme")
+		require.True(t, ok)
+		assert.Equal(t, "grpc", string(value))
+
+		// a literal longer than the captured buffer is rejected
+		tx.Stream.Scheme.Length = uint8(len(tx.Stream.Scheme.Raw_buffer) + 1)
+		_, ok = tx.Header(":scheme")
+		assert.False(t, ok)
+	})
+
+	t.Run(":status", func(t *testing.T) {
+		tx := &EbpfTx{}
+		buf, _ := literal("201", true)
+		copy(tx.Stream.Status_code.Raw_buffer[:], buf)
+		tx.Stream.Status_code.Is_huffman_encoded = true
+
+		value, ok := tx.Header(":status")
+		require.True(t, ok)
+		assert.Equal(t, "201", string(value))
+		assert.Equal(t, uint16(201), tx.StatusCode())
+	})
+
+	t.Run("dynamic table", func(t *testing.T) {
+		// the references to the dynamic table are resolved to their literal in eBPF
+		tx := &EbpfTx{}
+		buf, length := literal("/hello.HelloService/SayHello", true)
+		copy(tx.Stream.Path.Raw_buffer[:], buf)
+		tx.Stream.Path.Length = length
+		tx.Stream.Path.Is_huffman_encoded = true
+		tx.Stream.Path.From_dynamic_table = true
+
+		value, ok := tx.Header(":path")
+		require.True(t, ok)
+		assert.Equal(t, "/hello.HelloService/SayHello", string(value))
+		assert.Equal(t, PathSourceDynamicTable, tx.PathSource())
+	})
+
+	t.Run("exact case", func(t *testing.T) {
+		tx := &EbpfTx{}
+		tx.Stream.Status_code.Static_table_entry = K200Value
+
+		value, ok := tx.Header(":Status")
+		require.True(t, ok)
+		assert.Equal(t, "200", string(value))
+
+		_, ok = tx.HeaderWithOptions(":Status", HeaderOptions{ExactCaseNames: true})
+		assert.False(t, ok)
+
+		value, ok = tx.HeaderWithOptions(":status", HeaderOptions{ExactCaseNames: true})
+		require.True(t, ok)
+		assert.Equal(t, "200", string(value))
+	})
+
+	t.Run("user-agent", func(t *testing.T) {
+		// the user-agent header isn't captured in eBPF
+		_, ok := (&EbpfTx{}).Header("user-agent")
+		assert.False(t, ok)
+	})
+}
+
+func TestHTTP2StreamState(t *testing.T) {
+	tests := []struct {
+		name     string
+		state    uint8
+		expected StreamState
+		str      string
+	}{
+		{name: "unknown", state: 0, expected: StreamStateUnknown, str: "unknown"},
+		{name: "idle", state: uint8(StreamStateIdle), expected: StreamStateIdle, str: "idle"},
+		{name: "open", state: uint8(StreamStateOpen), expected: StreamStateOpen, str: "open"},
+		{name: "half-closed-local", state: uint8(StreamStateHalfClosedLocal), expected: StreamStateHalfClosedLocal, str: "half_closed_local"},
+		{name: "half-closed-remote", state: uint8(StreamStateHalfClosedRemote), expected: StreamStateHalfClosedRemote, str: "half_closed_remote"},
+		{name: "closed", state: uint8(StreamStateClosed), expected: StreamStateClosed, str: "closed"},
+		{name: "out-of-range", state: 42, expected: StreamStateUnknown, str: "unknown"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tx := &EbpfTx{Stream: HTTP2Stream{State: test.state}}
+			assert.Equal(t, test.expected, tx.State())
+			assert.Equal(t, test.str, tx.State().String())
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/network/protocols"
 	"github.com/DataDog/datadog-agent/pkg/network/protocols/events"
 	"github.com/DataDog/datadog-agent/pkg/network/protocols/http"
+	libtelemetry "github.com/DataDog/datadog-agent/pkg/network/protocols/telemetry"
 	"github.com/DataDog/datadog-agent/pkg/network/usm/buildmode"
 	"github.com/DataDog/datadog-agent/pkg/network/usm/utils"
 	"github.com/DataDog/datadog-agent/pkg/util/log"
@@ -42,6 +43,10 @@ type Protocol struct {
 	kernelTelemetryStopChannel chan struct{}
 
 	dynamicTable *DynamicTable
+
+	// goAwayAbortedStreams counts the in-flight streams aborted when flushing the in-flight map, as they were above
+	// the last stream id of a GOAWAY frame, but out of the window of streams the kernel aborts.
+	goAwayAbortedStreams *libtelemetry.Counter
 }
 
 const (
@@ -50,6 +55,8 @@ const (
 	incompleteFramesTable     = "http2_incomplete_frames"
 	dynamicTable              = "http2_dynamic_table"
 	dynamicTableCounter       = "http2_dynamic_counter_table"
+	goAwayLastStreamIDTable   = "http2_goaway_last_stream_id"
+	splitHeadersTable         = "http2_split_headers"
 	http2IterationsTable      = "http2_iterations"
 	tlsHTTP2IterationsTable   = "tls_http2_iterations"
 	firstFrameHandlerTailCall = "socket__http2_handle_first_frame"
@@ -83,6 +90,12 @@ var Spec = &protocols.ProtocolSpec{
 		{
 			Name: dynamicTableCounter,
 		},
+		{
+			Name: goAwayLastStreamIDTable,
+		},
+		{
+			Name: splitHeadersTable,
+		},
 		{
 			Name: http2IterationsTable,
 		},
@@ -104,6 +117,9 @@ var Spec = &protocols.ProtocolSpec{
 		{
 			Name: "http2_ctx_heap",
 		},
+		{
+			Name: "http2_split_header_heap",
+		},
 		{
 			Name: "http2_batch_events",
 		},
@@ -222,6 +238,7 @@ func newHTTP2Protocol(cfg *config.Config) (protocols.Protocol, error) {
 		http2Telemetry:             http2KernelTelemetry,
 		kernelTelemetryStopChannel: make(chan struct{}),
 		dynamicTable:               NewDynamicTable(cfg),
+		goAwayAbortedStreams:       libtelemetry.NewCounter("usm.http2.goaway_aborted_on_flush", libtelemetry.OptPrometheus),
 	}, nil
 }
 
@@ -237,6 +254,7 @@ const (
 // ConfigureOptions add the necessary options for http2 monitoring to work,
 // to be used by the manager. These are:
 // - Set the `http2_in_flight` map size to the value of the `max_tracked_connection` configuration variable.
+// - Bound the dynamic table of the connections to the value of the `http2_max_dynamic_table_size` configuration variable.
 //
 // We also configure the http2 event stream with the manager and its options.
 func (p *Protocol) ConfigureOptions(mgr *manager.Manager, opts *manager.Options) {
@@ -256,6 +274,14 @@ func (p *Protocol) ConfigureOptions(mgr *manager.Manager, opts *manager.Options)
 		MaxEntries: p.cfg.MaxUSMConcurrentRequests,
 		EditorFlag: manager.EditMaxEntries,
 	}
+	opts.MapSpecEditors[goAwayLastStreamIDTable] = manager.MapSpecEditor{
+		MaxEntries: p.cfg.MaxUSMConcurrentRequests,
+		EditorFlag: manager.EditMaxEntries,
+	}
+	opts.MapSpecEditors[splitHeadersTable] = manager.MapSpecEditor{
+		MaxEntries: p.cfg.MaxUSMConcurrentRequests,
+		EditorFlag: manager.EditMaxEntries,
+	}
 	opts.MapSpecEditors[http2IterationsTable] = manager.MapSpecEditor{
 		MaxEntries: mapSizeValue,
 		EditorFlag: manager.EditMaxEntries,
@@ -265,6 +291,11 @@ func (p *Protocol) ConfigureOptions(mgr *manager.Manager, opts *manager.Options)
 		EditorFlag: manager.EditMaxEntries,
 	}
 
+	opts.ConstantEditors = append(opts.ConstantEditors, manager.ConstantEditor{
+		Name:  "http2_max_dynamic_table_entries",
+		Value: maxDynamicTableEntries(p.cfg.HTTP2MaxDynamicTableSize),
+	})
+
 	utils.EnableOption(opts, "http2_monitoring_enabled")
 	utils.EnableOption(opts, "terminated_http2_monitoring_enabled")
 	// Configure event stream
@@ -407,8 +438,38 @@ func (p *Protocol) setupHTTP2InFlightMapCleaner(mgr *manager.Manager) {
 		return
 	}
 
+	goAwayMap, _, err := mgr.GetMap(goAwayLastStreamIDTable)
+	if err != nil {
+		log.Errorf("error getting %q map: %s", goAwayLastStreamIDTable, err)
+		return
+	}
+
 	ttl := p.cfg.HTTPIdleConnectionTTL.Nanoseconds()
-	mapCleaner.Clean(p.cfg.HTTP2DynamicTableMapCleanerInterval, nil, nil, func(now int64, _ HTTP2StreamKey, val HTTP2Stream) bool {
+	// goAwayLastStreamIDs holds the last stream id of the GOAWAY frames seen on the connections, loaded before each
+	// scan of the in-flight map.
+	goAwayLastStreamIDs := make(map[ConnTuple]uint32)
+	loadGoAwayLastStreamIDs := func() bool {
+		var tup ConnTuple
+		var lastStreamID uint32
+		iter := goAwayMap.Iterate()
+		for iter.Next(unsafe.Pointer(&tup), unsafe.Pointer(&lastStreamID)) {
+			goAwayLastStreamIDs[tup] = lastStreamID
+		}
+		if err := iter.Err(); err != nil {
+			log.Warnf("unable to iterate %q map: %s", goAwayLastStreamIDTable, err)
+		}
+		return true
+	}
+	resetGoAwayLastStreamIDs := func() {
+		clear(goAwayLastStreamIDs)
+	}
+
+	mapCleaner.Clean(p.cfg.HTTP2DynamicTableMapCleanerInterval, loadGoAwayLastStreamIDs, resetGoAwayLastStreamIDs, func(now int64, key HTTP2StreamKey, val HTTP2Stream) bool {
+		if lastStreamID, ok := goAwayLastStreamIDs[key.Tup]; ok && key.Id > lastStreamID {
+			p.abortStream(now, key, val)
+			return true
+		}
+
 		if updated := int64(val.Response_last_seen); updated > 0 {
 			return (now - updated) > ttl
 		}
@@ -420,6 +481,27 @@ func (p *Protocol) setupHTTP2InFlightMapCleaner(mgr *manager.Manager) {
 	p.http2InFlightMapCleaner = mapCleaner
 }
 
+// abortStream reports the given in-flight stream as aborted, as it is above the last stream id of a GOAWAY frame of its
+// connection and will never see an end of stream. Like in the kernel, only the streams having a complete request are
+// reported.
+func (p *Protocol) abortStream(now int64, key HTTP2StreamKey, stream HTTP2Stream) {
+	if !stream.Request_method.Finalized || !stream.Path.Finalized {
+		return
+	}
+
+	stream.Response_last_seen = uint64(now)
+	stream.Aborted = true
+	stream.State = uint8(StreamStateClosed)
+	tx := &EbpfTx{
+		Tuple:  key.Tup,
+		Stream: stream,
+		Id:     key.Id,
+	}
+	p.goAwayAbortedStreams.Add(1)
+	p.telemetry.Count(tx)
+	p.statkeeper.Process(tx)
+}
+
 // GetStats returns a map of HTTP2 stats stored in the following format:
 // [source, dest tuple, request path] -> RequestStats object
 func (p *Protocol) GetStats() *protocols.ProtocolStats {
@@ -26,6 +26,8 @@ type kernelTelemetry struct {
 	endOfStream *libtelemetry.TLSAwareCounter
 	// endOfStreamRST Count of RST flags seen
 	endOfStreamRST *libtelemetry.TLSAwareCounter
+	// endOfStreamGoAway Count of streams aborted by a GOAWAY frame
+	endOfStreamGoAway *libtelemetry.TLSAwareCounter
 	// pathSizeBucket Count of path sizes divided into buckets.
 	pathSizeBucket [http2PathBuckets + 1]*libtelemetry.TLSAwareCounter
 	// literalValueExceedsFrame Count of times we couldn't retrieve the literal value due to reaching the end of the frame.
@@ -34,6 +36,8 @@ type kernelTelemetry struct {
 	exceedingMaxInterestingFrames *libtelemetry.TLSAwareCounter
 	// exceedingMaxFramesToFilter Count of times we have left with more frames to filter than the max number of frames to filter.
 	exceedingMaxFramesToFilter *libtelemetry.TLSAwareCounter
+	// headerBlockSplit Count of header blocks we couldn't decode entirely due to a header field spanning two frames.
+	headerBlockSplit *libtelemetry.TLSAwareCounter
 	// fragmentedFrameCountRST Count of times we have seen a fragmented RST frame.
 	fragmentedFrameCountRST *libtelemetry.TLSAwareCounter
 	// fragmentedHeadersFrameEOSCount Count of times we have seen a fragmented headers frame with EOS.
@@ -55,9 +59,11 @@ func newHTTP2KernelTelemetry() *kernelTelemetry {
 		http2responses:                 libtelemetry.NewTLSAwareCounter(metricGroup, "responses"),
 		endOfStream:                    libtelemetry.NewTLSAwareCounter(metricGroup, "eos"),
 		endOfStreamRST:                 libtelemetry.NewTLSAwareCounter(metricGroup, "rst"),
+		endOfStreamGoAway:              libtelemetry.NewTLSAwareCounter(metricGroup, "goaway"),
 		literalValueExceedsFrame:       libtelemetry.NewTLSAwareCounter(metricGroup, "literal_value_exceeds_frame"),
 		exceedingMaxInterestingFrames:  libtelemetry.NewTLSAwareCounter(metricGroup, "exceeding_max_interesting_frames"),
 		exceedingMaxFramesToFilter:     libtelemetry.NewTLSAwareCounter(metricGroup, "exceeding_max_frames_to_filter"),
+		headerBlockSplit:               libtelemetry.NewTLSAwareCounter(metricGroup, "header_block_split"),
 		fragmentedDataFrameEOSCount:    libtelemetry.NewTLSAwareCounter(metricGroup, "exceeding_data_end_data_eos"),
 		fragmentedHeadersFrameCount:    libtelemetry.NewTLSAwareCounter(metricGroup, "exceeding_data_end_headers"),
 		fragmentedHeadersFrameEOSCount: libtelemetry.NewTLSAwareCounter(metricGroup, "exceeding_data_end_headers_eos"),
@@ -78,9 +84,11 @@ func (t *kernelTelemetry) update(tel *HTTP2Telemetry, isTLS bool) {
 	t.http2responses.Add(int64(telemetryDelta.Response_seen), isTLS)
 	t.endOfStream.Add(int64(telemetryDelta.End_of_stream), isTLS)
 	t.endOfStreamRST.Add(int64(telemetryDelta.End_of_stream_rst), isTLS)
+	t.endOfStreamGoAway.Add(int64(telemetryDelta.End_of_stream_goaway), isTLS)
 	t.literalValueExceedsFrame.Add(int64(telemetryDelta.Literal_value_exceeds_frame), isTLS)
 	t.exceedingMaxInterestingFrames.Add(int64(telemetryDelta.Exceeding_max_interesting_frames), isTLS)
 	t.exceedingMaxFramesToFilter.Add(int64(telemetryDelta.Exceeding_max_frames_to_filter), isTLS)
+	t.headerBlockSplit.Add(int64(telemetryDelta.Header_block_split), isTLS)
 	for bucketIndex := range t.pathSizeBucket {
 		t.pathSizeBucket[bucketIndex].Add(int64(telemetryDelta.Path_size_bucket[bucketIndex]), isTLS)
 	}
@@ -101,9 +109,11 @@ func (t *HTTP2Telemetry) Sub(other HTTP2Telemetry) *HTTP2Telemetry {
 		Response_seen:                    t.Response_seen - other.Response_seen,
 		End_of_stream:                    t.End_of_stream - other.End_of_stream,
 		End_of_stream_rst:                t.End_of_stream_rst - other.End_of_stream_rst,
+		End_of_stream_goaway:             t.End_of_stream_goaway - other.End_of_stream_goaway,
 		Literal_value_exceeds_frame:      t.Literal_value_exceeds_frame - other.Literal_value_exceeds_frame,
 		Exceeding_max_interesting_frames: t.Exceeding_max_interesting_frames - other.Exceeding_max_interesting_frames,
 		Exceeding_max_frames_to_filter:   t.Exceeding_max_frames_to_filter - other.Exceeding_max_frames_to_filter,
+		Header_block_split:               t.Header_block_split - other.Header_block_split,
 		Path_size_bucket:                 computePathSizeBucketDifferences(t.Path_size_bucket, other.Path_size_bucket),
 	}
 }
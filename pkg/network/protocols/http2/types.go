@@ -32,6 +32,7 @@ type HTTP2DynamicTableEntry C.dynamic_table_entry_t
 type HTTP2StreamKey C.http2_stream_key_t
 type http2StatusCode C.status_code_t
 type http2requestMethod C.method_t
+type http2Scheme C.scheme_t
 type http2Path C.path_t
 type HTTP2Stream C.http2_stream_t
 type EbpfTx C.http2_event_t
@@ -39,12 +40,15 @@ type HTTP2Telemetry C.http2_telemetry_t
 type HTTP2IncompleteFrameEntry C.incomplete_frame_t
 
 type StaticTableEnumValue = C.static_table_value_t
+type StreamState C.http2_stream_state_t
 
 const (
 	GetValue       StaticTableEnumValue = C.kGET
 	PostValue      StaticTableEnumValue = C.kPOST
 	EmptyPathValue StaticTableEnumValue = C.kEmptyPath
 	IndexPathValue StaticTableEnumValue = C.kIndexPath
+	HTTPScheme     StaticTableEnumValue = C.kHTTPScheme
+	HTTPSScheme    StaticTableEnumValue = C.kHTTPSScheme
 	K200Value      StaticTableEnumValue = C.k200
 	K204Value      StaticTableEnumValue = C.k204
 	K206Value      StaticTableEnumValue = C.k206
@@ -53,3 +57,12 @@ const (
 	K404Value      StaticTableEnumValue = C.k404
 	K500Value      StaticTableEnumValue = C.k500
 )
+
+const (
+	StreamStateUnknown          StreamState = C.kStreamStateUnknown
+	StreamStateIdle             StreamState = C.kStreamStateIdle
+	StreamStateOpen             StreamState = C.kStreamStateOpen
+	StreamStateHalfClosedLocal  StreamState = C.kStreamStateHalfClosedLocal
+	StreamStateHalfClosedRemote StreamState = C.kStreamStateHalfClosedRemote
+	StreamStateClosed           StreamState = C.kStreamStateClosed
+)
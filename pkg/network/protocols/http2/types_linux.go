@@ -54,35 +54,54 @@ type http2requestMethod struct {
 	Length             uint8
 	Finalized          bool
 }
+type http2Scheme struct {
+	Raw_buffer         [5]uint8
+	Is_huffman_encoded bool
+	Static_table_entry uint8
+	Length             uint8
+	Finalized          bool
+}
 type http2Path struct {
 	Raw_buffer         [160]uint8
 	Is_huffman_encoded bool
 	Static_table_entry uint8
 	Length             uint8
 	Finalized          bool
+	From_dynamic_table bool
 }
 type HTTP2Stream struct {
-	Response_last_seen uint64
-	Request_started    uint64
-	Tags               uint8
-	Status_code        http2StatusCode
-	Request_method     http2requestMethod
-	Path               http2Path
-	End_of_stream_seen bool
-	Pad_cgo_0          [1]byte
+	Response_last_seen    uint64
+	Request_started       uint64
+	Request_body_bytes    uint64
+	Response_body_bytes   uint64
+	Tags                  uint8
+	Status_code           http2StatusCode
+	Request_method        http2requestMethod
+	Scheme                http2Scheme
+	Path                  http2Path
+	End_of_stream_seen    bool
+	Aborted               bool
+	State                 uint8
+	End_of_stream_pending bool
+	Header_block_split    bool
+	Pad_cgo_0             [3]byte
 }
 type EbpfTx struct {
-	Tuple  ConnTuple
-	Stream HTTP2Stream
+	Tuple     ConnTuple
+	Stream    HTTP2Stream
+	Id        uint32
+	Pad_cgo_0 [4]byte
 }
 type HTTP2Telemetry struct {
 	Request_seen                     uint64
 	Response_seen                    uint64
 	End_of_stream                    uint64
 	End_of_stream_rst                uint64
+	End_of_stream_goaway             uint64
 	Literal_value_exceeds_frame      uint64
 	Exceeding_max_interesting_frames uint64
 	Exceeding_max_frames_to_filter   uint64
+	Header_block_split               uint64
 	Path_size_bucket                 [8]uint64
 }
 type HTTP2IncompleteFrameEntry struct {
@@ -93,12 +112,15 @@ type HTTP2IncompleteFrameEntry struct {
 }
 
 type StaticTableEnumValue = uint8
+type StreamState uint8
 
 const (
 	GetValue       StaticTableEnumValue = 0x2
 	PostValue      StaticTableEnumValue = 0x3
 	EmptyPathValue StaticTableEnumValue = 0x4
 	IndexPathValue StaticTableEnumValue = 0x5
+	HTTPScheme     StaticTableEnumValue = 0x6
+	HTTPSScheme    StaticTableEnumValue = 0x7
 	K200Value      StaticTableEnumValue = 0x8
 	K204Value      StaticTableEnumValue = 0x9
 	K206Value      StaticTableEnumValue = 0xa
@@ -107,3 +129,12 @@ const (
 	K404Value      StaticTableEnumValue = 0xd
 	K500Value      StaticTableEnumValue = 0xe
 )
+
+const (
+	StreamStateUnknown          StreamState = 0x0
+	StreamStateIdle             StreamState = 0x1
+	StreamStateOpen             StreamState = 0x2
+	StreamStateHalfClosedLocal  StreamState = 0x3
+	StreamStateHalfClosedRemote StreamState = 0x4
+	StreamStateClosed           StreamState = 0x5
+)
@@ -748,6 +748,28 @@ func (s *usmHTTP2Suite) TestRawTraffic() {
 			},
 			expectedEndpoints: nil,
 		},
+		{
+			name: "validate client GOAWAY",
+			// The purpose of this test is to validate that a GOAWAY frame sent by the client doesn't abort the in-flight
+			// requests, its last stream id referring to the streams pushed by the server.
+			messageBuilder: func() [][]byte {
+				const iterations = 5
+				framer := newFramer()
+				for i := 0; i < iterations; i++ {
+					streamID := getStreamID(i)
+					framer.
+						writeHeaders(t, streamID, usmhttp2.HeadersFrameOptions{Headers: testHeaders()}).
+						writeData(t, streamID, endStream, emptyBody)
+				}
+				return [][]byte{framer.writeGoAway(t, 0, http2.ErrCodeNo).bytes()}
+			},
+			expectedEndpoints: map[usmhttp.Key]int{
+				{
+					Path:   usmhttp.Path{Content: usmhttp.Interner.GetString(http2DefaultTestPath)},
+					Method: usmhttp.MethodPost,
+				}: 5,
+			},
+		},
 		{
 			name: "validate various status codes",
 			// The purpose of this test is to verify that we support status codes that both do and
@@ -1171,9 +1193,8 @@ func (s *usmHTTP2Suite) TestRawTraffic() {
 		},
 		{
 			name: "validate CONTINUATION frame support",
-			// Testing the scenario in which part of the data frame header is sent using CONTINUATION frame.
-			// Currently, we do not support CONTINUATION frames, therefore, we expect to capture only the first
-			// part of the message.
+			// Testing the scenario in which part of the headers is sent using CONTINUATION frame, the path being
+			// held by the CONTINUATION frame.
 			messageBuilder: func() [][]byte {
 				const headersFrameEndHeaders = false
 				fullHeaders := generateTestHeaderFields(headersGenerationOptions{})
@@ -1193,7 +1214,84 @@ func (s *usmHTTP2Suite) TestRawTraffic() {
 						writeData(t, 1, endStream, emptyBody).bytes(),
 				}
 			},
-			expectedEndpoints: nil,
+			expectedEndpoints: map[usmhttp.Key]int{
+				{
+					Path:   usmhttp.Path{Content: usmhttp.Interner.GetString(http2DefaultTestPath)},
+					Method: usmhttp.MethodPost,
+				}: 1,
+			},
+		},
+		{
+			name: "validate END_STREAM flag of a HEADERS frame followed by a CONTINUATION frame",
+			// Testing the scenario in which the END_STREAM flag is set on a HEADERS frame whose header block ends in
+			// a CONTINUATION frame sent in a different packet. The stream ends with the header block, after its
+			// path was decoded.
+			messageBuilder: func() [][]byte {
+				const headersFrameEndHeaders = false
+				fullHeaders := generateTestHeaderFields(headersGenerationOptions{})
+				prefixHeadersFrame, err := usmhttp2.NewHeadersFrameMessage(usmhttp2.HeadersFrameOptions{
+					Headers: fullHeaders[:2],
+				})
+				require.NoError(t, err, "could not create prefix headers frame")
+
+				suffixHeadersFrame, err := usmhttp2.NewHeadersFrameMessage(usmhttp2.HeadersFrameOptions{
+					Headers: fullHeaders[2:],
+				})
+				require.NoError(t, err, "could not create suffix headers frame")
+
+				return [][]byte{
+					newFramer().writeRawHeadersWithEndStream(t, 1, headersFrameEndHeaders, prefixHeadersFrame).bytes(),
+					newFramer().writeRawContinuation(t, 1, endHeaders, suffixHeadersFrame).bytes(),
+				}
+			},
+			expectedEndpoints: map[usmhttp.Key]int{
+				{
+					Path:   usmhttp.Path{Content: usmhttp.Interner.GetString(http2DefaultTestPath)},
+					Method: usmhttp.MethodPost,
+				}: 1,
+			},
+		},
+		{
+			name: "validate header field split between HEADERS and CONTINUATION frames",
+			// Testing the scenario in which the path header field spans the HEADERS frame and the CONTINUATION frame,
+			// the block being split after its index and length octets. The path is reassembled from both fragments.
+			messageBuilder: func() [][]byte {
+				return splitPathHeaderBlock(t, 0, false)
+			},
+			expectedEndpoints: map[usmhttp.Key]int{
+				{
+					Path:   usmhttp.Path{Content: usmhttp.Interner.GetString(http2DefaultTestPath)},
+					Method: usmhttp.MethodPost,
+				}: 1,
+			},
+		},
+		{
+			name: "validate header field value split between HEADERS and CONTINUATION frames",
+			// Testing the scenario in which the value of the path header field spans the HEADERS frame and the
+			// CONTINUATION frame. The path is reassembled from both fragments.
+			messageBuilder: func() [][]byte {
+				return splitPathHeaderBlock(t, 1, false)
+			},
+			expectedEndpoints: map[usmhttp.Key]int{
+				{
+					Path:   usmhttp.Path{Content: usmhttp.Interner.GetString(http2DefaultTestPath)},
+					Method: usmhttp.MethodPost,
+				}: 1,
+			},
+		},
+		{
+			name: "validate header field value split between HEADERS and CONTINUATION frames in different packets",
+			// Testing the scenario in which the value of the path header field spans the HEADERS frame and the
+			// CONTINUATION frame, sent in different packets. The path is reassembled from both fragments.
+			messageBuilder: func() [][]byte {
+				return splitPathHeaderBlock(t, 1, true)
+			},
+			expectedEndpoints: map[usmhttp.Key]int{
+				{
+					Path:   usmhttp.Path{Content: usmhttp.Interner.GetString(http2DefaultTestPath)},
+					Method: usmhttp.MethodPost,
+				}: 1,
+			},
 		},
 		{
 			name: "validate message split into 2 tcp segments",
@@ -1287,6 +1385,7 @@ func (s *usmHTTP2Suite) TestDynamicTable() {
 	tests := []struct {
 		name                            string
 		skip                            bool
+		maxDynamicTableSize             uint32
 		messageBuilder                  func() []byte
 		expectedEndpoints               map[usmhttp.Key]int
 		expectedDynamicTablePathIndexes []int
@@ -1315,11 +1414,43 @@ func (s *usmHTTP2Suite) TestDynamicTable() {
 			},
 			expectedDynamicTablePathIndexes: []int{1, 7, 13, 19, 25, 31, 37, 43, 49, 55},
 		},
+		{
+			name: "dynamic table is bounded by the maximum size",
+			// The purpose of this test is to validate that the entries above the maximum number of entries of a
+			// dynamic table bounded to 1024 bytes (32 entries) are evicted, although the peer uses the default size
+			// of 4096 bytes.
+			maxDynamicTableSize: 1024,
+			messageBuilder: func() []byte {
+				const iterations = 10
+				framer := newFramer()
+
+				for i := 0; i < iterations; i++ {
+					streamID := getStreamID(i)
+					framer.
+						writeHeaders(t, streamID, usmhttp2.HeadersFrameOptions{Headers: testHeaders()}).
+						writeData(t, streamID, endStream, emptyBody)
+				}
+
+				return framer.bytes()
+			},
+			expectedEndpoints: map[usmhttp.Key]int{
+				{
+					Path:   usmhttp.Path{Content: usmhttp.Interner.GetString(http2DefaultTestPath)},
+					Method: usmhttp.MethodPost,
+				}: 10,
+			},
+			// 60 entries were added, only the last 32 ones are kept.
+			expectedDynamicTablePathIndexes: []int{31, 37, 43, 49, 55},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
+			cfg := *cfg
+			if tt.maxDynamicTableSize != 0 {
+				cfg.HTTP2MaxDynamicTableSize = tt.maxDynamicTableSize
+			}
 
-			usmMonitor := setupUSMTLSMonitor(t, cfg)
+			usmMonitor := setupUSMTLSMonitor(t, &cfg)
 			if s.isTLS {
 				utils.WaitForProgramsToBeTraced(t, consts.USMModuleName, GoTLSAttacherName, proxyProcess.Process.Pid, utils.ManualTracingFallbackEnabled)
 			}
@@ -1695,6 +1826,37 @@ func removeHeaderFieldByKey(headerFields []hpack.HeaderField, keyToRemove string
 	})
 }
 
+// splitPathHeaderBlock returns a request whose header block is split between a HEADERS and a CONTINUATION frame,
+// in the path header field. The path follows the :authority and :method fields, the block being split after its
+// index and length octets, and the given number of octets of its value.
+func splitPathHeaderBlock(t *testing.T, valueOctets int, separatePackets bool) [][]byte {
+	const headersFrameEndHeaders = false
+	fullHeaders := generateTestHeaderFields(headersGenerationOptions{})
+	prefixHeadersFrame, err := usmhttp2.NewHeadersFrameMessage(usmhttp2.HeadersFrameOptions{
+		Headers: fullHeaders[:2],
+	})
+	require.NoError(t, err, "could not create prefix headers frame")
+
+	headerBlock, err := usmhttp2.NewHeadersFrameMessage(usmhttp2.HeadersFrameOptions{
+		Headers: fullHeaders,
+	})
+	require.NoError(t, err, "could not create headers frame")
+
+	splitOffset := len(prefixHeadersFrame) + 2 + valueOctets
+	if separatePackets {
+		return [][]byte{
+			newFramer().writeRawHeaders(t, 1, headersFrameEndHeaders, headerBlock[:splitOffset]).bytes(),
+			newFramer().writeRawContinuation(t, 1, endHeaders, headerBlock[splitOffset:]).
+				writeData(t, 1, endStream, emptyBody).bytes(),
+		}
+	}
+	return [][]byte{
+		newFramer().writeRawHeaders(t, 1, headersFrameEndHeaders, headerBlock[:splitOffset]).
+			writeRawContinuation(t, 1, endHeaders, headerBlock[splitOffset:]).
+			writeData(t, 1, endStream, emptyBody).bytes(),
+	}
+}
+
 func getStreamID(streamID int) uint32 {
 	return uint32(streamID*2 + 1)
 }
@@ -1738,6 +1900,11 @@ func (f *framer) writeRSTStream(t *testing.T, streamID uint32, errCode http2.Err
 	return f
 }
 
+func (f *framer) writeGoAway(t *testing.T, lastStreamID uint32, errCode http2.ErrCode) *framer {
+	require.NoError(t, f.framer.WriteGoAway(lastStreamID, errCode, nil), "could not write GOAWAY")
+	return f
+}
+
 func (f *framer) writeData(t *testing.T, streamID uint32, endStream bool, buf []byte) *framer {
 	require.NoError(t, f.framer.WriteData(streamID, endStream, buf), "could not write data frame")
 	return f
@@ -1762,6 +1929,16 @@ func (f *framer) writeRawHeaders(t *testing.T, streamID uint32, endHeaders bool,
 	return f
 }
 
+func (f *framer) writeRawHeadersWithEndStream(t *testing.T, streamID uint32, endHeaders bool, headerFrames []byte) *framer {
+	require.NoError(t, f.framer.WriteHeaders(http2.HeadersFrameParam{
+		StreamID:      streamID,
+		BlockFragment: headerFrames,
+		EndStream:     true,
+		EndHeaders:    endHeaders,
+	}), "could not write header frames")
+	return f
+}
+
 func (f *framer) writeHeaders(t *testing.T, streamID uint32, headersFramesOptions usmhttp2.HeadersFrameOptions) *framer {
 	headersFrame, err := usmhttp2.NewHeadersFrameMessage(headersFramesOptions)
 	require.NoError(t, err, "could not create headers frame")
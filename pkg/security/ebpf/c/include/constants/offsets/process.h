@@ -39,4 +39,46 @@ u64 __attribute__((always_inline)) get_task_struct_pid_offset() {
     return task_struct_pid_offset;
 }
 
+u64 __attribute__((always_inline)) get_upid_ns_offset() {
+    u64 upid_ns_offset;
+    LOAD_CONSTANT("upid_ns_offset", upid_ns_offset);
+    return upid_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_task_struct_nsproxy_offset() {
+    u64 task_struct_nsproxy_offset;
+    LOAD_CONSTANT("task_struct_nsproxy_offset", task_struct_nsproxy_offset);
+    return task_struct_nsproxy_offset;
+}
+
+u64 __attribute__((always_inline)) get_mnt_namespace_ns_offset() {
+    u64 mnt_namespace_ns_offset;
+    LOAD_CONSTANT("mnt_namespace_ns_offset", mnt_namespace_ns_offset);
+    return mnt_namespace_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_pid_namespace_ns_offset() {
+    u64 pid_namespace_ns_offset;
+    LOAD_CONSTANT("pid_namespace_ns_offset", pid_namespace_ns_offset);
+    return pid_namespace_ns_offset;
+}
+
+u64 __attribute__((always_inline)) get_ns_common_inum_offset() {
+    u64 ns_common_inum_offset;
+    LOAD_CONSTANT("ns_common_inum_offset", ns_common_inum_offset);
+    return ns_common_inum_offset;
+}
+
+u64 __attribute__((always_inline)) get_task_struct_fs_offset() {
+    u64 task_struct_fs_offset;
+    LOAD_CONSTANT("task_struct_fs_offset", task_struct_fs_offset);
+    return task_struct_fs_offset;
+}
+
+u64 __attribute__((always_inline)) get_fs_struct_pwd_offset() {
+    u64 fs_struct_pwd_offset;
+    LOAD_CONSTANT("fs_struct_pwd_offset", fs_struct_pwd_offset);
+    return fs_struct_pwd_offset;
+}
+
 #endif
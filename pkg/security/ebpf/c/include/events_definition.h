@@ -66,6 +66,7 @@ struct process_event_t {
     struct process_entry_t proc_entry;
     struct pid_cache_t pid_entry;
     struct linux_binprm_t linux_binprm;
+    struct path_key_t cwd;
     u64 args_id;
     u64 envs_id;
     u32 args_truncated;
@@ -52,8 +52,11 @@ void __attribute__((always_inline)) copy_pid_cache_except_exit_ts(struct pid_cac
     dst->cookie = src->cookie;
     dst->user_session_id = src->user_session_id;
     dst->ppid = src->ppid;
+    dst->ns_pid = src->ns_pid;
     dst->fork_timestamp = src->fork_timestamp;
     dst->credentials = src->credentials;
+    dst->mnt_ns = src->mnt_ns;
+    dst->pid_ns = src->pid_ns;
 }
 
 struct proc_cache_t __attribute__((always_inline)) * get_proc_from_cookie(u64 cookie) {
@@ -146,6 +149,89 @@ u32 __attribute__((always_inline)) get_namespace_nr_from_task_struct(struct task
     return namespace_nr;
 }
 
+u32 __attribute__((always_inline)) get_inum_from_ns_common(void *ns_common) {
+    u64 ns_common_inum_offset = get_ns_common_inum_offset();
+
+    // no constant
+    if (ns_common_inum_offset == -1) {
+        return 0;
+    }
+
+    u32 inum = 0;
+    bpf_probe_read(&inum, sizeof(inum), ns_common + ns_common_inum_offset);
+    return inum;
+}
+
+u32 __attribute__((always_inline)) get_mnt_ns_from_task_struct(struct task_struct *task) {
+    u64 task_struct_nsproxy_offset = get_task_struct_nsproxy_offset();
+    u64 mnt_namespace_ns_offset = get_mnt_namespace_ns_offset();
+
+    // no constant
+    if (task_struct_nsproxy_offset == -1 || mnt_namespace_ns_offset == -1) {
+        return 0;
+    }
+
+    struct nsproxy *nsproxy = NULL;
+    bpf_probe_read(&nsproxy, sizeof(nsproxy), (void *)task + task_struct_nsproxy_offset);
+    if (nsproxy == NULL) {
+        return 0;
+    }
+
+    struct mnt_namespace *mnt_ns = NULL;
+    bpf_probe_read(&mnt_ns, sizeof(mnt_ns), &nsproxy->mnt_ns);
+    if (mnt_ns == NULL) {
+        return 0;
+    }
+
+    return get_inum_from_ns_common((void *)mnt_ns + mnt_namespace_ns_offset);
+}
+
+u32 __attribute__((always_inline)) get_pid_ns_from_task_struct(struct task_struct *task) {
+    u64 pid_namespace_ns_offset = get_pid_namespace_ns_offset();
+
+    // no constant
+    if (pid_namespace_ns_offset == -1) {
+        return 0;
+    }
+
+    struct pid *pid = NULL;
+    bpf_probe_read(&pid, sizeof(pid), (void *)task + get_task_struct_pid_offset());
+    if (pid == NULL) {
+        return 0;
+    }
+
+    u32 pid_level = 0;
+    bpf_probe_read(&pid_level, sizeof(pid_level), (void *)pid + get_pid_level_offset());
+
+    // read the namespace from &pid->numbers[pid_level].ns
+    struct pid_namespace *pid_ns = NULL;
+    u64 namespace_numbers_offset = pid_level * get_sizeof_upid();
+    bpf_probe_read(&pid_ns, sizeof(pid_ns), (void *)pid + get_pid_numbers_offset() + namespace_numbers_offset + get_upid_ns_offset());
+    if (pid_ns == NULL) {
+        return 0;
+    }
+
+    return get_inum_from_ns_common((void *)pid_ns + pid_namespace_ns_offset);
+}
+
+__attribute__((always_inline)) struct path *get_pwd_from_task_struct(struct task_struct *task) {
+    u64 task_struct_fs_offset = get_task_struct_fs_offset();
+    u64 fs_struct_pwd_offset = get_fs_struct_pwd_offset();
+
+    // no constant
+    if (task_struct_fs_offset == -1 || fs_struct_pwd_offset == -1) {
+        return NULL;
+    }
+
+    struct fs_struct *fs = NULL;
+    bpf_probe_read(&fs, sizeof(fs), (void *)task + task_struct_fs_offset);
+    if (fs == NULL) {
+        return NULL;
+    }
+
+    return (struct path *)((void *)fs + fs_struct_pwd_offset);
+}
+
 __attribute__((always_inline)) struct process_event_t *new_process_event(u8 is_fork) {
     u32 key = bpf_get_current_pid_tgid() % EVENT_GEN_SIZE;
     struct process_event_t *evt = bpf_map_lookup_elem(&process_event_gen, &key);
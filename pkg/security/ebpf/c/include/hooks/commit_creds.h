@@ -279,6 +279,7 @@ int hook_commit_creds(ctx_t *ctx) {
     bpf_probe_read(&pid_entry->credentials.fsgid, sizeof(pid_entry->credentials.fsgid), &credentials->fsgid);
     bpf_probe_read(&pid_entry->credentials.cap_effective, sizeof(pid_entry->credentials.cap_effective), &capabilities->cap_effective);
     bpf_probe_read(&pid_entry->credentials.cap_permitted, sizeof(pid_entry->credentials.cap_permitted), &capabilities->cap_permitted);
+    bpf_probe_read(&pid_entry->credentials.cap_ambient, sizeof(pid_entry->credentials.cap_ambient), &capabilities->cap_ambient);
 
     if (new_entry) {
         bpf_map_update_elem(&pid_cache, &pid, &new_pid_entry, BPF_ANY);
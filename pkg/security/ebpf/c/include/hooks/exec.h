@@ -122,11 +122,17 @@ int __attribute__((always_inline)) handle_do_fork(ctx_t *ctx) {
         if (exit_signal == SIGCHLD) {
             syscall.fork.is_thread = 0;
         }
+
+        // flags is the first field of struct kernel_clone_args
+        u64 flags = 0;
+        bpf_probe_read(&flags, sizeof(flags), args);
+        syscall.fork.is_new_pid_ns = (flags & CLONE_NEWPID) == CLONE_NEWPID;
     } else {
         u64 flags = (u64)CTX_PARM1(ctx);
         if ((flags & SIGCHLD) == SIGCHLD) {
             syscall.fork.is_thread = 0;
         }
+        syscall.fork.is_new_pid_ns = (flags & CLONE_NEWPID) == CLONE_NEWPID;
     }
 
     cache_syscall(&syscall);
@@ -217,6 +223,8 @@ int sched_process_fork(struct _tracepoint_sched_process_fork *args) {
     // only work with PID, we can't use the TID. This is why we use the PID generated by the eBPF context instead.
     u32 ppid = event->process.pid;
     event->pid_entry.ppid = ppid;
+    // the child task isn't available here, its namespaced pid is only known when it starts a new pid namespace
+    event->pid_entry.ns_pid = syscall->fork.is_new_pid_ns ? 1 : 0;
     // sched::sched_process_fork is triggered from the parent process, update the pid / tid to the child value
     event->process.pid = pid;
     event->process.tid = pid;
@@ -238,6 +246,10 @@ int sched_process_fork(struct _tracepoint_sched_process_fork *args) {
         // ensure pid and ppid have the same credentials
         event->pid_entry.credentials = parent_pid_entry->credentials;
 
+        // inherit the namespaces, the ones created by the clone call are captured at the next exec
+        event->pid_entry.mnt_ns = parent_pid_entry->mnt_ns;
+        event->pid_entry.pid_ns = parent_pid_entry->pid_ns;
+
         // fetch the parent proc cache entry
         u64 on_stack_cookie = event->pid_entry.cookie;
         struct proc_cache_t *parent_pc = get_proc_from_cookie(on_stack_cookie);
@@ -593,6 +605,36 @@ int hook_setup_new_exec_interp(ctx_t *ctx) {
     return fetch_interpreter(ctx, bprm);
 }
 
+HOOK_ENTRY("setup_new_exec")
+int hook_setup_new_exec_cwd(ctx_t *ctx) {
+    struct syscall_cache_t *syscall = peek_current_or_impersonated_exec_syscall();
+    if (!syscall) {
+        return 0;
+    }
+
+    struct path *pwd = get_pwd_from_task_struct((struct task_struct *)bpf_get_current_task());
+    if (pwd == NULL) {
+        return 0;
+    }
+
+    struct dentry *dentry = get_path_dentry(pwd);
+    syscall->exec.cwd = get_dentry_key_path(dentry, pwd);
+    syscall->exec.cwd.path_id = get_path_id(syscall->exec.cwd.mount_id, 0);
+
+    // add the working directory path to map/pathnames so that it can be resolved along with the executed file
+    syscall->resolver.key = syscall->exec.cwd;
+    syscall->resolver.dentry = dentry;
+    syscall->resolver.discarder_event_type = 0;
+    syscall->resolver.callback = DR_NO_CALLBACK;
+    syscall->resolver.iteration = 0;
+    syscall->resolver.ret = 0;
+
+    resolve_dentry(ctx, DR_KPROBE_OR_FENTRY);
+
+    // the exec event doesn't depend on the working directory, keep the syscall cache entry if the tail call fails
+    return 0;
+}
+
 HOOK_ENTRY("setup_new_exec")
 int hook_setup_new_exec_args_envs(ctx_t *ctx) {
     struct syscall_cache_t *syscall = peek_current_or_impersonated_exec_syscall();
@@ -725,6 +767,12 @@ int __attribute__((always_inline)) send_exec_event(ctx_t *ctx) {
     fill_container_context(&pc, &event->container);
     copy_proc_entry(&pc.entry, &event->proc_entry);
 
+    // capture the namespaced pid and the namespaces of the process at exec
+    struct task_struct *task = (struct task_struct *)bpf_get_current_task();
+    fork_entry->ns_pid = get_namespace_nr_from_task_struct(task);
+    fork_entry->mnt_ns = get_mnt_ns_from_task_struct(task);
+    fork_entry->pid_ns = get_pid_ns_from_task_struct(task);
+
     // copy pid_cache entry data
     copy_pid_cache_except_exit_ts(fork_entry, &event->pid_entry);
 
@@ -744,6 +792,9 @@ int __attribute__((always_inline)) send_exec_event(ctx_t *ctx) {
     // add interpreter path info
     event->linux_binprm.interpreter = syscall->exec.linux_binprm.interpreter;
 
+    // add the working directory path info
+    event->cwd = syscall->exec.cwd;
+
     // syscall context
     event->syscall_ctx.id = syscall->ctx_id;
 
@@ -29,16 +29,20 @@ struct credentials_t {
     u32 is_auid_set;
     u64 cap_effective;
     u64 cap_permitted;
+    u64 cap_ambient;
 };
 
 struct pid_cache_t {
     u64 cookie;
     u32 ppid;
-    u32 padding;
+    u32 ns_pid; // pid in its own pid namespace, 0 for a forked process until it executes unless it is the init of a new pid namespace
     u64 fork_timestamp;
     u64 exit_timestamp;
     u64 user_session_id;
     struct credentials_t credentials;
+    // namespaces of the process at its last exec, inherited on fork
+    u32 mnt_ns;
+    u32 pid_ns;
 };
 
 struct args_envs_t {
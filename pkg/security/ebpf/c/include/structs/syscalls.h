@@ -128,12 +128,14 @@ struct syscall_cache_t {
             struct args_envs_parsing_context_t args_envs_ctx;
             struct span_context_t span_context;
             struct linux_binprm_t linux_binprm;
+            struct path_key_t cwd;
             u8 is_parsed;
         } exec;
 
         struct {
             u32 is_thread;
             u32 is_kthread;
+            u32 is_new_pid_ns;
         } fork;
 
         struct {
@@ -98,6 +98,12 @@ func getExecProbes(fentry bool) []*manager.Probe {
 				EBPFFuncName: "hook_setup_new_exec_args_envs",
 			},
 		},
+		{
+			ProbeIdentificationPair: manager.ProbeIdentificationPair{
+				UID:          SecurityAgentUID + "_c",
+				EBPFFuncName: "hook_setup_new_exec_cwd",
+			},
+		},
 		{
 			ProbeIdentificationPair: manager.ProbeIdentificationPair{
 				UID:          SecurityAgentUID,
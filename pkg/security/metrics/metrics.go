@@ -153,6 +153,10 @@ var (
 	// MetricProcessResolverHits is the name of the metric used to report the process resolver cache hits
 	// Tags: type
 	MetricProcessResolverHits = newRuntimeMetric(".process_resolver.hits")
+	// MetricProcessResolverAncestorsLoop is the name of the metric used to report the ancestor walks interrupted
+	// because of a loop in the ancestors chain
+	// Tags: -
+	MetricProcessResolverAncestorsLoop = newRuntimeMetric(".process_resolver.ancestors_loop")
 	// MetricProcessResolverAdded is the name of the metric used to report the number of entries added in the cache
 	// Tags: -
 	MetricProcessResolverAdded = newRuntimeMetric(".process_resolver.added")
@@ -348,6 +352,17 @@ var (
 	// MetricRulesStatus is the name of the metric used to report the rule status
 	// Tags: -
 	MetricRulesStatus = newRuntimeMetric(".rules_status")
+	// MetricRulesDeprecatedField is the name of the metric used to report the number of loaded rules using each
+	// deprecated legacy field, sent when the rules are loaded
+	// Tags: field
+	MetricRulesDeprecatedField = newRuntimeMetric(".rules.deprecated_field")
+	// MetricRulesStringSetHits is the name of the metric used to count the matches of the entries of the string sets
+	// Tags: set, entry
+	MetricRulesStringSetHits = newRuntimeMetric(".rules.string_set.hits")
+	// MetricRulesEvalLatency is the name of the metric used to count the rule evaluations per latency bucket, the
+	// `le` tag being the upper bound of the bucket
+	// Tags: event_type, le
+	MetricRulesEvalLatency = newRuntimeMetric(".rules.eval_latency")
 
 	// Enforcement metrics
 
@@ -86,6 +86,12 @@ type Config struct {
 	// EnvsWithValue lists environnement variables that will be fully exported
 	EnvsWithValue []string
 
+	// SecretLikeEnvPatterns lists the patterns of the environment variable names that look like credentials
+	SecretLikeEnvPatterns []string
+
+	// ShellMetachars lists the shell metacharacters looked for in the process arguments
+	ShellMetachars []string
+
 	// RuntimeMonitor defines if the Go runtime and system monitor should be enabled
 	RuntimeMonitor bool
 
@@ -139,6 +145,9 @@ type Config struct {
 	// StatsPollingInterval determines how often metrics should be polled
 	StatsPollingInterval time.Duration
 
+	// SecurityLabelXAttrs defines the names of the extended attributes of the security namespace holding security labels
+	SecurityLabelXAttrs []string
+
 	// SyscallsMonitorEnabled defines if syscalls monitoring metrics should be collected
 	SyscallsMonitorEnabled bool
 }
@@ -171,12 +180,15 @@ func NewConfig() (*Config, error) {
 		EventStreamBufferSize:        getInt("event_stream.buffer_size"),
 		EventStreamUseFentry:         getEventStreamFentryValue(),
 		EnvsWithValue:                getStringSlice("envs_with_value"),
+		SecretLikeEnvPatterns:        getStringSlice("secret_like_envs"),
+		ShellMetachars:               getStringSlice("shell_metachars"),
 		NetworkEnabled:               getBool("network.enabled"),
 		NetworkIngressEnabled:        getBool("network.ingress.enabled"),
 		NetworkRawPacketEnabled:      getBool("network.raw_packet.enabled"),
 		NetworkPrivateIPRanges:       getStringSlice("network.private_ip_ranges"),
 		NetworkExtraPrivateIPRanges:  getStringSlice("network.extra_private_ip_ranges"),
 		StatsPollingInterval:         time.Duration(getInt("events_stats.polling_interval")) * time.Second,
+		SecurityLabelXAttrs:          getStringSlice("security_label_xattrs"),
 		SyscallsMonitorEnabled:       getBool("syscalls_monitor.enabled"),
 
 		// event server
@@ -70,6 +70,17 @@ const (
 	OffsetNameTaskStructPID     = "task_struct_pid_offset"      // kernels >= 4.19
 	OffsetNameTaskStructPIDLink = "task_struct_pid_link_offset" // kernels < 4.19
 	OffsetNamePIDLinkStructPID  = "pid_link_pid_offset"         // kernels < 4.19
+	OffsetNameUPIDStructNS      = "upid_ns_offset"
+
+	// namespace inode offsets
+	OffsetNameTaskStructNSProxy    = "task_struct_nsproxy_offset"
+	OffsetNameMntNamespaceStructNS = "mnt_namespace_ns_offset"
+	OffsetNamePIDNamespaceStructNS = "pid_namespace_ns_offset"
+	OffsetNameNSCommonStructInum   = "ns_common_inum_offset"
+
+	// working directory offsets
+	OffsetNameTaskStructFS = "task_struct_fs_offset"
+	OffsetNameFSStructPWD  = "fs_struct_pwd_offset"
 
 	// splice event
 	OffsetNamePipeInodeInfoStructBufs     = "pipe_inode_info_bufs_offset"
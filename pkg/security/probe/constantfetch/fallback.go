@@ -83,6 +83,20 @@ func (f *FallbackConstantFetcher) appendRequest(id string) {
 		value = getTaskStructPIDLinkOffset(f.kernelVersion)
 	case OffsetNamePIDLinkStructPID:
 		value = getPIDLinkPIDOffset(f.kernelVersion)
+	case OffsetNameUPIDStructNS:
+		value = getUPIDNSOffset(f.kernelVersion)
+	case OffsetNameTaskStructNSProxy:
+		value = getTaskStructNSProxyOffset(f.kernelVersion)
+	case OffsetNameMntNamespaceStructNS:
+		value = getMntNamespaceNSOffset(f.kernelVersion)
+	case OffsetNamePIDNamespaceStructNS:
+		value = getPIDNamespaceNSOffset(f.kernelVersion)
+	case OffsetNameNSCommonStructInum:
+		value = getNSCommonInumOffset(f.kernelVersion)
+	case OffsetNameTaskStructFS:
+		value = getTaskStructFSOffset(f.kernelVersion)
+	case OffsetNameFSStructPWD:
+		value = getFSStructPWDOffset(f.kernelVersion)
 	case OffsetNameDentryStructDSB:
 		value = getDentrySuperBlockOffset(f.kernelVersion)
 	case OffsetNamePipeInodeInfoStructBufs:
@@ -1018,6 +1032,43 @@ func getPIDLinkPIDOffset(kv *kernel.Version) uint64 {
 	return offset
 }
 
+func getUPIDNSOffset(_ *kernel.Version) uint64 {
+	return uint64(8)
+}
+
+func getTaskStructNSProxyOffset(_ *kernel.Version) uint64 {
+	// do not use fallback for offsets inside task_struct
+	return ErrorSentinel
+}
+
+func getMntNamespaceNSOffset(kv *kernel.Version) uint64 {
+	// the reference counter moved into the ns_common struct
+	if kv.Code >= kernel.Kernel5_11 {
+		return uint64(0)
+	}
+	return uint64(8)
+}
+
+func getPIDNamespaceNSOffset(_ *kernel.Version) uint64 {
+	// the layout of pid_namespace depends on the kernel configuration
+	return ErrorSentinel
+}
+
+func getNSCommonInumOffset(_ *kernel.Version) uint64 {
+	// the inode number follows the stashed dentry and the operations pointers
+	return uint64(16)
+}
+
+func getTaskStructFSOffset(_ *kernel.Version) uint64 {
+	// do not use fallback for offsets inside task_struct
+	return ErrorSentinel
+}
+
+func getFSStructPWDOffset(_ *kernel.Version) uint64 {
+	// users, lock, seq, umask and in_exec followed by the root path
+	return uint64(40)
+}
+
 func getKernelCloneArgsExitSignalOffset(kv *kernel.Version) uint64 {
 	switch {
 	case kv.IsUbuntuKernel() && kv.IsInRangeCloseOpen(kernel.Kernel6_5, kernel.Kernel6_6):
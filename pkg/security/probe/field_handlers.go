@@ -17,6 +17,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/config"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
 )
 
 func bestGuessServiceTag(serviceValues []string) string {
@@ -79,16 +80,29 @@ func getProcessService(config *config.Config, entry *model.ProcessCacheEntry) (s
 
 // BaseFieldHandlers holds the base field handlers
 type BaseFieldHandlers struct {
-	config       *config.Config
-	privateCIDRs eval.CIDRValues
-	hostname     string
+	config             *config.Config
+	privateCIDRs       eval.CIDRValues
+	secretLikeEnvs     *model.SecretLikeEnvMatcher
+	shellMetachars     *model.ShellMetacharsMatcher
+	criticalPaths      *model.CriticalPathSet
+	logPaths           *model.LogPathSet
+	fileOwners         *model.FileOwnerResolver
+	interpreterClasses *model.InterpreterClassifier
+	hostname           string
+	agentPid           uint32
 }
 
 // NewBaseFieldHandlers creates a new BaseFieldHandlers
 func NewBaseFieldHandlers(cfg *config.Config, hostname string) (*BaseFieldHandlers, error) {
 	bfh := &BaseFieldHandlers{
-		config:   cfg,
-		hostname: hostname,
+		config:             cfg,
+		criticalPaths:      model.NewCriticalPathSet(model.DefaultCriticalPaths...),
+		logPaths:           model.NewLogPathSet(model.DefaultLogDirectories...),
+		fileOwners:         model.NewFileOwnerResolver(nil, nil),
+		shellMetachars:     model.NewShellMetacharsMatcher(cfg.Probe.ShellMetachars),
+		interpreterClasses: model.NewInterpreterClassifier(model.DefaultInterpreterClasses),
+		hostname:           hostname,
+		agentPid:           utils.Getpid(),
 	}
 
 	for _, cidr := range cfg.Probe.NetworkPrivateIPRanges {
@@ -102,9 +116,24 @@ func NewBaseFieldHandlers(cfg *config.Config, hostname string) (*BaseFieldHandle
 		}
 	}
 
+	secretLikeEnvs, err := model.NewSecretLikeEnvMatcher(cfg.Probe.SecretLikeEnvPatterns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid secret like environment variable pattern: %w", err)
+	}
+	bfh.secretLikeEnvs = secretLikeEnvs
+
 	return bfh, nil
 }
 
+// shareWithModel shares the path sets, the owner resolver and the interpreter classifier with the model so that the
+// entries registered on the model are used by the field handlers
+func (bfh *BaseFieldHandlers) shareWithModel(m *model.Model) {
+	m.SetCriticalPaths(bfh.criticalPaths)
+	m.SetLogPaths(bfh.logPaths)
+	m.SetFileOwnerResolver(bfh.fileOwners)
+	m.SetInterpreterClassifier(bfh.interpreterClasses)
+}
+
 // ResolveIsIPPublic resolves if the IP is public
 func (bfh *BaseFieldHandlers) ResolveIsIPPublic(_ *model.Event, ipCtx *model.IPPortContext) bool {
 	if !ipCtx.IsPublicResolved {
@@ -119,6 +148,16 @@ func (bfh *BaseFieldHandlers) ResolveHostname(_ *model.Event, _ *model.BaseEvent
 	return bfh.hostname
 }
 
+// ResolveEventIsMetadataOnly resolves whether the event only changes the metadata of a file
+func (bfh *BaseFieldHandlers) ResolveEventIsMetadataOnly(_ *model.Event, e *model.BaseEvent) bool {
+	return model.EventType(e.Type).IsMetadataOnly()
+}
+
+// ResolveProcessIsAgent returns whether the process is the agent itself
+func (bfh *BaseFieldHandlers) ResolveProcessIsAgent(_ *model.Event, process *model.Process) bool {
+	return process.Pid == bfh.agentPid
+}
+
 // ResolveService returns the service tag based on the process context
 func (bfh *BaseFieldHandlers) ResolveService(ev *model.Event, e *model.BaseEvent) string {
 	if e.Service != "" {
@@ -40,11 +40,24 @@ func NewEBPFFieldHandlers(config *config.Config, resolvers *resolvers.EBPFResolv
 		return nil, err
 	}
 
-	return &EBPFFieldHandlers{
+	fh := &EBPFFieldHandlers{
 		BaseFieldHandlers: bfh,
 		resolvers:         resolvers,
 		onDemand:          onDemand,
-	}, nil
+	}
+	fh.fileOwners.SetCallbacks(fh.resolveUser, fh.resolveGroup)
+
+	return fh, nil
+}
+
+func (fh *EBPFFieldHandlers) resolveUser(uid uint32, containerID containerutils.ContainerID) string {
+	user, _ := fh.resolvers.UserGroupResolver.ResolveUser(int(uid), containerID)
+	return user
+}
+
+func (fh *EBPFFieldHandlers) resolveGroup(gid uint32, containerID containerutils.ContainerID) string {
+	group, _ := fh.resolvers.UserGroupResolver.ResolveGroup(int(gid), containerID)
+	return group
 }
 
 // ResolveProcessCacheEntry queries the ProcessResolver to retrieve the ProcessContext of the event
@@ -81,6 +94,62 @@ func (fh *EBPFFieldHandlers) ResolveFilePath(ev *model.Event, f *model.FileEvent
 	return f.PathnameStr
 }
 
+// ResolveFileResolvedPath resolves the path of the file with its symlinks resolved
+func (fh *EBPFFieldHandlers) ResolveFileResolvedPath(ev *model.Event, f *model.FileEvent) string {
+	if len(f.ResolvedPathnameStr) == 0 {
+		f.ResolvedPathnameStr = fh.ResolveFilePath(ev, f)
+
+		// the path is resolved from the dentry of the file, so that only the file itself can be a symlink, when the
+		// syscall doesn't follow it, the target being then looked up from the root directory of the process
+		if f.Mode&syscall.S_IFMT == syscall.S_IFLNK {
+			if resolved, err := utils.ResolveSymlinks(utils.ProcRootPath(ev.PIDContext.Pid), f.ResolvedPathnameStr); err == nil {
+				f.ResolvedPathnameStr = resolved
+			}
+		}
+	}
+	return f.ResolvedPathnameStr
+}
+
+// ResolveFileParentPath resolves the path of the directory containing the file
+func (fh *EBPFFieldHandlers) ResolveFileParentPath(ev *model.Event, f *model.FileEvent) string {
+	if len(f.ParentPathStr) == 0 {
+		f.ParentPathStr = model.ParentPath(fh.ResolveFilePath(ev, f))
+	}
+	return f.ParentPathStr
+}
+
+// ResolveFileDepth resolves the number of segments of the path of the file
+func (fh *EBPFFieldHandlers) ResolveFileDepth(ev *model.Event, f *model.FileEvent) int {
+	f.Depth = model.PathDepth(fh.ResolveFilePath(ev, f))
+	return f.Depth
+}
+
+// ResolveFileIsCritical resolves whether the path of the file is one of the critical paths
+func (fh *EBPFFieldHandlers) ResolveFileIsCritical(ev *model.Event, f *model.FileEvent) bool {
+	f.IsCritical = fh.criticalPaths.Contains(fh.ResolveFilePath(ev, f))
+	return f.IsCritical
+}
+
+// ResolveFileIsLog resolves whether the file is located below one of the log directories
+func (fh *EBPFFieldHandlers) ResolveFileIsLog(ev *model.Event, f *model.FileEvent) bool {
+	f.IsLog = fh.logPaths.Contains(fh.ResolveFilePath(ev, f))
+	return f.IsLog
+}
+
+// ResolveFilePathIsValidUTF8 resolves whether the path of the file is valid UTF-8 and free of control characters
+func (fh *EBPFFieldHandlers) ResolveFilePathIsValidUTF8(ev *model.Event, f *model.FileEvent) bool {
+	f.PathIsValidUTF8 = model.IsValidPath(fh.ResolveFilePath(ev, f))
+	return f.PathIsValidUTF8
+}
+
+// ResolveFileSanitizedPath resolves the path of the file with its invalid bytes and control characters escaped
+func (fh *EBPFFieldHandlers) ResolveFileSanitizedPath(ev *model.Event, f *model.FileEvent) string {
+	if len(f.SanitizedPathStr) == 0 {
+		f.SanitizedPathStr = model.SanitizePath(fh.ResolveFilePath(ev, f))
+	}
+	return f.SanitizedPathStr
+}
+
 // ResolveFileBasename resolves the inode to a full path
 func (fh *EBPFFieldHandlers) ResolveFileBasename(_ *model.Event, f *model.FileEvent) string {
 	if !f.IsBasenameStrResolved && len(f.BasenameStr) == 0 {
@@ -109,6 +178,13 @@ func (fh *EBPFFieldHandlers) ResolveFileFilesystem(ev *model.Event, f *model.Fil
 	return f.Filesystem
 }
 
+// ResolveFileMountPath resolves the path of the mount point of the mount a file resides in
+func (fh *EBPFFieldHandlers) ResolveFileMountPath(ev *model.Event, f *model.FileEvent) string {
+	return f.ResolveMountPath(func(mountID uint32, device uint32) (string, model.MountSource, model.MountOrigin, error) {
+		return fh.resolvers.MountResolver.ResolveMountPath(mountID, device, ev.PIDContext.Pid, ev.ContainerContext.ContainerID)
+	})
+}
+
 // ResolveProcessArgsFlags resolves the arguments flags of the event
 func (fh *EBPFFieldHandlers) ResolveProcessArgsFlags(ev *model.Event, process *model.Process) (flags []string) {
 	return args.ParseProcessFlags(fh.ResolveProcessArgv(ev, process))
@@ -119,6 +195,14 @@ func (fh *EBPFFieldHandlers) ResolveProcessArgsOptions(ev *model.Event, process
 	return args.ParseProcessOptions(fh.ResolveProcessArgv(ev, process))
 }
 
+// ResolveFileFieldsID resolves the identifier of the file, unique across mounts
+func (fh *EBPFFieldHandlers) ResolveFileFieldsID(_ *model.Event, f *model.FileFields) string {
+	if len(f.ID) == 0 {
+		f.ID = f.GetID()
+	}
+	return f.ID
+}
+
 // ResolveFileFieldsInUpperLayer resolves whether the file is in an upper layer
 func (fh *EBPFFieldHandlers) ResolveFileFieldsInUpperLayer(_ *model.Event, f *model.FileFields) bool {
 	return f.GetInUpperLayer()
@@ -143,6 +227,16 @@ func (fh *EBPFFieldHandlers) ResolveXAttrNamespace(ev *model.Event, e *model.Set
 	return e.Namespace
 }
 
+// ResolveXAttrIsSecurityLabel resolves whether the extended attribute is a security label
+func (fh *EBPFFieldHandlers) ResolveXAttrIsSecurityLabel(ev *model.Event, e *model.SetXAttrEvent) bool {
+	return model.IsSecurityLabelXAttr(fh.ResolveXAttrNamespace(ev, e), fh.ResolveXAttrName(ev, e), fh.config.Probe.SecurityLabelXAttrs)
+}
+
+// ResolveXAttrAffectsIntegrity resolves whether the extended attribute holds integrity measurements or signatures
+func (fh *EBPFFieldHandlers) ResolveXAttrAffectsIntegrity(ev *model.Event, e *model.SetXAttrEvent) bool {
+	return model.IsIntegrityXAttr(fh.ResolveXAttrNamespace(ev, e), fh.ResolveXAttrName(ev, e))
+}
+
 // ResolveMountPointPath resolves a mount point path
 func (fh *EBPFFieldHandlers) ResolveMountPointPath(ev *model.Event, e *model.MountEvent) string {
 	if len(e.MountPointPath) == 0 {
@@ -233,18 +327,20 @@ func (fh *EBPFFieldHandlers) ResolveRights(_ *model.Event, e *model.FileFields)
 
 // ResolveChownUID resolves the user id of a chown event to a username
 func (fh *EBPFFieldHandlers) ResolveChownUID(ev *model.Event, e *model.ChownEvent) string {
-	if len(e.User) == 0 {
-		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), ev.ContainerContext.ContainerID)
-	}
-	return e.User
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
 }
 
 // ResolveChownGID resolves the group id of a chown event to a group name
 func (fh *EBPFFieldHandlers) ResolveChownGID(ev *model.Event, e *model.ChownEvent) string {
-	if len(e.Group) == 0 {
-		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.GID), ev.ContainerContext.ContainerID)
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveLinkType resolves the type of a link event
+func (fh *EBPFFieldHandlers) ResolveLinkType(_ *model.Event, e *model.LinkEvent) string {
+	if len(e.Type) == 0 {
+		e.Type = e.GetLinkType()
 	}
-	return e.Group
+	return e.Type
 }
 
 // ResolveProcessArgv0 resolves the first arg of the event
@@ -261,6 +357,14 @@ func (fh *EBPFFieldHandlers) ResolveProcessArgs(ev *model.Event, process *model.
 	return process.Args
 }
 
+// ResolveProcessCmdLine resolves the command line of the process
+func (fh *EBPFFieldHandlers) ResolveProcessCmdLine(ev *model.Event, process *model.Process) string {
+	if process.CmdLine == "" {
+		process.CmdLine = model.BuildCmdLine(fh.ResolveProcessArgv0(ev, process), fh.ResolveProcessArgv(ev, process))
+	}
+	return process.CmdLine
+}
+
 // ResolveProcessArgsScrubbed resolves the args of the event
 func (fh *EBPFFieldHandlers) ResolveProcessArgsScrubbed(ev *model.Event, process *model.Process) string {
 	if process.ArgsScrubbed == "" {
@@ -293,6 +397,26 @@ func (fh *EBPFFieldHandlers) ResolveProcessArgsTruncated(_ *model.Event, process
 	return truncated
 }
 
+// ResolveProcessArgsHasShellMetachars returns whether one of the arguments contains a shell metacharacter
+func (fh *EBPFFieldHandlers) ResolveProcessArgsHasShellMetachars(ev *model.Event, process *model.Process) bool {
+	return process.HasShellMetacharsArgs(fh.ResolveProcessArgv(ev, process), fh.shellMetachars)
+}
+
+// ResolveProcessArgsDistinctCount returns the number of distinct arguments of the process
+func (fh *EBPFFieldHandlers) ResolveProcessArgsDistinctCount(ev *model.Event, process *model.Process) int {
+	return process.GetDistinctArgsCount(fh.ResolveProcessArgv(ev, process))
+}
+
+// ResolveProcessEnvsDistinctCount returns the number of distinct environment variable names of the process
+func (fh *EBPFFieldHandlers) ResolveProcessEnvsDistinctCount(ev *model.Event, process *model.Process) int {
+	return process.GetDistinctEnvsCount(fh.ResolveProcessEnvs(ev, process))
+}
+
+// ResolveProcessEnvsHasSecretLike returns whether the name of one of the envs looks like a credential
+func (fh *EBPFFieldHandlers) ResolveProcessEnvsHasSecretLike(ev *model.Event, process *model.Process) bool {
+	return process.HasSecretLikeEnv(fh.ResolveProcessEnvs(ev, process), fh.secretLikeEnvs)
+}
+
 // ResolveProcessEnvsTruncated returns whether the envs are truncated
 func (fh *EBPFFieldHandlers) ResolveProcessEnvsTruncated(_ *model.Event, process *model.Process) bool {
 	_, truncated := fh.resolvers.ProcessResolver.GetProcessEnvs(process)
@@ -305,15 +429,235 @@ func (fh *EBPFFieldHandlers) ResolveProcessEnvs(_ *model.Event, process *model.P
 	return envs
 }
 
+// ResolveProcessSessionType returns the type of the interactive session of the process
+func (fh *EBPFFieldHandlers) ResolveProcessSessionType(ev *model.Event, process *model.Process) string {
+	return process.ResolveTTYSessionType(func() []string {
+		return fh.ResolveProcessEnvs(ev, process)
+	})
+}
+
+// ResolveProcessCommTruncated returns whether the comm of the process may have been truncated
+func (fh *EBPFFieldHandlers) ResolveProcessCommTruncated(_ *model.Event, process *model.Process) bool {
+	return process.IsCommTruncated()
+}
+
+// ResolveProcessCommMatchesBinary returns whether the comm of the process matches the basename of its executable
+func (fh *EBPFFieldHandlers) ResolveProcessCommMatchesBinary(ev *model.Event, process *model.Process) bool {
+	return process.IsCommMatchingBasename(fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+// ResolveProcessAncestorsExecCount returns the number of re-exec links in the ancestor chain
+func (fh *EBPFFieldHandlers) ResolveProcessAncestorsExecCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.CountAncestorsExecs()
+}
+
+// ResolveProcessFileEventsCount returns the number of open, rename and unlink events of the process
+func (fh *EBPFFieldHandlers) ResolveProcessFileEventsCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.FileEventsCount
+}
+
+// ResolveProcessMetadataChangeCount returns the number of metadata change events of the process
+func (fh *EBPFFieldHandlers) ResolveProcessMetadataChangeCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.MetadataChangeCount
+}
+
+// ResolveProcessHasAncestors returns whether the process has at least one ancestor
+func (fh *EBPFFieldHandlers) ResolveProcessHasAncestors(_ *model.Event, pc *model.ProcessContext) bool {
+	return pc.HasAncestor()
+}
+
+// ResolveProcessFileMD5 returns the MD5 hash of the process executable, if it was resolved
+func (fh *EBPFFieldHandlers) ResolveProcessFileMD5(_ *model.Event, process *model.Process) string {
+	return process.FileEvent.GetHash(model.MD5)
+}
+
+// ResolveProcessFileIsMemfd returns whether the process executable is a memfd or an anonymous file
+func (fh *EBPFFieldHandlers) ResolveProcessFileIsMemfd(ev *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsMemfd(fh.ResolveFilePath(ev, &process.FileEvent), fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+// ResolveProcessFileIsSetuid returns whether the process executable has the setuid bit set
+func (fh *EBPFFieldHandlers) ResolveProcessFileIsSetuid(_ *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsSetuid()
+}
+
+// ResolveProcessFileIsSetgid returns whether the process executable has the setgid bit set
+func (fh *EBPFFieldHandlers) ResolveProcessFileIsSetgid(_ *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsSetgid()
+}
+
+// ResolveProcessFileIsDeleted returns whether the process executable was deleted from the disk
+func (fh *EBPFFieldHandlers) ResolveProcessFileIsDeleted(ev *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsDeleted(fh.ResolveFilePath(ev, &process.FileEvent))
+}
+
+// ResolveProcessFileInterpreterClass resolves the class of the process executable when it's a well-known interpreter
+func (fh *EBPFFieldHandlers) ResolveProcessFileInterpreterClass(ev *model.Event, process *model.Process) string {
+	if process.FileInterpreterClass == "" {
+		process.FileInterpreterClass = fh.interpreterClasses.Classify(fh.ResolveFileBasename(ev, &process.FileEvent))
+	}
+	return process.FileInterpreterClass
+}
+
+// ResolveProcessFileNameEntropy resolves the entropy of the basename of the process executable
+func (fh *EBPFFieldHandlers) ResolveProcessFileNameEntropy(ev *model.Event, process *model.Process) float64 {
+	process.FileNameEntropy = model.NameEntropy(fh.ResolveFileBasename(ev, &process.FileEvent))
+	return process.FileNameEntropy
+}
+
+// ResolveProcessFileLayerID resolves the ID of the overlay layer providing the process executable
+func (fh *EBPFFieldHandlers) ResolveProcessFileLayerID(ev *model.Event, process *model.Process) string {
+	if !process.FileEvent.IsLayerIDResolved {
+		process.FileEvent.LayerID = utils.GetOverlayLayerID(process.Pid, process.FileEvent.MountID, fh.ResolveFilePath(ev, &process.FileEvent))
+		process.FileEvent.IsLayerIDResolved = true
+	}
+	return process.FileEvent.LayerID
+}
+
+// ResolveProcessFileIsHostPath returns whether the process executable resides on a host filesystem
+func (fh *EBPFFieldHandlers) ResolveProcessFileIsHostPath(ev *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsHostPath(fh.ResolveFileFilesystem(ev, &process.FileEvent))
+}
+
+// ResolveProcessFileSHA256 returns the SHA256 hash of the process executable, if it was resolved
+func (fh *EBPFFieldHandlers) ResolveProcessFileSHA256(_ *model.Event, process *model.Process) string {
+	return process.FileEvent.GetHash(model.SHA256)
+}
+
+// ResolveSyscallSucceeded returns whether the syscall succeeded
+func (fh *EBPFFieldHandlers) ResolveSyscallSucceeded(_ *model.Event, e *model.SyscallEvent) bool {
+	return e.IsSuccess()
+}
+
+// ResolveSyscallFailed returns whether the syscall failed
+func (fh *EBPFFieldHandlers) ResolveSyscallFailed(_ *model.Event, e *model.SyscallEvent) bool {
+	return !e.IsSuccess()
+}
+
+// ResolveRenameDestinationContainsDotDot resolves whether the destination path argument of the rename contains ".." segments
+func (fh *EBPFFieldHandlers) ResolveRenameDestinationContainsDotDot(ev *model.Event, e *model.RenameEvent) bool {
+	return model.ContainsDotDotSegment(fh.ResolveSyscallCtxArgsStr2(ev, &e.SyscallContext))
+}
+
+// ResolveLinkDestinationContainsDotDot resolves whether the destination path argument of the link contains ".." segments
+func (fh *EBPFFieldHandlers) ResolveLinkDestinationContainsDotDot(ev *model.Event, e *model.LinkEvent) bool {
+	return model.ContainsDotDotSegment(fh.ResolveSyscallCtxArgsStr2(ev, &e.SyscallContext))
+}
+
+// ResolveRenameCrossMount resolves whether the rename crosses mount points
+func (fh *EBPFFieldHandlers) ResolveRenameCrossMount(_ *model.Event, e *model.RenameEvent) bool {
+	return model.IsCrossMount(&e.Old, &e.New)
+}
+
+// ResolveLinkCrossMount resolves whether the link crosses mount points
+func (fh *EBPFFieldHandlers) ResolveLinkCrossMount(_ *model.Event, e *model.LinkEvent) bool {
+	return model.IsCrossMount(&e.Source, &e.Target)
+}
+
+// ResolveRenameBasenameChanged resolves whether the rename changes the basename of the file
+func (fh *EBPFFieldHandlers) ResolveRenameBasenameChanged(ev *model.Event, e *model.RenameEvent) bool {
+	return fh.ResolveFileBasename(ev, &e.Old) != fh.ResolveFileBasename(ev, &e.New)
+}
+
+// ResolveLinkBasenameChanged resolves whether the link has a basename different from the one of its source
+func (fh *EBPFFieldHandlers) ResolveLinkBasenameChanged(ev *model.Event, e *model.LinkEvent) bool {
+	return fh.ResolveFileBasename(ev, &e.Source) != fh.ResolveFileBasename(ev, &e.Target)
+}
+
+// ResolveUnlinkIsSelfDelete resolves whether the process deletes its own executable
+func (fh *EBPFFieldHandlers) ResolveUnlinkIsSelfDelete(ev *model.Event, e *model.UnlinkEvent) bool {
+	return e.IsSelfDelete(ev.ProcessContext)
+}
+
+// ResolveCredentialsUIDMismatch resolves whether the effective UID of the process differs from its real UID
+func (fh *EBPFFieldHandlers) ResolveCredentialsUIDMismatch(_ *model.Event, e *model.Credentials) bool {
+	return e.IsUIDMismatch()
+}
+
+// ResolveCredentialsGIDMismatch resolves whether the effective GID of the process differs from its real GID
+func (fh *EBPFFieldHandlers) ResolveCredentialsGIDMismatch(_ *model.Event, e *model.Credentials) bool {
+	return e.IsGIDMismatch()
+}
+
+// ResolveCredentialsUser resolves the user of the process
+func (fh *EBPFFieldHandlers) ResolveCredentialsUser(ev *model.Event, e *model.Credentials) string {
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsGroup resolves the group of the process
+func (fh *EBPFFieldHandlers) ResolveCredentialsGroup(ev *model.Event, e *model.Credentials) string {
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsEUser resolves the effective user of the process
+func (fh *EBPFFieldHandlers) ResolveCredentialsEUser(ev *model.Event, e *model.Credentials) string {
+	return e.GetEUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsEGroup resolves the effective group of the process
+func (fh *EBPFFieldHandlers) ResolveCredentialsEGroup(ev *model.Event, e *model.Credentials) string {
+	return e.GetEGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsFSUser resolves the file-system user of the process
+func (fh *EBPFFieldHandlers) ResolveCredentialsFSUser(ev *model.Event, e *model.Credentials) string {
+	return e.GetFSUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsFSGroup resolves the file-system group of the process
+func (fh *EBPFFieldHandlers) ResolveCredentialsFSGroup(ev *model.Event, e *model.Credentials) string {
+	return e.GetFSGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveChownToRoot resolves whether the file is chown-ed to the root user or the root group
+func (fh *EBPFFieldHandlers) ResolveChownToRoot(_ *model.Event, e *model.ChownEvent) bool {
+	return e.IsToRoot()
+}
+
+// ResolveOpenIsWrite resolves whether the file is opened for writing
+func (fh *EBPFFieldHandlers) ResolveOpenIsWrite(_ *model.Event, e *model.OpenEvent) bool {
+	return e.IsWrite()
+}
+
+// ResolveOpenIsTruncating resolves whether the file is truncated when opened
+func (fh *EBPFFieldHandlers) ResolveOpenIsTruncating(_ *model.Event, e *model.OpenEvent) bool {
+	return e.IsTruncating()
+}
+
 // ResolveProcessIsThread returns true is the process is a thread
 func (fh *EBPFFieldHandlers) ResolveProcessIsThread(_ *model.Event, process *model.Process) bool {
 	return !process.IsExec
 }
 
+// ResolveProcessIsInit returns whether the process is the init process of its pid namespace
+func (fh *EBPFFieldHandlers) ResolveProcessIsInit(_ *model.Event, process *model.Process) bool {
+	return process.IsNamespaceInit()
+}
+
+// ResolveProcessCwd resolves the working directory of the process
+func (fh *EBPFFieldHandlers) ResolveProcessCwd(_ *model.Event, process *model.Process) string {
+	return process.Cwd
+}
+
+// ResolveProcessPIDNamespace resolves the PID namespace of the process
+func (fh *EBPFFieldHandlers) ResolveProcessPIDNamespace(_ *model.Event, e *model.PIDContext) int {
+	return int(e.NSID)
+}
+
+// ResolveProcessMntNamespace resolves the mount namespace of the process
+func (fh *EBPFFieldHandlers) ResolveProcessMntNamespace(_ *model.Event, e *model.PIDContext) int {
+	return int(e.MntNS)
+}
+
+// ResolveProcessNetNamespace resolves the network namespace of the process
+func (fh *EBPFFieldHandlers) ResolveProcessNetNamespace(_ *model.Event, e *model.PIDContext) int {
+	return int(e.NetNS)
+}
+
 // ResolveSetuidUser resolves the user of the Setuid event
 func (fh *EBPFFieldHandlers) ResolveSetuidUser(ev *model.Event, e *model.SetuidEvent) string {
 	if len(e.User) == 0 {
-		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), ev.ContainerContext.ContainerID)
+		e.User = fh.fileOwners.ResolveUser(e.UID, ev.ContainerContext.ContainerID)
 	}
 	return e.User
 }
@@ -321,7 +665,7 @@ func (fh *EBPFFieldHandlers) ResolveSetuidUser(ev *model.Event, e *model.SetuidE
 // ResolveSetuidEUser resolves the effective user of the Setuid event
 func (fh *EBPFFieldHandlers) ResolveSetuidEUser(ev *model.Event, e *model.SetuidEvent) string {
 	if len(e.EUser) == 0 {
-		e.EUser, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.EUID), ev.ContainerContext.ContainerID)
+		e.EUser = fh.fileOwners.ResolveUser(e.EUID, ev.ContainerContext.ContainerID)
 	}
 	return e.EUser
 }
@@ -329,7 +673,7 @@ func (fh *EBPFFieldHandlers) ResolveSetuidEUser(ev *model.Event, e *model.Setuid
 // ResolveSetuidFSUser resolves the file-system user of the Setuid event
 func (fh *EBPFFieldHandlers) ResolveSetuidFSUser(ev *model.Event, e *model.SetuidEvent) string {
 	if len(e.FSUser) == 0 {
-		e.FSUser, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.FSUID), ev.ContainerContext.ContainerID)
+		e.FSUser = fh.fileOwners.ResolveUser(e.FSUID, ev.ContainerContext.ContainerID)
 	}
 	return e.FSUser
 }
@@ -337,7 +681,7 @@ func (fh *EBPFFieldHandlers) ResolveSetuidFSUser(ev *model.Event, e *model.Setui
 // ResolveSetgidGroup resolves the group of the Setgid event
 func (fh *EBPFFieldHandlers) ResolveSetgidGroup(ev *model.Event, e *model.SetgidEvent) string {
 	if len(e.Group) == 0 {
-		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.GID), ev.ContainerContext.ContainerID)
+		e.Group = fh.fileOwners.ResolveGroup(e.GID, ev.ContainerContext.ContainerID)
 	}
 	return e.Group
 }
@@ -345,7 +689,7 @@ func (fh *EBPFFieldHandlers) ResolveSetgidGroup(ev *model.Event, e *model.Setgid
 // ResolveSetgidEGroup resolves the effective group of the Setgid event
 func (fh *EBPFFieldHandlers) ResolveSetgidEGroup(ev *model.Event, e *model.SetgidEvent) string {
 	if len(e.EGroup) == 0 {
-		e.EGroup, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.EGID), ev.ContainerContext.ContainerID)
+		e.EGroup = fh.fileOwners.ResolveGroup(e.EGID, ev.ContainerContext.ContainerID)
 	}
 	return e.EGroup
 }
@@ -353,7 +697,7 @@ func (fh *EBPFFieldHandlers) ResolveSetgidEGroup(ev *model.Event, e *model.Setgi
 // ResolveSetgidFSGroup resolves the file-system group of the Setgid event
 func (fh *EBPFFieldHandlers) ResolveSetgidFSGroup(ev *model.Event, e *model.SetgidEvent) string {
 	if len(e.FSGroup) == 0 {
-		e.FSGroup, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.FSGID), ev.ContainerContext.ContainerID)
+		e.FSGroup = fh.fileOwners.ResolveGroup(e.FSGID, ev.ContainerContext.ContainerID)
 	}
 	return e.FSGroup
 }
@@ -382,10 +726,7 @@ func (fh *EBPFFieldHandlers) GetProcessCacheEntry(ev *model.Event, newEntryCb fu
 
 // ResolveFileFieldsGroup resolves the group id of the file to a group name
 func (fh *EBPFFieldHandlers) ResolveFileFieldsGroup(ev *model.Event, e *model.FileFields) string {
-	if len(e.Group) == 0 {
-		e.Group, _ = fh.resolvers.UserGroupResolver.ResolveGroup(int(e.GID), ev.ContainerContext.ContainerID)
-	}
-	return e.Group
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
 }
 
 // ResolveNetworkDeviceIfName returns the network iterface name from the network context
@@ -402,10 +743,7 @@ func (fh *EBPFFieldHandlers) ResolveNetworkDeviceIfName(_ *model.Event, device *
 
 // ResolveFileFieldsUser resolves the user id of the file to a username
 func (fh *EBPFFieldHandlers) ResolveFileFieldsUser(ev *model.Event, e *model.FileFields) string {
-	if len(e.User) == 0 {
-		e.User, _ = fh.resolvers.UserGroupResolver.ResolveUser(int(e.UID), ev.ContainerContext.ContainerID)
-	}
-	return e.User
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
 }
 
 // ResolveEventTimestamp resolves the monolitic kernel event timestamp to an absolute time
@@ -746,3 +1084,13 @@ func (fh *EBPFFieldHandlers) ResolveProcessNSID(e *model.Event) (uint64, error)
 	e.ProcessCacheEntry.Process.NSID = nsid
 	return nsid, nil
 }
+
+// ResolveSetuidChanged returns whether the setuid call changed the UID of the process
+func (fh *EBPFFieldHandlers) ResolveSetuidChanged(_ *model.Event, e *model.SetuidEvent) bool {
+	return e.IsUIDChanged()
+}
+
+// ResolveSetgidChanged returns whether the setgid call changed the GID of the process
+func (fh *EBPFFieldHandlers) ResolveSetgidChanged(_ *model.Event, e *model.SetgidEvent) bool {
+	return e.IsGIDChanged()
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/DataDog/datadog-agent/pkg/security/secl/args"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/utils"
 )
 
 // EBPFLessFieldHandlers defines a field handlers
@@ -62,6 +63,51 @@ func (fh *EBPFLessFieldHandlers) ResolveFilePath(_ *model.Event, f *model.FileEv
 	return f.PathnameStr
 }
 
+// ResolveFileResolvedPath resolves the path of the file with its symlinks resolved
+func (fh *EBPFLessFieldHandlers) ResolveFileResolvedPath(ev *model.Event, f *model.FileEvent) string {
+	return f.GetResolvedPath(fh.ResolveFilePath(ev, f))
+}
+
+// ResolveFileParentPath resolves the path of the directory containing the file
+func (fh *EBPFLessFieldHandlers) ResolveFileParentPath(ev *model.Event, f *model.FileEvent) string {
+	if len(f.ParentPathStr) == 0 {
+		f.ParentPathStr = model.ParentPath(fh.ResolveFilePath(ev, f))
+	}
+	return f.ParentPathStr
+}
+
+// ResolveFileDepth resolves the number of segments of the path of the file
+func (fh *EBPFLessFieldHandlers) ResolveFileDepth(ev *model.Event, f *model.FileEvent) int {
+	f.Depth = model.PathDepth(fh.ResolveFilePath(ev, f))
+	return f.Depth
+}
+
+// ResolveFileIsCritical resolves whether the path of the file is one of the critical paths
+func (fh *EBPFLessFieldHandlers) ResolveFileIsCritical(ev *model.Event, f *model.FileEvent) bool {
+	f.IsCritical = fh.criticalPaths.Contains(fh.ResolveFilePath(ev, f))
+	return f.IsCritical
+}
+
+// ResolveFileIsLog resolves whether the file is located below one of the log directories
+func (fh *EBPFLessFieldHandlers) ResolveFileIsLog(ev *model.Event, f *model.FileEvent) bool {
+	f.IsLog = fh.logPaths.Contains(fh.ResolveFilePath(ev, f))
+	return f.IsLog
+}
+
+// ResolveFilePathIsValidUTF8 resolves whether the path of the file is valid UTF-8 and free of control characters
+func (fh *EBPFLessFieldHandlers) ResolveFilePathIsValidUTF8(ev *model.Event, f *model.FileEvent) bool {
+	f.PathIsValidUTF8 = model.IsValidPath(fh.ResolveFilePath(ev, f))
+	return f.PathIsValidUTF8
+}
+
+// ResolveFileSanitizedPath resolves the path of the file with its invalid bytes and control characters escaped
+func (fh *EBPFLessFieldHandlers) ResolveFileSanitizedPath(ev *model.Event, f *model.FileEvent) string {
+	if len(f.SanitizedPathStr) == 0 {
+		f.SanitizedPathStr = model.SanitizePath(fh.ResolveFilePath(ev, f))
+	}
+	return f.SanitizedPathStr
+}
+
 // ResolveFileBasename resolves the inode to a full path
 func (fh *EBPFLessFieldHandlers) ResolveFileBasename(_ *model.Event, f *model.FileEvent) string {
 	return f.BasenameStr
@@ -88,6 +134,11 @@ func (fh *EBPFLessFieldHandlers) ResolveProcessArgs(ev *model.Event, process *mo
 	return strings.Join(fh.ResolveProcessArgv(ev, process), " ")
 }
 
+// ResolveProcessCmdLine resolves the command line of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessCmdLine(ev *model.Event, process *model.Process) string {
+	return model.BuildCmdLine(fh.ResolveProcessArgv0(ev, process), fh.ResolveProcessArgv(ev, process))
+}
+
 // ResolveProcessArgv resolves the unscrubbed args of the process as an array. Use with caution.
 func (fh *EBPFLessFieldHandlers) ResolveProcessArgv(_ *model.Event, process *model.Process) []string {
 	argv, _ := sprocess.GetProcessArgv(process)
@@ -117,6 +168,26 @@ func (fh *EBPFLessFieldHandlers) ResolveProcessArgsTruncated(_ *model.Event, pro
 	return truncated
 }
 
+// ResolveProcessArgsHasShellMetachars returns whether one of the arguments contains a shell metacharacter
+func (fh *EBPFLessFieldHandlers) ResolveProcessArgsHasShellMetachars(ev *model.Event, process *model.Process) bool {
+	return process.HasShellMetacharsArgs(fh.ResolveProcessArgv(ev, process), fh.shellMetachars)
+}
+
+// ResolveProcessArgsDistinctCount returns the number of distinct arguments of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessArgsDistinctCount(ev *model.Event, process *model.Process) int {
+	return process.GetDistinctArgsCount(fh.ResolveProcessArgv(ev, process))
+}
+
+// ResolveProcessEnvsDistinctCount returns the number of distinct environment variable names of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessEnvsDistinctCount(ev *model.Event, process *model.Process) int {
+	return process.GetDistinctEnvsCount(fh.ResolveProcessEnvs(ev, process))
+}
+
+// ResolveProcessEnvsHasSecretLike returns whether the name of one of the envs looks like a credential
+func (fh *EBPFLessFieldHandlers) ResolveProcessEnvsHasSecretLike(ev *model.Event, process *model.Process) bool {
+	return process.HasSecretLikeEnv(fh.ResolveProcessEnvs(ev, process), fh.secretLikeEnvs)
+}
+
 // ResolveProcessEnvsTruncated returns whether the envs are truncated
 func (fh *EBPFLessFieldHandlers) ResolveProcessEnvsTruncated(_ *model.Event, process *model.Process) bool {
 	_, truncated := fh.resolvers.ProcessResolver.GetProcessEnvs(process)
@@ -129,11 +200,232 @@ func (fh *EBPFLessFieldHandlers) ResolveProcessEnvs(_ *model.Event, process *mod
 	return envs
 }
 
+// ResolveProcessSessionType returns the type of the interactive session of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessSessionType(ev *model.Event, process *model.Process) string {
+	return process.ResolveTTYSessionType(func() []string {
+		return fh.ResolveProcessEnvs(ev, process)
+	})
+}
+
+// ResolveProcessCommTruncated returns whether the comm of the process may have been truncated
+func (fh *EBPFLessFieldHandlers) ResolveProcessCommTruncated(_ *model.Event, process *model.Process) bool {
+	return process.IsCommTruncated()
+}
+
+// ResolveProcessCommMatchesBinary returns whether the comm of the process matches the basename of its executable
+func (fh *EBPFLessFieldHandlers) ResolveProcessCommMatchesBinary(ev *model.Event, process *model.Process) bool {
+	return process.IsCommMatchingBasename(fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+// ResolveProcessAncestorsExecCount returns the number of re-exec links in the ancestor chain
+func (fh *EBPFLessFieldHandlers) ResolveProcessAncestorsExecCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.CountAncestorsExecs()
+}
+
+// ResolveProcessFileEventsCount returns the number of open, rename and unlink events of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileEventsCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.FileEventsCount
+}
+
+// ResolveProcessMetadataChangeCount returns the number of metadata change events of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessMetadataChangeCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.MetadataChangeCount
+}
+
+// ResolveProcessHasAncestors returns whether the process has at least one ancestor
+func (fh *EBPFLessFieldHandlers) ResolveProcessHasAncestors(_ *model.Event, pc *model.ProcessContext) bool {
+	return pc.HasAncestor()
+}
+
+// ResolveProcessFileMD5 returns the MD5 hash of the process executable, if it was resolved
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileMD5(_ *model.Event, process *model.Process) string {
+	return process.FileEvent.GetHash(model.MD5)
+}
+
+// ResolveProcessFileIsMemfd returns whether the process executable is a memfd or an anonymous file
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileIsMemfd(ev *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsMemfd(fh.ResolveFilePath(ev, &process.FileEvent), fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+// ResolveProcessFileIsSetuid returns whether the process executable has the setuid bit set
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileIsSetuid(_ *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsSetuid()
+}
+
+// ResolveProcessFileIsSetgid returns whether the process executable has the setgid bit set
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileIsSetgid(_ *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsSetgid()
+}
+
+// ResolveProcessFileIsDeleted returns whether the process executable was deleted from the disk
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileIsDeleted(ev *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsDeleted(fh.ResolveFilePath(ev, &process.FileEvent))
+}
+
+// ResolveProcessFileInterpreterClass resolves the class of the process executable when it's a well-known interpreter
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileInterpreterClass(ev *model.Event, process *model.Process) string {
+	if process.FileInterpreterClass == "" {
+		process.FileInterpreterClass = fh.interpreterClasses.Classify(fh.ResolveFileBasename(ev, &process.FileEvent))
+	}
+	return process.FileInterpreterClass
+}
+
+// ResolveProcessFileNameEntropy resolves the entropy of the basename of the process executable
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileNameEntropy(ev *model.Event, process *model.Process) float64 {
+	process.FileNameEntropy = model.NameEntropy(fh.ResolveFileBasename(ev, &process.FileEvent))
+	return process.FileNameEntropy
+}
+
+// ResolveProcessFileLayerID resolves the ID of the overlay layer providing the process executable
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileLayerID(ev *model.Event, process *model.Process) string {
+	if !process.FileEvent.IsLayerIDResolved {
+		process.FileEvent.LayerID = utils.GetOverlayLayerID(process.Pid, process.FileEvent.MountID, fh.ResolveFilePath(ev, &process.FileEvent))
+		process.FileEvent.IsLayerIDResolved = true
+	}
+	return process.FileEvent.LayerID
+}
+
+// ResolveProcessFileIsHostPath returns whether the process executable resides on a host filesystem
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileIsHostPath(ev *model.Event, process *model.Process) bool {
+	return process.FileEvent.IsHostPath(fh.ResolveFileFilesystem(ev, &process.FileEvent))
+}
+
+// ResolveProcessFileSHA256 returns the SHA256 hash of the process executable, if it was resolved
+func (fh *EBPFLessFieldHandlers) ResolveProcessFileSHA256(_ *model.Event, process *model.Process) string {
+	return process.FileEvent.GetHash(model.SHA256)
+}
+
+// ResolveSyscallSucceeded returns whether the syscall succeeded
+func (fh *EBPFLessFieldHandlers) ResolveSyscallSucceeded(_ *model.Event, e *model.SyscallEvent) bool {
+	return e.IsSuccess()
+}
+
+// ResolveSyscallFailed returns whether the syscall failed
+func (fh *EBPFLessFieldHandlers) ResolveSyscallFailed(_ *model.Event, e *model.SyscallEvent) bool {
+	return !e.IsSuccess()
+}
+
+// ResolveRenameDestinationContainsDotDot resolves whether the destination path argument of the rename contains ".." segments
+func (fh *EBPFLessFieldHandlers) ResolveRenameDestinationContainsDotDot(ev *model.Event, e *model.RenameEvent) bool {
+	return model.ContainsDotDotSegment(fh.ResolveSyscallCtxArgsStr2(ev, &e.SyscallContext))
+}
+
+// ResolveLinkDestinationContainsDotDot resolves whether the destination path argument of the link contains ".." segments
+func (fh *EBPFLessFieldHandlers) ResolveLinkDestinationContainsDotDot(ev *model.Event, e *model.LinkEvent) bool {
+	return model.ContainsDotDotSegment(fh.ResolveSyscallCtxArgsStr2(ev, &e.SyscallContext))
+}
+
+// ResolveRenameCrossMount resolves whether the rename crosses mount points
+func (fh *EBPFLessFieldHandlers) ResolveRenameCrossMount(_ *model.Event, e *model.RenameEvent) bool {
+	return model.IsCrossMount(&e.Old, &e.New)
+}
+
+// ResolveLinkCrossMount resolves whether the link crosses mount points
+func (fh *EBPFLessFieldHandlers) ResolveLinkCrossMount(_ *model.Event, e *model.LinkEvent) bool {
+	return model.IsCrossMount(&e.Source, &e.Target)
+}
+
+// ResolveRenameBasenameChanged resolves whether the rename changes the basename of the file
+func (fh *EBPFLessFieldHandlers) ResolveRenameBasenameChanged(ev *model.Event, e *model.RenameEvent) bool {
+	return fh.ResolveFileBasename(ev, &e.Old) != fh.ResolveFileBasename(ev, &e.New)
+}
+
+// ResolveLinkBasenameChanged resolves whether the link has a basename different from the one of its source
+func (fh *EBPFLessFieldHandlers) ResolveLinkBasenameChanged(ev *model.Event, e *model.LinkEvent) bool {
+	return fh.ResolveFileBasename(ev, &e.Source) != fh.ResolveFileBasename(ev, &e.Target)
+}
+
+// ResolveUnlinkIsSelfDelete resolves whether the process deletes its own executable
+func (fh *EBPFLessFieldHandlers) ResolveUnlinkIsSelfDelete(ev *model.Event, e *model.UnlinkEvent) bool {
+	return e.IsSelfDelete(ev.ProcessContext)
+}
+
+// ResolveCredentialsUIDMismatch resolves whether the effective UID of the process differs from its real UID
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsUIDMismatch(_ *model.Event, e *model.Credentials) bool {
+	return e.IsUIDMismatch()
+}
+
+// ResolveCredentialsGIDMismatch resolves whether the effective GID of the process differs from its real GID
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsGIDMismatch(_ *model.Event, e *model.Credentials) bool {
+	return e.IsGIDMismatch()
+}
+
+// ResolveCredentialsUser resolves the user of the process
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsUser(ev *model.Event, e *model.Credentials) string {
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsGroup resolves the group of the process
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsGroup(ev *model.Event, e *model.Credentials) string {
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsEUser resolves the effective user of the process
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsEUser(ev *model.Event, e *model.Credentials) string {
+	return e.GetEUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsEGroup resolves the effective group of the process
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsEGroup(ev *model.Event, e *model.Credentials) string {
+	return e.GetEGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsFSUser resolves the file-system user of the process
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsFSUser(ev *model.Event, e *model.Credentials) string {
+	return e.GetFSUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveCredentialsFSGroup resolves the file-system group of the process
+func (fh *EBPFLessFieldHandlers) ResolveCredentialsFSGroup(ev *model.Event, e *model.Credentials) string {
+	return e.GetFSGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveChownToRoot resolves whether the file is chown-ed to the root user or the root group
+func (fh *EBPFLessFieldHandlers) ResolveChownToRoot(_ *model.Event, e *model.ChownEvent) bool {
+	return e.IsToRoot()
+}
+
+// ResolveOpenIsWrite resolves whether the file is opened for writing
+func (fh *EBPFLessFieldHandlers) ResolveOpenIsWrite(_ *model.Event, e *model.OpenEvent) bool {
+	return e.IsWrite()
+}
+
+// ResolveOpenIsTruncating resolves whether the file is truncated when opened
+func (fh *EBPFLessFieldHandlers) ResolveOpenIsTruncating(_ *model.Event, e *model.OpenEvent) bool {
+	return e.IsTruncating()
+}
+
 // ResolveProcessIsThread returns true is the process is a thread
 func (fh *EBPFLessFieldHandlers) ResolveProcessIsThread(_ *model.Event, process *model.Process) bool {
 	return !process.IsExec
 }
 
+// ResolveProcessIsInit returns whether the process is the init process of its pid namespace
+func (fh *EBPFLessFieldHandlers) ResolveProcessIsInit(_ *model.Event, process *model.Process) bool {
+	// the pids reported by the tracer are the ones of its namespace
+	return process.IsNamespaceInit()
+}
+
+// ResolveProcessCwd resolves the working directory of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessCwd(_ *model.Event, process *model.Process) string {
+	return process.Cwd
+}
+
+// ResolveProcessPIDNamespace resolves the PID namespace of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessPIDNamespace(_ *model.Event, e *model.PIDContext) int {
+	return int(e.NSID)
+}
+
+// ResolveProcessMntNamespace resolves the mount namespace of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessMntNamespace(_ *model.Event, e *model.PIDContext) int {
+	return int(e.MntNS)
+}
+
+// ResolveProcessNetNamespace resolves the network namespace of the process
+func (fh *EBPFLessFieldHandlers) ResolveProcessNetNamespace(_ *model.Event, e *model.PIDContext) int {
+	return int(e.NetNS)
+}
+
 // GetProcessCacheEntry queries the ProcessResolver to retrieve the ProcessContext of the event
 func (fh *EBPFLessFieldHandlers) GetProcessCacheEntry(ev *model.Event) (*model.ProcessCacheEntry, bool) {
 	ev.ProcessCacheEntry = fh.resolvers.ProcessResolver.Resolve(sprocess.CacheResolverKey{
@@ -222,13 +514,21 @@ func (fh *EBPFLessFieldHandlers) ResolveProcessCreatedAt(_ *model.Event, e *mode
 func (fh *EBPFLessFieldHandlers) ResolveAsync(ev *model.Event) bool { return ev.Async }
 
 // ResolveChownGID resolves the ResolveProcessCacheEntry group id of a chown event to a username
-func (fh *EBPFLessFieldHandlers) ResolveChownGID(_ *model.Event, e *model.ChownEvent) string {
-	return e.Group
+func (fh *EBPFLessFieldHandlers) ResolveChownGID(ev *model.Event, e *model.ChownEvent) string {
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
 }
 
 // ResolveChownUID resolves the ResolveProcessCacheEntry id of a chown event to a username
-func (fh *EBPFLessFieldHandlers) ResolveChownUID(_ *model.Event, e *model.ChownEvent) string {
-	return e.User
+func (fh *EBPFLessFieldHandlers) ResolveChownUID(ev *model.Event, e *model.ChownEvent) string {
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveLinkType resolves the type of a link event
+func (fh *EBPFLessFieldHandlers) ResolveLinkType(_ *model.Event, e *model.LinkEvent) string {
+	if len(e.Type) == 0 {
+		e.Type = e.GetLinkType()
+	}
+	return e.Type
 }
 
 // ResolveEventTimestamp resolves the monolitic kernel event timestamp to an absolute time
@@ -237,8 +537,16 @@ func (fh *EBPFLessFieldHandlers) ResolveEventTimestamp(_ *model.Event, e *model.
 }
 
 // ResolveFileFieldsGroup resolves the group id of the file to a group name
-func (fh *EBPFLessFieldHandlers) ResolveFileFieldsGroup(_ *model.Event, e *model.FileFields) string {
-	return e.Group
+func (fh *EBPFLessFieldHandlers) ResolveFileFieldsGroup(ev *model.Event, e *model.FileFields) string {
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+// ResolveFileFieldsID resolves the identifier of the file, unique across mounts
+func (fh *EBPFLessFieldHandlers) ResolveFileFieldsID(_ *model.Event, e *model.FileFields) string {
+	if len(e.ID) == 0 {
+		e.ID = e.GetID()
+	}
+	return e.ID
 }
 
 // ResolveFileFieldsInUpperLayer resolves whether the file is in an upper layer
@@ -247,8 +555,8 @@ func (fh *EBPFLessFieldHandlers) ResolveFileFieldsInUpperLayer(_ *model.Event, e
 }
 
 // ResolveFileFieldsUser resolves the user id of the file to a username
-func (fh *EBPFLessFieldHandlers) ResolveFileFieldsUser(_ *model.Event, e *model.FileFields) string {
-	return e.User
+func (fh *EBPFLessFieldHandlers) ResolveFileFieldsUser(ev *model.Event, e *model.FileFields) string {
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
 }
 
 // ResolveFileFilesystem resolves the filesystem a file resides in
@@ -256,6 +564,11 @@ func (fh *EBPFLessFieldHandlers) ResolveFileFilesystem(_ *model.Event, e *model.
 	return e.Filesystem
 }
 
+// ResolveFileMountPath resolves the path of the mount point of the mount a file resides in
+func (fh *EBPFLessFieldHandlers) ResolveFileMountPath(_ *model.Event, e *model.FileEvent) string {
+	return e.MountPath
+}
+
 // ResolveK8SGroups resolves the k8s groups of the event
 func (fh *EBPFLessFieldHandlers) ResolveK8SGroups(_ *model.Event, e *model.UserSessionContext) []string {
 	return e.K8SGroups
@@ -327,32 +640,50 @@ func (fh *EBPFLessFieldHandlers) ResolveSELinuxBoolName(_ *model.Event, e *model
 }
 
 // ResolveSetgidEGroup resolves the effective group of the Setgid event
-func (fh *EBPFLessFieldHandlers) ResolveSetgidEGroup(_ *model.Event, e *model.SetgidEvent) string {
+func (fh *EBPFLessFieldHandlers) ResolveSetgidEGroup(ev *model.Event, e *model.SetgidEvent) string {
+	if len(e.EGroup) == 0 {
+		e.EGroup = fh.fileOwners.ResolveGroup(e.EGID, ev.ContainerContext.ContainerID)
+	}
 	return e.EGroup
 }
 
 // ResolveSetgidFSGroup resolves the file-system group of the Setgid event
-func (fh *EBPFLessFieldHandlers) ResolveSetgidFSGroup(_ *model.Event, e *model.SetgidEvent) string {
+func (fh *EBPFLessFieldHandlers) ResolveSetgidFSGroup(ev *model.Event, e *model.SetgidEvent) string {
+	if len(e.FSGroup) == 0 {
+		e.FSGroup = fh.fileOwners.ResolveGroup(e.FSGID, ev.ContainerContext.ContainerID)
+	}
 	return e.FSGroup
 }
 
 // ResolveSetgidGroup resolves the group of the Setgid event
-func (fh *EBPFLessFieldHandlers) ResolveSetgidGroup(_ *model.Event, e *model.SetgidEvent) string {
+func (fh *EBPFLessFieldHandlers) ResolveSetgidGroup(ev *model.Event, e *model.SetgidEvent) string {
+	if len(e.Group) == 0 {
+		e.Group = fh.fileOwners.ResolveGroup(e.GID, ev.ContainerContext.ContainerID)
+	}
 	return e.Group
 }
 
 // ResolveSetuidEUser resolves the effective user of the Setuid event
-func (fh *EBPFLessFieldHandlers) ResolveSetuidEUser(_ *model.Event, e *model.SetuidEvent) string {
+func (fh *EBPFLessFieldHandlers) ResolveSetuidEUser(ev *model.Event, e *model.SetuidEvent) string {
+	if len(e.EUser) == 0 {
+		e.EUser = fh.fileOwners.ResolveUser(e.EUID, ev.ContainerContext.ContainerID)
+	}
 	return e.EUser
 }
 
 // ResolveSetuidFSUser resolves the file-system user of the Setuid event
-func (fh *EBPFLessFieldHandlers) ResolveSetuidFSUser(_ *model.Event, e *model.SetuidEvent) string {
+func (fh *EBPFLessFieldHandlers) ResolveSetuidFSUser(ev *model.Event, e *model.SetuidEvent) string {
+	if len(e.FSUser) == 0 {
+		e.FSUser = fh.fileOwners.ResolveUser(e.FSUID, ev.ContainerContext.ContainerID)
+	}
 	return e.FSUser
 }
 
 // ResolveSetuidUser resolves the user of the Setuid event
-func (fh *EBPFLessFieldHandlers) ResolveSetuidUser(_ *model.Event, e *model.SetuidEvent) string {
+func (fh *EBPFLessFieldHandlers) ResolveSetuidUser(ev *model.Event, e *model.SetuidEvent) string {
+	if len(e.User) == 0 {
+		e.User = fh.fileOwners.ResolveUser(e.UID, ev.ContainerContext.ContainerID)
+	}
 	return e.User
 }
 
@@ -366,6 +697,16 @@ func (fh *EBPFLessFieldHandlers) ResolveXAttrNamespace(_ *model.Event, e *model.
 	return e.Namespace
 }
 
+// ResolveXAttrIsSecurityLabel resolves whether the extended attribute is a security label
+func (fh *EBPFLessFieldHandlers) ResolveXAttrIsSecurityLabel(ev *model.Event, e *model.SetXAttrEvent) bool {
+	return model.IsSecurityLabelXAttr(fh.ResolveXAttrNamespace(ev, e), fh.ResolveXAttrName(ev, e), fh.config.Probe.SecurityLabelXAttrs)
+}
+
+// ResolveXAttrAffectsIntegrity resolves whether the extended attribute holds integrity measurements or signatures
+func (fh *EBPFLessFieldHandlers) ResolveXAttrAffectsIntegrity(ev *model.Event, e *model.SetXAttrEvent) bool {
+	return model.IsIntegrityXAttr(fh.ResolveXAttrNamespace(ev, e), fh.ResolveXAttrName(ev, e))
+}
+
 // ResolveHashes resolves the hash of the provided file
 func (fh *EBPFLessFieldHandlers) ResolveHashes(eventType model.EventType, process *model.Process, file *model.FileEvent) []string {
 	return fh.resolvers.HashResolver.ComputeHashes(eventType, process, file)
@@ -469,3 +810,13 @@ func (fh *EBPFLessFieldHandlers) ResolveOnDemandArg4Str(_ *model.Event, _ *model
 func (fh *EBPFLessFieldHandlers) ResolveOnDemandArg4Uint(_ *model.Event, _ *model.OnDemandEvent) int {
 	return 0
 }
+
+// ResolveSetuidChanged returns whether the setuid call changed the UID of the process
+func (fh *EBPFLessFieldHandlers) ResolveSetuidChanged(_ *model.Event, e *model.SetuidEvent) bool {
+	return e.IsUIDChanged()
+}
+
+// ResolveSetgidChanged returns whether the setgid call changed the GID of the process
+func (fh *EBPFLessFieldHandlers) ResolveSetgidChanged(_ *model.Event, e *model.SetgidEvent) bool {
+	return e.IsGIDChanged()
+}
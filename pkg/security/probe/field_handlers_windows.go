@@ -90,6 +90,26 @@ func (fh *FieldHandlers) ResolveProcessCacheEntry(ev *model.Event, _ func(*model
 	return ev.ProcessCacheEntry, true
 }
 
+// ResolveProcessAncestorsExecCount returns the number of re-exec links in the ancestor chain
+func (fh *FieldHandlers) ResolveProcessAncestorsExecCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.CountAncestorsExecs()
+}
+
+// ResolveProcessFileEventsCount returns the number of open, rename and unlink events of the process
+func (fh *FieldHandlers) ResolveProcessFileEventsCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.FileEventsCount
+}
+
+// ResolveProcessMetadataChangeCount returns the number of metadata change events of the process
+func (fh *FieldHandlers) ResolveProcessMetadataChangeCount(_ *model.Event, pc *model.ProcessContext) int {
+	return pc.MetadataChangeCount
+}
+
+// ResolveProcessHasAncestors returns whether the process has at least one ancestor
+func (fh *FieldHandlers) ResolveProcessHasAncestors(_ *model.Event, pc *model.ProcessContext) bool {
+	return pc.HasAncestor()
+}
+
 // ResolveProcessCmdLineScrubbed returns a scrubbed version of the cmdline
 func (fh *FieldHandlers) ResolveProcessCmdLineScrubbed(_ *model.Event, e *model.Process) string {
 	return fh.resolvers.ProcessResolver.GetProcessCmdLineScrubbed(e)
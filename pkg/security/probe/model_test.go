@@ -9,7 +9,10 @@
 package probe
 
 import (
+	"os"
+	"path/filepath"
 	"sort"
+	"syscall"
 	"testing"
 
 	"github.com/DataDog/datadog-agent/pkg/process/procutil"
@@ -202,3 +205,102 @@ func TestBestGuessServiceValues(t *testing.T) {
 		})
 	}
 }
+
+func TestLinkType(t *testing.T) {
+	handlers := map[string]model.FieldHandlers{
+		"ebpf":     &EBPFFieldHandlers{},
+		"ebpfless": &EBPFLessFieldHandlers{},
+	}
+
+	tests := []struct {
+		name       string
+		isSymbolic bool
+		targetPath string
+		linkType   string
+	}{
+		{
+			name:     "hardlink",
+			linkType: model.LinkTypeHard,
+		},
+		{
+			name:       "symlink",
+			isSymbolic: true,
+			targetPath: "../lib/libc.so.6",
+			linkType:   model.LinkTypeSymbolic,
+		},
+	}
+
+	for name, fh := range handlers {
+		for _, test := range tests {
+			t.Run(name+"/"+test.name, func(t *testing.T) {
+				event := model.NewFakeEvent()
+				event.FieldHandlers = fh
+				event.Type = uint32(model.FileLinkEventType)
+				event.Link.IsSymbolic = test.isSymbolic
+				event.Link.TargetPath = test.targetPath
+
+				value, err := event.GetFieldValue("link.type")
+				assert.NoError(t, err)
+				assert.Equal(t, test.linkType, value)
+				assert.Equal(t, test.linkType, event.Link.Type)
+
+				value, err = event.GetFieldValue("link.target_path")
+				assert.NoError(t, err)
+				assert.Equal(t, test.targetPath, value)
+			})
+		}
+	}
+}
+
+func TestFileResolvedPath(t *testing.T) {
+	dir := t.TempDir()
+
+	target := filepath.Join(dir, "passwd")
+	if err := os.WriteFile(target, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	symlinks := map[string]string{
+		"absolute": target,
+		"relative": "passwd",
+		"chained":  "relative",
+		"dangling": "missing",
+	}
+	for name, linkTarget := range symlinks {
+		if err := os.Symlink(linkTarget, filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name     string
+		path     string
+		mode     uint16
+		expected string
+	}{
+		{name: "absolute", path: filepath.Join(dir, "absolute"), mode: syscall.S_IFLNK, expected: target},
+		{name: "relative", path: filepath.Join(dir, "relative"), mode: syscall.S_IFLNK, expected: target},
+		{name: "chained", path: filepath.Join(dir, "chained"), mode: syscall.S_IFLNK, expected: target},
+		{name: "dangling", path: filepath.Join(dir, "dangling"), mode: syscall.S_IFLNK, expected: filepath.Join(dir, "dangling")},
+		{name: "regular", path: target, mode: syscall.S_IFREG, expected: target},
+		// the file of the event isn't a symlink, even if the path now points to one
+		{name: "not-symlink", path: filepath.Join(dir, "absolute"), mode: syscall.S_IFREG, expected: filepath.Join(dir, "absolute")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ev := model.NewFakeEvent()
+			ev.Type = uint32(model.FileOpenEventType)
+			ev.FieldHandlers = &EBPFFieldHandlers{}
+			ev.PIDContext.Pid = uint32(os.Getpid())
+			ev.Open.File.SetPathnameStr(test.path)
+			ev.Open.File.Mode = test.mode
+
+			value, err := ev.GetFieldValue("open.file.resolved_path")
+			if err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, test.expected, value)
+		})
+	}
+}
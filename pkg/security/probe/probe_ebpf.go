@@ -255,7 +255,11 @@ func (p *EBPFProbe) sanityChecks() error {
 
 // NewModel returns a new Model
 func (p *EBPFProbe) NewModel() *model.Model {
-	return NewEBPFModel(p)
+	m := NewEBPFModel(p)
+	if p.fieldHandlers != nil {
+		p.fieldHandlers.shareWithModel(m)
+	}
+	return m
 }
 
 // VerifyOSVersion returns an error if the current kernel version is not supported
@@ -1024,6 +1028,8 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 			seclog.Errorf("failed to decode setuid event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
+		// the credentials of the process context are updated once the event is dispatched
+		event.SetUID.PreviousUID = event.ProcessContext.Credentials.UID
 		defer p.Resolvers.ProcessResolver.UpdateUID(event.PIDContext.Pid, event)
 	case model.SetgidEventType:
 		// the process context may be incorrect, do not modify it
@@ -1035,6 +1041,7 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 			seclog.Errorf("failed to decode setgid event: %s (offset %d, len %d)", err, offset, len(data))
 			return
 		}
+		event.SetGID.PreviousGID = event.ProcessContext.Credentials.GID
 		defer p.Resolvers.ProcessResolver.UpdateGID(event.PIDContext.Pid, event)
 	case model.CapsetEventType:
 		// the process context may be incorrect, do not modify it
@@ -1240,6 +1247,9 @@ func (p *EBPFProbe) handleEvent(CPU int, data []byte) {
 		}
 	}
 
+	// count the file events of the process, for the mass file operations detection
+	event.ProcessCacheEntry.CountFileEvent(eventType)
+
 	// resolve the container context
 	event.ContainerContext, _ = p.fieldHandlers.ResolveContainerContext(event)
 
@@ -2478,6 +2488,17 @@ func AppendProbeRequestsToFetcher(constantFetcher constantfetch.ConstantFetcher,
 		constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructPID, "struct task_struct", "thread_pid")
 	}
 
+	// namespace inode offsets
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameUPIDStructNS, "struct upid", "ns")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructNSProxy, "struct task_struct", "nsproxy")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameMntNamespaceStructNS, "struct mnt_namespace", "ns")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNamePIDNamespaceStructNS, "struct pid_namespace", "ns")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameNSCommonStructInum, "struct ns_common", "inum")
+
+	// working directory offsets
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameTaskStructFS, "struct task_struct", "fs")
+	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNameFSStructPWD, "struct fs_struct", "pwd")
+
 	// splice event
 	constantFetcher.AppendOffsetofRequest(constantfetch.OffsetNamePipeInodeInfoStructBufs, "struct pipe_inode_info", "bufs")
 	if kv.HaveLegacyPipeInodeInfoStruct() {
@@ -119,6 +119,7 @@ func copyFileAttributes(src *ebpfless.FileSyscallMsg, dst *model.FileEvent) {
 	} else {
 		dst.SetPathnameStr(src.Filename)
 		dst.SetBasenameStr(filepath.Base(src.Filename))
+		dst.ResolvedPathnameStr = src.ResolvedFilename
 	}
 	dst.CTime = src.CTime
 	dst.MTime = src.MTime
@@ -163,6 +164,13 @@ func (p *EBPFLessProbe) handleSyscallMsg(cl *client, syscallMsg *ebpfless.Syscal
 			entry.Credentials.Group = syscallMsg.Exec.Credentials.Group
 			entry.Credentials.EGroup = syscallMsg.Exec.Credentials.EGroup
 		}
+		if syscallMsg.Exec.Cwd != "" {
+			entry.Cwd = syscallMsg.Exec.Cwd
+		}
+		// the tracer namespace only keys the process cache, report the namespace of the traced process
+		entry.NSID = syscallMsg.Exec.PidNS
+		entry.MntNS = syscallMsg.Exec.MntNS
+		entry.NetNS = syscallMsg.Exec.NetNS
 		event.Exec.Process = &entry.Process
 		copyFileAttributes(&syscallMsg.Exec.File, &event.Exec.FileEvent)
 
@@ -178,6 +186,9 @@ func (p *EBPFLessProbe) handleSyscallMsg(cl *client, syscallMsg *ebpfless.Syscal
 		event.Open.Flags = syscallMsg.Open.Flags
 
 	case ebpfless.SyscallTypeSetUID:
+		if entry := p.Resolvers.ProcessResolver.Resolve(process.CacheResolverKey{Pid: syscallMsg.PID, NSID: cl.nsID}); entry != nil {
+			event.SetUID.PreviousUID = entry.Credentials.UID
+		}
 		p.Resolvers.ProcessResolver.UpdateUID(process.CacheResolverKey{Pid: syscallMsg.PID, NSID: cl.nsID}, syscallMsg.SetUID.UID, syscallMsg.SetUID.EUID)
 		event.Type = uint32(model.SetuidEventType)
 		event.SetUID.UID = uint32(syscallMsg.SetUID.UID)
@@ -186,6 +197,9 @@ func (p *EBPFLessProbe) handleSyscallMsg(cl *client, syscallMsg *ebpfless.Syscal
 		event.SetUID.EUser = syscallMsg.SetUID.EUser
 
 	case ebpfless.SyscallTypeSetGID:
+		if entry := p.Resolvers.ProcessResolver.Resolve(process.CacheResolverKey{Pid: syscallMsg.PID, NSID: cl.nsID}); entry != nil {
+			event.SetGID.PreviousGID = entry.Credentials.GID
+		}
 		p.Resolvers.ProcessResolver.UpdateGID(process.CacheResolverKey{Pid: syscallMsg.PID, NSID: cl.nsID}, syscallMsg.SetGID.GID, syscallMsg.SetGID.EGID)
 		event.Type = uint32(model.SetgidEventType)
 		event.SetGID.GID = uint32(syscallMsg.SetGID.GID)
@@ -242,6 +256,8 @@ func (p *EBPFLessProbe) handleSyscallMsg(cl *client, syscallMsg *ebpfless.Syscal
 		event.Link.Retval = syscallMsg.Retval
 		copyFileAttributes(&syscallMsg.Link.Target, &event.Link.Source)
 		copyFileAttributes(&syscallMsg.Link.Link, &event.Link.Target)
+		event.Link.IsSymbolic = syscallMsg.Link.Type == ebpfless.LinkTypeSymbolic
+		event.Link.TargetPath = syscallMsg.Link.TargetPath
 
 	case ebpfless.SyscallTypeChmod:
 		event.Type = uint32(model.FileChmodEventType)
@@ -316,6 +332,9 @@ func (p *EBPFLessProbe) handleSyscallMsg(cl *client, syscallMsg *ebpfless.Syscal
 	}
 	event.ProcessContext = &event.ProcessCacheEntry.ProcessContext
 
+	// count the file events of the process, for the mass file operations detection
+	event.ProcessCacheEntry.CountFileEvent(event.GetEventType())
+
 	if syscallMsg.Type == ebpfless.SyscallTypeExit {
 		event.Type = uint32(model.ExitEventType)
 		event.ProcessContext.ExitTime = time.Unix(0, int64(syscallMsg.Timestamp))
@@ -551,7 +570,11 @@ func (p *EBPFLessProbe) OnNewDiscarder(_ *rules.RuleSet, _ *model.Event, _ eval.
 
 // NewModel returns a new Model
 func (p *EBPFLessProbe) NewModel() *model.Model {
-	return NewEBPFLessModel()
+	m := NewEBPFLessModel()
+	if p.fieldHandlers != nil {
+		p.fieldHandlers.shareWithModel(m)
+	}
+	return m
 }
 
 // SendStats send the stats
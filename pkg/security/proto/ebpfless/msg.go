@@ -124,6 +124,10 @@ type ExecSyscallMsg struct {
 	Envs          []string
 	EnvsTruncated bool
 	TTY           string
+	Cwd           string
+	PidNS         uint64
+	MntNS         uint64
+	NetNS         uint32
 	Credentials   *Credentials
 	PPID          uint32
 	FromProcFS    bool
@@ -142,12 +146,13 @@ type ExitSyscallMsg struct {
 
 // FileSyscallMsg defines a file message
 type FileSyscallMsg struct {
-	Filename    string
-	CTime       uint64
-	MTime       uint64
-	Mode        uint32
-	Inode       uint64
-	Credentials *Credentials
+	Filename         string
+	ResolvedFilename string
+	CTime            uint64
+	MTime            uint64
+	Mode             uint32
+	Inode            uint64
+	Credentials      *Credentials
 }
 
 // OpenSyscallMsg defines an open message
@@ -246,9 +251,10 @@ const (
 
 // LinkSyscallMsg defines a link/linkat/symlink/symlinkat message
 type LinkSyscallMsg struct {
-	Type   LinkType
-	Target FileSyscallMsg
-	Link   FileSyscallMsg
+	Type       LinkType
+	Target     FileSyscallMsg
+	Link       FileSyscallMsg
+	TargetPath string
 }
 
 // ChmodSyscallMsg defines a chmod/fchmod/fchmodat/fchmodat2 message
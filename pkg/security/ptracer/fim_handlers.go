@@ -894,6 +894,7 @@ func handleSymlink(tracer *Tracer, process *Process, msg *ebpfless.SyscallMsg, r
 	if err != nil {
 		return err
 	}
+	targetPath := targetFilename
 
 	targetFilename, err = getFullPathFromFilename(process, targetFilename)
 	if err != nil {
@@ -919,6 +920,7 @@ func handleSymlink(tracer *Tracer, process *Process, msg *ebpfless.SyscallMsg, r
 		Link: ebpfless.FileSyscallMsg{
 			Filename: linkFilename,
 		},
+		TargetPath: targetPath,
 	}
 	return fillFileMetadata(tracer, targetFilename, &msg.Link.Target, disableStats)
 }
@@ -930,6 +932,7 @@ func handleSymlinkAt(tracer *Tracer, process *Process, msg *ebpfless.SyscallMsg,
 	if err != nil {
 		return err
 	}
+	targetPath := targetFilename
 
 	targetFilename, err = getFullPathFromFd(process, targetFilename, targetFD)
 	if err != nil {
@@ -957,6 +960,7 @@ func handleSymlinkAt(tracer *Tracer, process *Process, msg *ebpfless.SyscallMsg,
 		Link: ebpfless.FileSyscallMsg{
 			Filename: linkFilename,
 		},
+		TargetPath: targetPath,
 	}
 	return fillFileMetadata(tracer, targetFilename, &msg.Link.Target, disableStats)
 }
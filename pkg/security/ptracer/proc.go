@@ -240,6 +240,7 @@ func procToMsg(proc *ProcProcess) (*ebpfless.Message, error) {
 	}
 
 	envs, truncated, _ := collectPIDEnvVars(proc.Pid)
+	cwd, _ := proc.Cwd()
 
 	containerID, err := getProcContainerID(int(proc.Pid))
 	if err != nil {
@@ -261,6 +262,10 @@ func procToMsg(proc *ProcProcess) (*ebpfless.Message, error) {
 				Envs:          envs,
 				EnvsTruncated: truncated,
 				TTY:           getPidTTY(int(proc.Pid)),
+				Cwd:           cwd,
+				PidNS:         getProcessNamespace(int(proc.Pid), "pid"),
+				MntNS:         getProcessNamespace(int(proc.Pid), "mnt"),
+				NetNS:         uint32(getProcessNamespace(int(proc.Pid), "net")),
 				Credentials: &ebpfless.Credentials{
 					UID:  uint32(uids[0]),
 					EUID: uint32(uids[1]),
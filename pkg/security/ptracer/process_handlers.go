@@ -181,6 +181,10 @@ func handleExecveAt(tracer *Tracer, process *Process, msg *ebpfless.SyscallMsg,
 		Envs:          envs,
 		EnvsTruncated: envsTruncated,
 		TTY:           getPidTTY(process.Pid),
+		Cwd:           getProcessCwd(process),
+		PidNS:         getProcessNamespace(process.Pid, "pid"),
+		MntNS:         getProcessNamespace(process.Pid, "mnt"),
+		NetNS:         uint32(getProcessNamespace(process.Pid, "net")),
 	}
 	// special case for execveat: we store ALSO the msg in execve bucket (see cws.go)
 	process.Nr[ExecveNr] = msg
@@ -222,6 +226,10 @@ func handleExecve(tracer *Tracer, process *Process, msg *ebpfless.SyscallMsg, re
 		Envs:          envs,
 		EnvsTruncated: envsTruncated,
 		TTY:           getPidTTY(process.Pid),
+		Cwd:           getProcessCwd(process),
+		PidNS:         getProcessNamespace(process.Pid, "pid"),
+		MntNS:         getProcessNamespace(process.Pid, "mnt"),
+		NetNS:         uint32(getProcessNamespace(process.Pid, "net")),
 	}
 	return fillFileMetadata(tracer, filename, &msg.Exec.File, disableStats)
 }
@@ -96,12 +96,27 @@ func getProcContainerID(pid int) (containerutils.ContainerID, error) {
 	return getContainerIDFromProcFS(fmt.Sprintf("/proc/%d/cgroup", pid))
 }
 
-func getNSID() uint64 {
+// getNamespaceID returns the inode of the namespace file at the given path
+func getNamespaceID(path string) (uint64, error) {
 	var stat syscall.Stat_t
-	if err := syscall.Stat("/proc/self/ns/pid", &stat); err != nil {
+	if err := syscall.Stat(path, &stat); err != nil {
+		return 0, err
+	}
+	return stat.Ino, nil
+}
+
+func getNSID() uint64 {
+	nsID, err := getNamespaceID("/proc/self/ns/pid")
+	if err != nil {
 		return rand.Uint64()
 	}
-	return stat.Ino
+	return nsID
+}
+
+// getProcessNamespace returns the inode of the given namespace of the process, 0 if it can't be resolved
+func getProcessNamespace(pid int, ns string) uint64 {
+	nsID, _ := getNamespaceID(fmt.Sprintf("/proc/%d/ns/%s", pid, ns))
+	return nsID
 }
 
 // simpleHTTPRequest used to avoid importing the crypto golang package
@@ -171,6 +186,14 @@ func fillProcessCwd(process *Process) error {
 	return nil
 }
 
+// getProcessCwd returns the working directory of the process, an empty string if it can't be resolved
+func getProcessCwd(process *Process) string {
+	if process.FsRes.Cwd == "" {
+		_ = fillProcessCwd(process)
+	}
+	return process.FsRes.Cwd
+}
+
 func getFullPathFromFd(process *Process, filename string, fd int32) (string, error) {
 	if len(filename) > 0 && filename[0] != '/' {
 		if fd == unix.AT_FDCWD { // if use current dir, try to prefix it
@@ -291,14 +314,14 @@ func getGroupFromGID(tracer *Tracer, gid int32) string {
 	return ""
 }
 
-func fillFileMetadata(tracer *Tracer, filepath string, fileMsg *ebpfless.FileSyscallMsg, disableStats bool) error {
-	if disableStats || strings.HasPrefix(filepath, "memfd:") {
+func fillFileMetadata(tracer *Tracer, filename string, fileMsg *ebpfless.FileSyscallMsg, disableStats bool) error {
+	if disableStats || strings.HasPrefix(filename, "memfd:") {
 		return nil
 	}
 
 	// NB: Here we use Lstat to not follow the link, because we don't do it yet globally.
 	//     Once we'll follow them, we may want to replace it by a Stat().
-	fileInfo, err := os.Lstat(filepath)
+	fileInfo, err := os.Lstat(filename)
 	if err != nil {
 		return nil
 	}
@@ -315,6 +338,11 @@ func fillFileMetadata(tracer *Tracer, filepath string, fileMsg *ebpfless.FileSys
 	if fileMsg.Mode == 0 { // here, mode can be already set by handler of open syscalls
 		fileMsg.Mode = stat.Mode // useful for exec handlers
 	}
+
+	// the symlinks can be anywhere in the path, not only the last component
+	if resolved, err := filepath.EvalSymlinks(filename); err == nil {
+		fileMsg.ResolvedFilename = resolved
+	}
 	return nil
 }
 
@@ -17,6 +17,7 @@ import (
 	"io"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -204,6 +205,12 @@ func (p *EBPFResolver) SendStats() error {
 		}
 	}
 
+	if count := model.GetAndResetAncestorsLoopCount(); count > 0 {
+		if err := p.statsdClient.Count(metrics.MetricProcessResolverAncestorsLoop, int64(count), []string{}, 1.0); err != nil {
+			return fmt.Errorf("failed to send process_resolver ancestors loop metric: %w", err)
+		}
+	}
+
 	if count := p.argsTruncated.Swap(0); count > 0 {
 		if err := p.statsdClient.Count(metrics.MetricProcessResolverArgsTruncated, count, []string{}, 1.0); err != nil {
 			return fmt.Errorf("failed to send args truncated metric: %w", err)
@@ -436,7 +443,7 @@ func (p *EBPFResolver) enrichEventFromProc(entry *model.ProcessCacheEntry, proc
 		return fmt.Errorf("snapshot failed for %d: couldn't get login UID: %w", proc.Pid, err)
 	}
 
-	entry.Credentials.CapEffective, entry.Credentials.CapPermitted, err = utils.CapEffCapEprm(uint32(proc.Pid))
+	entry.Credentials.CapEffective, entry.Credentials.CapPermitted, entry.Credentials.CapAmbient, err = utils.CapEffCapEprmCapAmb(uint32(proc.Pid))
 	if err != nil {
 		return fmt.Errorf("snapshot failed for %d: couldn't parse kernel capabilities: %w", proc.Pid, err)
 	}
@@ -487,8 +494,15 @@ func (p *EBPFResolver) enrichEventFromProc(entry *model.ProcessCacheEntry, proc
 		entry.LinuxBinprm.FileEvent.SetBasenameStr("")
 	}
 
-	// add netns
+	entry.Cwd, _ = os.Readlink(utils.ProcCwdPath(pid))
+
+	// add the namespaces, the kernel providing them for the processes started afterwards
 	entry.NetNS, _ = utils.NetNSPathFromPid(pid).GetProcessNetworkNamespace()
+	entry.MntNS, _ = utils.GetProcessMntNamespace(pid)
+	entry.NSID, _ = utils.GetProcessPidNamespace(pid)
+	if nspids, err := utils.GetNsPids(pid, strconv.FormatUint(uint64(pid), 10)); err == nil && len(nspids) > 0 {
+		entry.NSPid = nspids[len(nspids)-1]
+	}
 
 	if p.config.NetworkEnabled {
 		// snapshot pid routes in kernel space
@@ -527,6 +541,9 @@ func (p *EBPFResolver) retrieveExecFileFields(procExecPath string) (*model.FileF
 		return nil, fmt.Errorf("inode `%d` not found: %v", inode, err)
 	}
 
+	// the binary was unlinked while the process was running
+	fileFields.Deleted = stat.Nlink == 0
+
 	return &fileFields, nil
 }
 
@@ -733,6 +750,18 @@ func (p *EBPFResolver) SetProcessPath(fileEvent *model.FileEvent, pce *model.Pro
 	return fileEvent.PathnameStr, nil
 }
 
+// SetProcessCwd resolves the working directory captured at exec, left empty when it can't be resolved
+func (p *EBPFResolver) SetProcessCwd(entry *model.ProcessCacheEntry, ctrCtx *model.ContainerContext) {
+	if entry.CwdPathKey.Inode == 0 {
+		return
+	}
+
+	fields := model.FileFields{PathKey: entry.CwdPathKey}
+	if cwd, _, _, _, err := p.resolveFileFieldsPath(&fields, entry, ctrCtx); err == nil {
+		entry.Cwd = cwd
+	}
+}
+
 // SetProcessSymlink resolves process file symlink path
 func (p *EBPFResolver) SetProcessSymlink(entry *model.ProcessCacheEntry) {
 	// TODO: busybox workaround only for now
@@ -809,6 +838,7 @@ func (p *EBPFResolver) ResolveNewProcessCacheEntry(entry *model.ProcessCacheEntr
 		entry.LinuxBinprm.FileEvent.SetBasenameStr("")
 	}
 
+	p.SetProcessCwd(entry, ctrCtx)
 	p.SetProcessArgs(entry)
 	p.SetProcessEnvs(entry)
 	p.SetProcessTTY(entry)
@@ -1300,7 +1330,7 @@ func (p *EBPFResolver) newEntryFromProcfsAndSyncKernelMaps(proc *process.Process
 			seclog.Errorf("couldn't push proc_cache entry to kernel space: %s", err)
 		}
 	}
-	pidCacheEntryB := make([]byte, 88)
+	pidCacheEntryB := make([]byte, 104)
 	_, err = entry.Process.MarshalPidCache(pidCacheEntryB, bootTime)
 	if err != nil {
 		seclog.Errorf("couldn't marshal pid_cache entry: %s", err)
@@ -228,6 +228,9 @@ func (e *RuleEngine) Start(ctx context.Context, reloadChan <-chan struct{}, wg *
 				} else if e.config.FIMEnabled {
 					_ = e.statsdClient.Gauge(fimMetric, 1, tags, 1)
 				}
+
+				e.sendStringSetHits()
+				e.sendEvalLatencies()
 			}
 		}
 	}()
@@ -296,6 +299,7 @@ func (e *RuleEngine) LoadPolicies(providers []rules.PolicyProvider, sendLoadedRe
 	e.policyLoader.SetProviders(providers)
 
 	rs := e.probe.NewRuleSet(e.getEventTypeEnabled())
+	rs.SetEvalLatencyTelemetry(e.config.PolicyEvalLatencyEnabled)
 
 	loadErrs := rs.LoadPolicies(e.policyLoader, e.policyOpts)
 	if loadErrs.ErrorOrNil() != nil {
@@ -329,6 +333,8 @@ func (e *RuleEngine) LoadPolicies(providers []rules.PolicyProvider, sendLoadedRe
 	e.currentRuleSet.Store(rs)
 	ruleIDs = append(ruleIDs, rs.ListRuleIDs()...)
 
+	e.sendLegacyFieldUsages(rs)
+
 	if err := e.probe.FlushDiscarders(); err != nil {
 		return fmt.Errorf("failed to flush discarders: %w", err)
 	}
@@ -512,7 +518,59 @@ func (e *RuleEngine) getEventTypeEnabled() map[eval.EventType]bool {
 	return enabled
 }
 
-// GetRuleSet returns the set of loaded rules
+// sendStringSetHits reports the number of matches of each entry of the string sets of the loaded rule set
+func (e *RuleEngine) sendStringSetHits() {
+	rs := e.GetRuleSet()
+	if rs == nil {
+		return
+	}
+
+	for name, hits := range rs.FlushStringSetHits() {
+		for entry, count := range hits {
+			tags := []string{"set:" + name, "entry:" + entry}
+			_ = e.statsdClient.Count(metrics.MetricRulesStringSetHits, int64(count), tags, 1.0)
+		}
+	}
+}
+
+// sendLegacyFieldUsages reports the number of rules of the given rule set using each deprecated legacy field
+func (e *RuleEngine) sendLegacyFieldUsages(rs *rules.RuleSet) {
+	usages := make(map[eval.Field]int64)
+	for _, rule := range rs.GetRules() {
+		for _, field := range rule.GetLegacyFields() {
+			usages[field]++
+		}
+	}
+
+	for field, count := range usages {
+		tags := []string{"field:" + field}
+		_ = e.statsdClient.Count(metrics.MetricRulesDeprecatedField, count, tags, 1.0)
+	}
+}
+
+// sendEvalLatencies reports the rule evaluation latency histograms of the loaded rule set, per event type
+func (e *RuleEngine) sendEvalLatencies() {
+	rs := e.GetRuleSet()
+	if rs == nil {
+		return
+	}
+
+	for eventType, histogram := range rs.GetAndResetEvalLatencies() {
+		for i, count := range histogram.Counts {
+			if count == 0 {
+				continue
+			}
+
+			le := "inf"
+			if i < len(rules.EvalLatencyBuckets) {
+				le = rules.EvalLatencyBuckets[i].String()
+			}
+			tags := []string{"event_type:" + eventType, "le:" + le}
+			_ = e.statsdClient.Count(metrics.MetricRulesEvalLatency, int64(count), tags, 1.0)
+		}
+	}
+}
+
 func (e *RuleEngine) GetRuleSet() (rs *rules.RuleSet) {
 	if ruleSet := e.currentRuleSet.Load(); ruleSet != nil {
 		return ruleSet.(*rules.RuleSet)
@@ -169,6 +169,7 @@ type RuleState struct {
 	Expression string            `json:"expression"`
 	Status     string            `json:"status"`
 	Message    string            `json:"message,omitempty"`
+	Warnings   []string          `json:"warnings,omitempty"`
 	Tags       map[string]string `json:"tags,omitempty"`
 	Actions    []RuleAction      `json:"actions,omitempty"`
 	ModifiedBy []*PolicyState    `json:"modified_by,omitempty"`
@@ -324,7 +325,11 @@ func NewPoliciesState(rs *rules.RuleSet, err *multierror.Error, includeInternalP
 			policyState = PolicyStateFromRule(rule.PolicyRule)
 			mp[policyName] = policyState
 		}
-		policyState.Rules = append(policyState.Rules, RuleStateFromRule(rule.PolicyRule, "loaded", ""))
+		ruleState := RuleStateFromRule(rule.PolicyRule, "loaded", "")
+		for _, warning := range rule.Warnings {
+			ruleState.Warnings = append(ruleState.Warnings, warning.Error())
+		}
+		policyState.Rules = append(policyState.Rules, ruleState)
 	}
 
 	// rules ignored due to errors
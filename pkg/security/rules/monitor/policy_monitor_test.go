@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build test
+
+// Package monitor holds rules related files
+package monitor
+
+import (
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
+)
+
+func TestNewPoliciesStateWarnings(t *testing.T) {
+	ruleOpts, evalOpts := rules.NewBothOpts(map[eval.EventType]bool{"*": true})
+	rs := rules.NewRuleSet(&model.Model{}, func() eval.Event { return model.NewFakeEvent() }, ruleOpts, evalOpts)
+
+	policy := &rules.Policy{
+		Name: "test",
+		Def:  &rules.PolicyDef{Version: "1.0.0"},
+	}
+
+	var policyRules []*rules.PolicyRule
+	for id, expr := range map[string]string{
+		"optional":  `dns.question.name == "example.com" && process.file.name == "curl"`,
+		"mandatory": `open.file.path == "/etc/passwd" && container.id != ""`,
+	} {
+		policyRules = append(policyRules, &rules.PolicyRule{
+			Def:    &rules.RuleDefinition{ID: id, Expression: expr},
+			Policy: policy,
+		})
+	}
+
+	if err := rs.AddRules(ast.NewParsingContext(false), policyRules); err != nil {
+		t.Fatal(err)
+	}
+
+	states := NewPoliciesState(rs, nil, false)
+	if len(states) != 1 || len(states[0].Rules) != 2 {
+		t.Fatalf("unexpected policy states: %+v", states)
+	}
+
+	for _, ruleState := range states[0].Rules {
+		if ruleState.Status != "loaded" {
+			t.Errorf("unexpected status for `%s`: %s", ruleState.ID, ruleState.Status)
+		}
+
+		switch ruleState.ID {
+		case "optional":
+			if len(ruleState.Warnings) != 1 {
+				t.Errorf("expected one warning for `%s`, got %v", ruleState.ID, ruleState.Warnings)
+			}
+		case "mandatory":
+			if len(ruleState.Warnings) != 0 {
+				t.Errorf("expected no warning for `%s`, got %v", ruleState.ID, ruleState.Warnings)
+			}
+		}
+	}
+}
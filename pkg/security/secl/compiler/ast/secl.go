@@ -37,6 +37,7 @@ Regexp = "r\"" { "\u0000"…"\uffff"-"\""-"\\" | "\\" any } "\"" .
 Ident = (alpha | "_") { "_" | alpha | digit | "." | "[" | "]" } .
 String = "\"" { "\u0000"…"\uffff"-"\""-"\\" | "\\" any } "\"" .
 Pattern = "~\"" { "\u0000"…"\uffff"-"\""-"\\" | "\\" any } "\"" .
+Float = [ "-" | "+" ] digit { digit } "." digit { digit } .
 Int = [ "-" | "+" ] digit { digit } .
 Punct = "!"…"/" | ":"…"@" | "["…` + "\"`\"" + ` | "{"…"~" .
 Whitespace = ( " " | "\t" | "\n" ) { " " | "\t" | "\n" } .
@@ -67,6 +68,7 @@ func buildParser(obj interface{}, lexer lexer.Definition) *participle.Parser {
 		participle.Map(unquoteLiteral, "String"),
 		participle.Map(parseDuration, "Duration"),
 		participle.Map(unquotePattern, "Pattern", "Regexp"),
+		participle.Map(reserveKeywords(lexer.Symbols()["Punct"]), "Ident"),
 	)
 	if err != nil {
 		panic(err)
@@ -74,6 +76,40 @@ func buildParser(obj interface{}, lexer lexer.Definition) *participle.Parser {
 	return parser
 }
 
+// keywords are the identifiers used by the operators of the grammar
+var keywords = map[string]bool{
+	"and":          true,
+	"or":           true,
+	"not":          true,
+	"in":           true,
+	"allin":        true,
+	"exists":       true,
+	"fullmatch":    true,
+	"in_bloom":     true,
+	"in_globs":     true,
+	"in_gitignore": true,
+	"in_set":       true,
+	"subset":       true,
+	"superset":     true,
+	"intersects":   true,
+}
+
+// IsKeyword returns whether the given identifier is reserved by the grammar
+func IsKeyword(ident string) bool {
+	return keywords[ident]
+}
+
+// reserveKeywords turns the keywords into punctuation tokens so that they don't match as identifiers, like the
+// custom operators, while still matching as literals
+func reserveKeywords(punct rune) func(t lexer.Token) (lexer.Token, error) {
+	return func(t lexer.Token) (lexer.Token, error) {
+		if keywords[t.Value] {
+			t.Type = punct
+		}
+		return t, nil
+	}
+}
+
 func unquoteLiteral(t lexer.Token) (lexer.Token, error) {
 	unquoted := strings.TrimSpace(t.Value)
 	unquoted = unquoted[1 : len(unquoted)-1]
@@ -171,14 +207,15 @@ type Comparison struct {
 
 	ArithmeticOperation *ArithmeticOperation `parser:"@@"`
 	ScalarComparison    *ScalarComparison    `parser:"[ @@"`
-	ArrayComparison     *ArrayComparison     `parser:"| @@ ]"`
+	ArrayComparison     *ArrayComparison     `parser:"| @@"`
+	CustomComparison    *CustomComparison    `parser:"| @@ ]"`
 }
 
 // ScalarComparison describes a scalar comparison : the operator with the right operand
 type ScalarComparison struct {
 	Pos lexer.Position
 
-	Op   *string     `parser:"@( \">\" \"=\" | \">\" | \"<\" \"=\" | \"<\" | \"!\" \"=\" | \"=\" \"=\" | \"=\" \"~\" | \"!\" \"~\" )"`
+	Op   *string     `parser:"@( \">\" \"=\" | \">\" | \"<\" \"=\" | \"<\" | \"!\" \"=\" | \"=\" \"=\" | \"=\" \"~\" | \"!\" \"~\" | \"fullmatch\" | \"in_bloom\" | \"in_globs\" | \"in_gitignore\" | \"in_set\" | \"subset\" | \"superset\" | \"intersects\" )"`
 	Next *Comparison `parser:"@@"`
 }
 
@@ -186,10 +223,19 @@ type ScalarComparison struct {
 type ArrayComparison struct {
 	Pos lexer.Position
 
-	Op    *string `parser:"( @( \"in\" | \"not\" \"in\" | \"allin\" )"`
+	Op    *string `parser:"( @( \"in\" | \"not\" \"in\" | \"allin\" | \"subset\" | \"superset\" | \"intersects\" )"`
 	Array *Array  `parser:"@@ )"`
 }
 
+// CustomComparison describes a comparison using an operator registered by the user : the operator with the right
+// operand
+type CustomComparison struct {
+	Pos lexer.Position
+
+	Op   *string     `parser:"@Ident"`
+	Next *Comparison `parser:"@@"`
+}
+
 // BitOperation describes an operation on bits
 type BitOperation struct {
 	Pos lexer.Position
@@ -217,7 +263,7 @@ type ArithmeticElement struct {
 type Unary struct {
 	Pos lexer.Position
 
-	Op      *string  `parser:"( @( \"!\" | \"not\" | \"-\" | \"^\" )"`
+	Op      *string  `parser:"( @( \"!\" | \"not\" | \"exists\" | \"-\" | \"^\" )"`
 	Unary   *Unary   `parser:"@@ )"`
 	Primary *Primary `parser:"| @@"`
 }
@@ -227,9 +273,11 @@ type Unary struct {
 type Primary struct {
 	Pos lexer.Position
 
-	Ident         *string     `parser:"@Ident"`
+	Call          *Call       `parser:"@@"`
+	Ident         *string     `parser:"| @Ident"`
 	CIDR          *string     `parser:"| @CIDR"`
 	IP            *string     `parser:"| @IP"`
+	Float         *float64    `parser:"| @Float"`
 	Number        *int        `parser:"| @Int"`
 	Variable      *string     `parser:"| @Variable"`
 	String        *string     `parser:"| @String"`
@@ -239,6 +287,14 @@ type Primary struct {
 	SubExpression *Expression `parser:"| \"(\" @@ \")\""`
 }
 
+// Call describes a predicate call like `has_env("LD_PRELOAD")`
+type Call struct {
+	Pos lexer.Position
+
+	Name string    `parser:"@Ident \"(\""`
+	Args []Primary `parser:"[ @@ { \",\" @@ } ] \")\""`
+}
+
 // StringMember describes a String based array member
 type StringMember struct {
 	Pos lexer.Position
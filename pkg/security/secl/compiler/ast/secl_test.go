@@ -348,3 +348,17 @@ func TestCIDRArrayMatches(t *testing.T) {
 
 	printJSON(t, rule)
 }
+
+func TestCall(t *testing.T) {
+	rule, err := parseRule(`has_env("LD_PRELOAD") && process.pid > 1`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	printJSON(t, rule)
+
+	call := rule.BooleanExpression.Expression.Comparison.ArithmeticOperation.First.Unary.Primary.Call
+	if call == nil || call.Name != "has_env" || len(call.Args) != 1 || call.Args[0].String == nil || *call.Args[0].String != "LD_PRELOAD" {
+		t.Errorf("expected a has_env call with one string argument, got %+v", call)
+	}
+}
@@ -0,0 +1,87 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+)
+
+// BloomFilter is a probabilistic set of strings, used to match the values against huge allowlists with a fraction of
+// the memory a trie or a map would require. A value that was added is always reported as possibly present, but a
+// value that was not added can also be reported as possibly present, according to the false positive rate of the
+// filter. Only a negative answer is certain.
+type BloomFilter struct {
+	bits      []uint64
+	size      uint64
+	hashCount uint64
+
+	falsePositiveRate float64
+}
+
+// NewBloomFilter returns a new bloom filter sized for the given number of values and false positive rate
+func NewBloomFilter(expectedValues int, falsePositiveRate float64) (*BloomFilter, error) {
+	if expectedValues <= 0 {
+		return nil, fmt.Errorf("invalid number of expected values `%d`", expectedValues)
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		return nil, fmt.Errorf("invalid false positive rate `%f`, it has to be between 0 and 1", falsePositiveRate)
+	}
+
+	// optimal number of bits and of hash functions for the expected values and false positive rate
+	size := uint64(math.Ceil(-float64(expectedValues) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	hashCount := uint64(math.Max(1, math.Round(float64(size)/float64(expectedValues)*math.Ln2)))
+
+	return &BloomFilter{
+		bits:              make([]uint64, (size+63)/64),
+		size:              size,
+		hashCount:         hashCount,
+		falsePositiveRate: falsePositiveRate,
+	}, nil
+}
+
+// hashes returns the two base hashes of the value, the bit positions being derived from them with double hashing
+func (b *BloomFilter) hashes(value string) (uint64, uint64) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(value))
+	h1 := h.Sum64()
+
+	// splitmix64 finalizer, the second hash has to be odd to go through all the positions
+	h2 := h1 + 0x9e3779b97f4a7c15
+	h2 = (h2 ^ (h2 >> 30)) * 0xbf58476d1ce4e5b9
+	h2 = (h2 ^ (h2 >> 27)) * 0x94d049bb133111eb
+	h2 ^= h2 >> 31
+
+	return h1, h2 | 1
+}
+
+// Add adds a value to the filter
+func (b *BloomFilter) Add(value string) {
+	h1, h2 := b.hashes(value)
+	for i := uint64(0); i < b.hashCount; i++ {
+		pos := (h1 + i*h2) % b.size
+		b.bits[pos/64] |= 1 << (pos % 64)
+	}
+}
+
+// MayContain returns false if the value is definitely not in the filter, true if it possibly is
+func (b *BloomFilter) MayContain(value string) bool {
+	h1, h2 := b.hashes(value)
+	for i := uint64(0); i < b.hashCount; i++ {
+		pos := (h1 + i*h2) % b.size
+		if b.bits[pos/64]&(1<<(pos%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// FalsePositiveRate returns the false positive rate the filter was sized for
+func (b *BloomFilter) FalsePositiveRate() float64 {
+	return b.falsePositiveRate
+}
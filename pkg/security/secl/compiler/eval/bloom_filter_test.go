@@ -0,0 +1,111 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestBloomFilter(t *testing.T) {
+	const values = 100000
+
+	for _, falsePositiveRate := range []float64{0.01, 0.001} {
+		t.Run(strconv.FormatFloat(falsePositiveRate, 'f', -1, 64), func(t *testing.T) {
+			filter, err := NewBloomFilter(values, falsePositiveRate)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < values; i++ {
+				filter.Add("/usr/lib/allowed/" + strconv.Itoa(i))
+			}
+
+			for i := 0; i < values; i++ {
+				if value := "/usr/lib/allowed/" + strconv.Itoa(i); !filter.MayContain(value) {
+					t.Fatalf("false negative for `%s`", value)
+				}
+			}
+
+			var falsePositives int
+			for i := 0; i < values; i++ {
+				if filter.MayContain("/usr/lib/other/" + strconv.Itoa(i)) {
+					falsePositives++
+				}
+			}
+
+			// leave some room for the statistical variations
+			if rate := float64(falsePositives) / values; rate > falsePositiveRate*1.5 {
+				t.Errorf("false positive rate %f above the expected %f", rate, falsePositiveRate)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		if _, err := NewBloomFilter(0, 0.01); err == nil {
+			t.Error("expected an error for no expected values")
+		}
+		if _, err := NewBloomFilter(100, 0); err == nil {
+			t.Error("expected an error for a zero false positive rate")
+		}
+		if _, err := NewBloomFilter(100, 1); err == nil {
+			t.Error("expected an error for a false positive rate of 1")
+		}
+	})
+}
+
+func TestBloomFilterOperator(t *testing.T) {
+	filter, err := NewBloomFilter(100, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filter.Add("bash")
+	filter.Add("zsh")
+
+	opts := newOptsWithParams(testConstants, nil).AddBloomFilter("shells", filter)
+
+	tests := []struct {
+		Expr     string
+		Expected bool
+	}{
+		{Expr: `process.name in_bloom "shells"`, Expected: true},
+		{Expr: `!(process.name in_bloom "shells")`, Expected: false},
+		{Expr: `process.argv0 in_bloom "shells"`, Expected: false},
+		{Expr: `process.array.value in_bloom "shells"`, Expected: true},
+	}
+
+	event := &testEvent{
+		process: testProcess{
+			name:  "bash",
+			argv0: "python3",
+			array: []*testItem{{value: "sh"}, {value: "zsh"}},
+		},
+	}
+
+	for _, test := range tests {
+		rule, err := parseRule(test.Expr, &testModel{}, opts)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result := rule.Eval(NewContext(event)); result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t` for `%s`", test.Expected, result, test.Expr)
+		}
+	}
+
+	t.Run("not-registered", func(t *testing.T) {
+		if _, err := parseRule(`process.name in_bloom "unknown"`, &testModel{}, opts); err == nil {
+			t.Error("expected an error for a bloom filter not registered")
+		}
+	})
+
+	t.Run("non-static", func(t *testing.T) {
+		if _, err := parseRule(`process.name in_bloom process.argv0`, &testModel{}, opts); err == nil {
+			t.Error("expected an error for a non static bloom filter name")
+		}
+	})
+}
@@ -0,0 +1,254 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// builtinOperators holds the comparison operators of the SECL grammar
+var builtinOperators = newBuiltinOperators()
+
+// compileFnc compiles an operator applied to evaluators of types A and B
+type compileFnc[A, B any] func(a A, b B, opts *Opts, state *State) (*BoolEvaluator, error)
+
+func registerBuiltin[A, B any](r *OperatorRegistry, fnc compileFnc[A, B], tokens ...string) {
+	left, right := reflect.TypeOf((*A)(nil)).Elem(), reflect.TypeOf((*B)(nil)).Elem()
+	compile := func(a interface{}, b interface{}, opts *Opts, state *State) (*BoolEvaluator, error) {
+		return fnc(a.(A), b.(B), opts, state)
+	}
+
+	for _, token := range tokens {
+		if err := r.register(token, left, right, compile); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// withState adapts an operator implementation not using the options
+func withState[A, B any](fnc func(a A, b B, state *State) (*BoolEvaluator, error)) compileFnc[A, B] {
+	return func(a A, b B, _ *Opts, state *State) (*BoolEvaluator, error) {
+		return fnc(a, b, state)
+	}
+}
+
+// mirrored adapts an operator implementation taking its operands in the reverse order
+func mirrored[A, B any](fnc func(b B, a A, state *State) (*BoolEvaluator, error)) compileFnc[A, B] {
+	return func(a A, b B, _ *Opts, state *State) (*BoolEvaluator, error) {
+		return fnc(b, a, state)
+	}
+}
+
+// negated returns the negation of an operator
+func negated[A, B any](fnc compileFnc[A, B]) compileFnc[A, B] {
+	return func(a A, b B, opts *Opts, state *State) (*BoolEvaluator, error) {
+		evaluator, err := fnc(a, b, opts, state)
+		if err != nil {
+			return nil, err
+		}
+		return Not(evaluator, state), nil
+	}
+}
+
+// patternMatch forces the static string value to be matched as a pattern
+func patternMatch[A any](fnc compileFnc[A, *StringEvaluator]) compileFnc[A, *StringEvaluator] {
+	return func(a A, b *StringEvaluator, opts *Opts, state *State) (*BoolEvaluator, error) {
+		if b.EvalFnc != nil {
+			return nil, &ErrNonStaticPattern{Field: b.Field}
+		}
+
+		// force pattern if needed
+		if b.ValueType == ScalarValueType {
+			b.ValueType = PatternValueType
+		}
+		return fnc(a, b, opts, state)
+	}
+}
+
+// fullMatch forces the static string value to match the full value
+func fullMatch[A any](fnc compileFnc[A, *StringEvaluator]) compileFnc[A, *StringEvaluator] {
+	return func(a A, b *StringEvaluator, opts *Opts, state *State) (*BoolEvaluator, error) {
+		if b.EvalFnc != nil {
+			return nil, &ErrNonStaticPattern{Field: b.Field}
+		}
+
+		// anchor regular expressions, the other kinds of values always match the full value
+		if b.ValueType == RegexpValueType {
+			b.Value = AnchorRegexp(b.Value)
+		}
+		return fnc(a, b, opts, state)
+	}
+}
+
+// withNamedValue resolves the static string value naming a value provided by the options, like a bloom filter
+func withNamedValue[A, V any](fromOpts func(value *StringEvaluator, opts *Opts) (V, error), fnc func(a A, value V, state *State) (*BoolEvaluator, error)) compileFnc[A, *StringEvaluator] {
+	return func(a A, b *StringEvaluator, opts *Opts, state *State) (*BoolEvaluator, error) {
+		value, err := fromOpts(b, opts)
+		if err != nil {
+			return nil, err
+		}
+		return fnc(a, value, state)
+	}
+}
+
+// durationOrInt selects the implementation of an int operator depending on whether the values are durations, the
+// arithmetic operations on durations having an implementation of their own
+func durationOrInt[A any](token string, duration compileFnc[A, *IntEvaluator], arithmDuration compileFnc[A, *IntEvaluator], integer compileFnc[A, *IntEvaluator]) compileFnc[A, *IntEvaluator] {
+	return func(a A, b *IntEvaluator, opts *Opts, state *State) (*BoolEvaluator, error) {
+		if !b.isDuration {
+			return integer(a, b, opts, state)
+		}
+
+		if arithmDuration != nil {
+			if ia, ok := any(a).(*IntEvaluator); ok && ia.isFromArithmeticOperation {
+				return arithmDuration(a, b, opts, state)
+			}
+		}
+		if duration == nil {
+			return nil, fmt.Errorf("operator `%s` not supported for durations", token)
+		}
+		return duration(a, b, opts, state)
+	}
+}
+
+// intBitmask returns the implementation of a bitmask operator
+func intBitmask(token string) compileFnc[*IntEvaluator, *IntEvaluator] {
+	return durationOrInt(token, nil, nil, func(a *IntEvaluator, b *IntEvaluator, _ *Opts, state *State) (*BoolEvaluator, error) {
+		return intBitmaskOperator(token, a, b, state)
+	})
+}
+
+// intArrayBitmask returns the implementation of a bitmask operator applied to a list of values, combined into a
+// single mask
+func intArrayBitmask(token string) compileFnc[*IntEvaluator, *IntArrayEvaluator] {
+	return func(a *IntEvaluator, b *IntArrayEvaluator, _ *Opts, state *State) (*BoolEvaluator, error) {
+		if b.EvalFnc != nil {
+			return nil, fmt.Errorf("`%s` requires a list of static values", token)
+		}
+
+		var mask int
+		for _, value := range b.Values {
+			mask |= value
+		}
+		return intBitmaskOperator(token, a, &IntEvaluator{Value: mask}, state)
+	}
+}
+
+func newBuiltinOperators() *OperatorRegistry {
+	r := NewOperatorRegistry()
+
+	// array comparisons, `allin` matching as `in` for a scalar value
+	registerBuiltin(r, withState(ArrayBoolContains), "in", "allin")
+	registerBuiltin(r, negated(withState(ArrayBoolContains)), "notin")
+	registerBuiltin(r, withState(StringArrayContainsWrapper), "in", "allin")
+	registerBuiltin(r, negated(withState(StringArrayContainsWrapper)), "notin")
+	registerBuiltin(r, withState(StringValuesContainsWrapper), "in", "allin")
+	registerBuiltin(r, negated(withState(StringValuesContainsWrapper)), "notin")
+	registerBuiltin(r, mirrored(StringArrayMatchesWrapper), "in", "allin")
+	registerBuiltin(r, negated(mirrored(StringArrayMatchesWrapper)), "notin")
+	registerBuiltin(r, withState(StringArrayMatchesWrapper), "in", "allin")
+	registerBuiltin(r, negated(withState(StringArrayMatchesWrapper)), "notin")
+	registerBuiltin(r, withState(IntArrayEquals), "in", "allin")
+	registerBuiltin(r, negated(withState(IntArrayEquals)), "notin")
+	registerBuiltin(r, withState(IntArrayMatches), "in", "allin")
+	registerBuiltin(r, negated(withState(IntArrayMatches)), "notin")
+	registerBuiltin(r, withState(CIDREquals), "in", "allin", "==")
+	registerBuiltin(r, negated(withState(CIDREquals)), "notin", "!=")
+	registerBuiltin(r, withState(CIDRValuesContains), "in", "allin")
+	registerBuiltin(r, negated(withState(CIDRValuesContains)), "notin")
+	registerBuiltin(r, withState(CIDRArrayContains), "in", "allin")
+	registerBuiltin(r, negated(withState(CIDRArrayContains)), "notin")
+	registerBuiltin(r, withState(CIDRArrayMatches), "in")
+	registerBuiltin(r, withState(CIDRArrayMatchesAll), "allin")
+	registerBuiltin(r, negated(withState(CIDRArrayMatches)), "notin")
+	registerBuiltin(r, mirrored(CIDRValuesContains), "in", "allin")
+	registerBuiltin(r, negated(mirrored(CIDRValuesContains)), "notin")
+	registerBuiltin(r, mirrored(CIDRArrayMatches), "in")
+	registerBuiltin(r, mirrored(CIDRArrayMatchesAll), "allin")
+	registerBuiltin(r, negated(mirrored(CIDRArrayMatches)), "notin")
+	for _, token := range []string{"subset", "superset", "intersects"} {
+		registerBuiltin(r, intArrayBitmask(token), token)
+		registerBuiltin(r, intBitmask(token), token)
+	}
+
+	// bool comparisons
+	registerBuiltin(r, withState(BoolEquals), "==")
+	registerBuiltin(r, negated(withState(BoolEquals)), "!=")
+	registerBuiltin(r, mirrored(BoolArrayEquals), "==")
+	registerBuiltin(r, negated(mirrored(BoolArrayEquals)), "!=")
+
+	// string comparisons
+	registerBuiltin(r, withState(StringEqualsWrapper), "==")
+	registerBuiltin(r, negated(withState(StringEqualsWrapper)), "!=")
+	registerBuiltin(r, patternMatch(withState(StringEqualsWrapper)), "=~")
+	registerBuiltin(r, negated(patternMatch(withState(StringEqualsWrapper))), "!~")
+	registerBuiltin(r, fullMatch(withState(StringEqualsWrapper)), "fullmatch")
+	registerBuiltin(r, withNamedValue(bloomFilterFromOpts, StringBloomFilterContains), "in_bloom")
+	registerBuiltin(r, withNamedValue(globSetFromOpts, StringGlobSetMatches), "in_globs")
+	registerBuiltin(r, withNamedValue(gitIgnoreMatcherFromOpts, StringGitIgnoreMatches), "in_gitignore")
+	registerBuiltin(r, withNamedValue(stringSetFromOpts, StringSetContains), "in_set")
+	registerBuiltin(r, withState(StringGreaterThan), ">")
+	registerBuiltin(r, withState(StringGreaterOrEqualThan), ">=")
+	registerBuiltin(r, withState(StringLesserThan), "<")
+	registerBuiltin(r, withState(StringLesserOrEqualThan), "<=")
+
+	// string array comparisons, matching if one of the values of the array matches
+	registerBuiltin(r, mirrored(StringArrayContainsWrapper), "==")
+	registerBuiltin(r, negated(mirrored(StringArrayContainsWrapper)), "!=")
+	registerBuiltin(r, patternMatch(mirrored(StringArrayContainsWrapper)), "=~")
+	registerBuiltin(r, negated(patternMatch(mirrored(StringArrayContainsWrapper))), "!~")
+	registerBuiltin(r, fullMatch(mirrored(StringArrayContainsWrapper)), "fullmatch")
+	registerBuiltin(r, withNamedValue(bloomFilterFromOpts, StringArrayBloomFilterContains), "in_bloom")
+	registerBuiltin(r, withNamedValue(globSetFromOpts, StringArrayGlobSetMatches), "in_globs")
+	registerBuiltin(r, withNamedValue(gitIgnoreMatcherFromOpts, StringArrayGitIgnoreMatches), "in_gitignore")
+	registerBuiltin(r, withNamedValue(stringSetFromOpts, StringArraySetContains), "in_set")
+
+	// int comparisons, the durations being compared to the time elapsed since the value of the field
+	registerBuiltin(r, durationOrInt("<", withState(DurationLesserThan), withState(DurationLesserThanArithmeticOperation), withState(LesserThan)), "<")
+	registerBuiltin(r, durationOrInt("<=", withState(DurationLesserOrEqualThan), withState(DurationLesserOrEqualThanArithmeticOperation), withState(LesserOrEqualThan)), "<=")
+	registerBuiltin(r, durationOrInt(">", withState(DurationGreaterThan), withState(DurationGreaterThanArithmeticOperation), withState(GreaterThan)), ">")
+	registerBuiltin(r, durationOrInt(">=", withState(DurationGreaterOrEqualThan), withState(DurationGreaterOrEqualThanArithmeticOperation), withState(GreaterOrEqualThan)), ">=")
+	registerBuiltin(r, durationOrInt("==", withState(DurationEqual), withState(DurationEqualArithmeticOperation), withState(IntEquals)), "==")
+	registerBuiltin(r, negated(durationOrInt("!=", nil, nil, withState(IntEquals))), "!=")
+	registerBuiltin(r, withState(IntArrayLesserThan), "<")
+	registerBuiltin(r, withState(IntArrayLesserOrEqualThan), "<=")
+	registerBuiltin(r, withState(IntArrayGreaterThan), ">")
+	registerBuiltin(r, withState(IntArrayGreaterOrEqualThan), ">=")
+	registerBuiltin(r, withState(IntArrayEquals), "==")
+	registerBuiltin(r, negated(withState(IntArrayEquals)), "!=")
+
+	// int array comparisons, the array operators taking the scalar first, the comparison is mirrored
+	registerBuiltin(r, durationOrInt("<", mirrored(DurationArrayLesserThan), nil, mirrored(IntArrayGreaterThan)), "<")
+	registerBuiltin(r, durationOrInt("<=", mirrored(DurationArrayLesserOrEqualThan), nil, mirrored(IntArrayGreaterOrEqualThan)), "<=")
+	registerBuiltin(r, durationOrInt(">", mirrored(DurationArrayGreaterThan), nil, mirrored(IntArrayLesserThan)), ">")
+	registerBuiltin(r, durationOrInt(">=", mirrored(DurationArrayGreaterOrEqualThan), nil, mirrored(IntArrayLesserOrEqualThan)), ">=")
+	registerBuiltin(r, durationOrInt("==", nil, nil, mirrored(IntArrayEquals)), "==")
+	registerBuiltin(r, negated(durationOrInt("!=", nil, nil, mirrored(IntArrayEquals))), "!=")
+
+	// float comparisons
+	registerBuiltin(r, withState(FloatLesserThan), "<")
+	registerBuiltin(r, withState(FloatLesserOrEqualThan), "<=")
+	registerBuiltin(r, withState(FloatGreaterThan), ">")
+	registerBuiltin(r, withState(FloatGreaterOrEqualThan), ">=")
+	registerBuiltin(r, withState(FloatEquals), "==")
+	registerBuiltin(r, negated(withState(FloatEquals)), "!=")
+	registerBuiltin(r, withState(FloatArrayLesserThan), "<")
+	registerBuiltin(r, withState(FloatArrayLesserOrEqualThan), "<=")
+	registerBuiltin(r, withState(FloatArrayGreaterThan), ">")
+	registerBuiltin(r, withState(FloatArrayGreaterOrEqualThan), ">=")
+	registerBuiltin(r, withState(FloatArrayEquals), "==")
+	registerBuiltin(r, negated(withState(FloatArrayEquals)), "!=")
+	registerBuiltin(r, mirrored(FloatArrayGreaterThan), "<")
+	registerBuiltin(r, mirrored(FloatArrayGreaterOrEqualThan), "<=")
+	registerBuiltin(r, mirrored(FloatArrayLesserThan), ">")
+	registerBuiltin(r, mirrored(FloatArrayLesserOrEqualThan), ">=")
+	registerBuiltin(r, mirrored(FloatArrayEquals), "==")
+	registerBuiltin(r, negated(mirrored(FloatArrayEquals)), "!=")
+
+	return r
+}
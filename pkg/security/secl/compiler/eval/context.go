@@ -24,6 +24,7 @@ type Context struct {
 	// cache available across all the evaluations
 	StringCache map[string][]string
 	IntCache    map[string][]int
+	FloatCache  map[string][]float64
 	BoolCache   map[string][]bool
 
 	// iterator register cache. used to cache entry within a single rule evaluation
@@ -36,7 +37,13 @@ type Context struct {
 
 	CachedAncestorsCount int
 
+	// Trace, when set, records the results of the leaf predicates of the rules compiled with tracing enabled
+	Trace *Trace
+
 	resolvedFields []string
+
+	// results of the leaf predicates shared by several rules, for the current event
+	resultCache map[resultCacheKey]bool
 }
 
 // Now return and cache the `now` timestamp
@@ -50,6 +57,7 @@ func (c *Context) Now() time.Time {
 // SetEvent set the given event to the context
 func (c *Context) SetEvent(evt Event) {
 	c.Event = evt
+	clear(c.resultCache)
 }
 
 // Reset the context
@@ -59,11 +67,13 @@ func (c *Context) Reset() {
 
 	clear(c.StringCache)
 	clear(c.IntCache)
+	clear(c.FloatCache)
 	clear(c.BoolCache)
 	clear(c.Registers)
 	clear(c.RegisterCache)
 	c.CachedAncestorsCount = 0
 	clear(c.resolvedFields)
+	clear(c.resultCache)
 }
 
 // GetResolvedFields returns the resolved fields, always empty outside of functional tests
@@ -77,6 +87,7 @@ func NewContext(evt Event) *Context {
 		Event:         evt,
 		StringCache:   make(map[string][]string),
 		IntCache:      make(map[string][]int),
+		FloatCache:    make(map[string][]float64),
 		BoolCache:     make(map[string][]bool),
 		Registers:     make(map[RegisterID]int),
 		RegisterCache: make(map[RegisterID]*RegisterCacheEntry),
@@ -118,9 +118,14 @@ func (e *ErrRuleParse) Error() string {
 // ErrFieldNotFound error when a field is not present in the model
 type ErrFieldNotFound struct {
 	Field string
+	// Suggestion is the closest existing field, only computed at rule compilation
+	Suggestion string
 }
 
 func (e ErrFieldNotFound) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("field `%s` not found, did you mean `%s`?", e.Field, e.Suggestion)
+	}
 	return fmt.Sprintf("field `%s` not found", e.Field)
 }
 
@@ -186,3 +191,57 @@ type ErrIteratorVariable struct {
 func (e ErrIteratorVariable) Error() string {
 	return fmt.Sprintf("iterator variable error: %s", e.Err)
 }
+
+// ErrBloomFilterNotFound is returned when a rule uses a bloom filter that was not registered
+type ErrBloomFilterNotFound struct {
+	Name string
+}
+
+func (e ErrBloomFilterNotFound) Error() string {
+	return fmt.Sprintf("bloom filter `%s` not found", e.Name)
+}
+
+// ErrPredicateNotFound is returned when a rule calls a predicate that was not registered
+type ErrPredicateNotFound struct {
+	Name string
+}
+
+func (e ErrPredicateNotFound) Error() string {
+	return fmt.Sprintf("predicate `%s` not found", e.Name)
+}
+
+// ErrGlobSetNotFound is returned when a rule uses a glob set that was not registered
+type ErrGlobSetNotFound struct {
+	Name string
+}
+
+func (e ErrGlobSetNotFound) Error() string {
+	return fmt.Sprintf("glob set `%s` not found", e.Name)
+}
+
+// ErrStringSetNotFound is returned when a rule uses a string set that was not registered
+type ErrStringSetNotFound struct {
+	Name string
+}
+
+func (e ErrStringSetNotFound) Error() string {
+	return fmt.Sprintf("string set `%s` not found", e.Name)
+}
+
+// ErrGitIgnoreMatcherNotFound is returned when a rule uses a gitignore matcher that was not registered
+type ErrGitIgnoreMatcherNotFound struct {
+	Name string
+}
+
+func (e ErrGitIgnoreMatcherNotFound) Error() string {
+	return fmt.Sprintf("gitignore matcher `%s` not found", e.Name)
+}
+
+// ErrOperatorConflict is returned when registering an operator which is already defined
+type ErrOperatorConflict struct {
+	Token string
+}
+
+func (e ErrOperatorConflict) Error() string {
+	return fmt.Sprintf("operator `%s` already defined", e.Token)
+}
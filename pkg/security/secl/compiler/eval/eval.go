@@ -9,6 +9,7 @@
 package eval
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -25,6 +26,10 @@ import (
 const (
 	FunctionWeight       = 5
 	InArrayWeight        = 10
+	BloomFilterWeight    = 20
+	StringSetWeight      = 20
+	GlobSetWeight        = 50
+	GitIgnoreWeight      = 50
 	HandlerWeight        = 50
 	RegexpWeight         = 100
 	InPatternArrayWeight = 1000
@@ -84,18 +89,42 @@ func identToEvaluator(obj *ident, opts *Opts, state *State) (interface{}, lexer.
 	// transform extracted field to support legacy SECL fields
 	if opts.LegacyFields != nil {
 		if newField, ok := opts.LegacyFields[field]; ok {
+			state.UpdateLegacyFields(field)
 			field = newField
 		}
 	}
 
+	// a numeric subscript selects a given element of the iterator instead of iterating over all of them
+	index, err := strconv.Atoi(regID)
+	isIndex := err == nil
+	if isIndex {
+		if index < 0 {
+			return nil, obj.Pos, NewError(obj.Pos, "invalid index `%s`", regID)
+		}
+		// the register holding the index has to be specific to the iterator field
+		regID = itField + "[" + regID + "]"
+	}
+
 	accessor, err := state.model.GetEvaluator(field, regID)
 	if err != nil {
+		var errFieldNotFound *ErrFieldNotFound
+		if errors.As(err, &errFieldNotFound) && errFieldNotFound.Suggestion == "" {
+			if lister, ok := state.model.NewEvent().(FieldsLister); ok {
+				errFieldNotFound.Suggestion = SuggestField(field, lister.GetFields())
+			}
+		}
 		return nil, obj.Pos, err
 	}
 
 	state.UpdateFields(field)
 
-	if regID != "" {
+	if isIndex {
+		if err := setRegisterIndex(accessor, regID, index); err != nil {
+			return nil, obj.Pos, NewError(obj.Pos, "field `%s` doesn't support index access: %s", field, err)
+		}
+
+		state.indexes = append(state.indexes, Register{ID: regID, Field: itField})
+	} else if regID != "" {
 		// avoid wildcard register for the moment
 		if regID == "_" {
 			return nil, obj.Pos, NewError(obj.Pos, "`_` can't be used as a iterator variable name")
@@ -118,6 +147,138 @@ func identToEvaluator(obj *ident, opts *Opts, state *State) (interface{}, lexer.
 	return accessor, obj.Pos, nil
 }
 
+// setRegisterIndex makes the given iterator evaluator always evaluate the element at the given index
+func setRegisterIndex(evaluator interface{}, regID RegisterID, index int) error {
+	switch evaluator := evaluator.(type) {
+	case *StringArrayEvaluator:
+		evalFnc := evaluator.EvalFnc
+		evaluator.EvalFnc = func(ctx *Context) []string {
+			ctx.Registers[regID] = index
+			return evalFnc(ctx)
+		}
+	case *IntArrayEvaluator:
+		evalFnc := evaluator.EvalFnc
+		evaluator.EvalFnc = func(ctx *Context) []int {
+			ctx.Registers[regID] = index
+			return evalFnc(ctx)
+		}
+	case *BoolArrayEvaluator:
+		evalFnc := evaluator.EvalFnc
+		evaluator.EvalFnc = func(ctx *Context) []bool {
+			ctx.Registers[regID] = index
+			return evalFnc(ctx)
+		}
+	case *FloatArrayEvaluator:
+		evalFnc := evaluator.EvalFnc
+		evaluator.EvalFnc = func(ctx *Context) []float64 {
+			ctx.Registers[regID] = index
+			return evalFnc(ctx)
+		}
+	default:
+		return errors.New("not an iterator field")
+	}
+
+	return nil
+}
+
+func isBitmaskOperator(op string) bool {
+	return op == "subset" || op == "superset" || op == "intersects"
+}
+
+func intBitmaskOperator(op string, a *IntEvaluator, b *IntEvaluator, state *State) (*BoolEvaluator, error) {
+	switch op {
+	case "subset":
+		return IntSubset(a, b, state)
+	case "superset":
+		return IntSuperset(a, b, state)
+	case "intersects":
+		return IntIntersects(a, b, state)
+	}
+	return nil, fmt.Errorf("unknown bitmask operator `%s`", op)
+}
+
+// arrayComparisonTypeError returns the error reported when no operator applies a value to a list of this type
+func arrayComparisonTypeError(pos lexer.Position, unary interface{}, next interface{}) error {
+	switch unary.(type) {
+	case *BoolEvaluator:
+		return NewArrayTypeError(pos, reflect.Array, reflect.Bool)
+	case *StringEvaluator, *StringValuesEvaluator, *StringArrayEvaluator:
+		return NewArrayTypeError(pos, reflect.Array, reflect.String)
+	case *IntEvaluator, *IntArrayEvaluator:
+		return NewArrayTypeError(pos, reflect.Array, reflect.Int)
+	case *CIDREvaluator, *CIDRValuesEvaluator, *CIDRArrayEvaluator:
+		return NewCIDRTypeError(pos, reflect.Array, next)
+	}
+	return NewTypeError(pos, reflect.Array)
+}
+
+// scalarComparisonTypeError returns the error reported when no operator applies a value to a value of this type
+func scalarComparisonTypeError(pos lexer.Position, unary interface{}) error {
+	switch unary.(type) {
+	case *BoolEvaluator, *BoolArrayEvaluator:
+		return NewTypeError(pos, reflect.Bool)
+	case *StringEvaluator, *StringArrayEvaluator:
+		return NewTypeError(pos, reflect.String)
+	case *IntEvaluator, *IntArrayEvaluator:
+		return NewTypeError(pos, reflect.Int)
+	case *FloatEvaluator, *FloatArrayEvaluator:
+		return NewTypeError(pos, reflect.Float64)
+	}
+	return NewTypeError(pos, reflect.TypeOf(unary).Kind())
+}
+
+// bloomFilterFromOpts returns the bloom filter named by the static string value
+func bloomFilterFromOpts(value *StringEvaluator, opts *Opts) (*BloomFilter, error) {
+	if value.EvalFnc != nil || value.ValueType != ScalarValueType {
+		return nil, errors.New("the `in_bloom` operator expects the name of a bloom filter")
+	}
+
+	filter := opts.BloomFilters[value.Value]
+	if filter == nil {
+		return nil, &ErrBloomFilterNotFound{Name: value.Value}
+	}
+	return filter, nil
+}
+
+// globSetFromOpts returns the glob set named by the static string value
+func globSetFromOpts(value *StringEvaluator, opts *Opts) (*GlobSet, error) {
+	if value.EvalFnc != nil || value.ValueType != ScalarValueType {
+		return nil, errors.New("the `in_globs` operator expects the name of a glob set")
+	}
+
+	set := opts.GlobSets[value.Value]
+	if set == nil {
+		return nil, &ErrGlobSetNotFound{Name: value.Value}
+	}
+	return set, nil
+}
+
+// stringSetFromOpts returns the string set named by the static string value
+func stringSetFromOpts(value *StringEvaluator, opts *Opts) (*StringSet, error) {
+	if value.EvalFnc != nil || value.ValueType != ScalarValueType {
+		return nil, errors.New("the `in_set` operator expects the name of a string set")
+	}
+
+	set := opts.StringSets[value.Value]
+	if set == nil {
+		return nil, &ErrStringSetNotFound{Name: value.Value}
+	}
+	return set, nil
+}
+
+// gitIgnoreMatcherFromOpts returns the gitignore matcher named by the static string value
+func gitIgnoreMatcherFromOpts(value *StringEvaluator, opts *Opts) (*GitIgnoreMatcher, error) {
+	if value.EvalFnc != nil || value.ValueType != ScalarValueType {
+		return nil, errors.New("the `in_gitignore` operator expects the name of a gitignore matcher")
+	}
+
+	matcher := opts.GitIgnoreMatchers[value.Value]
+	if matcher == nil {
+		return nil, &ErrGitIgnoreMatcherNotFound{Name: value.Value}
+	}
+	return matcher, nil
+}
+
 func arrayToEvaluator(array *ast.Array, opts *Opts, state *State) (interface{}, lexer.Position, error) {
 	if len(array.Numbers) != 0 {
 		var evaluator IntArrayEvaluator
@@ -381,6 +542,107 @@ func StringArrayMatchesWrapper(a *StringArrayEvaluator, b *StringValuesEvaluator
 	return evaluator, nil
 }
 
+// isIntField returns whether the evaluator is an int field
+func isIntField(evaluator interface{}) bool {
+	_, ok := intField(evaluator)
+	return ok
+}
+
+// intField returns the field of the evaluator when it's an int field
+func intField(evaluator interface{}) (Field, bool) {
+	switch evaluator := evaluator.(type) {
+	case *IntEvaluator:
+		return evaluator.Field, evaluator.Field != ""
+	case *IntArrayEvaluator:
+		return evaluator.Field, evaluator.Field != ""
+	}
+	return "", false
+}
+
+// coerceStringToInt converts the string literal compared against an int field to an int literal
+func coerceStringToInt(a, b interface{}, pos lexer.Position) (interface{}, interface{}, error) {
+	convert := func(evaluator interface{}) (interface{}, error) {
+		str, ok := evaluator.(*StringEvaluator)
+		if !ok || str.EvalFnc != nil || str.Field != "" || str.ValueType != ScalarValueType {
+			return evaluator, nil
+		}
+
+		value, err := strconv.Atoi(str.Value)
+		if err != nil {
+			return nil, NewError(pos, "integer expected, got `%s`", str.Value)
+		}
+		return &IntEvaluator{Value: value}, nil
+	}
+
+	var err error
+	if isIntField(a) {
+		b, err = convert(b)
+	} else if isIntField(b) {
+		a, err = convert(a)
+	}
+	return a, b, err
+}
+
+// isFloatOperand returns whether the evaluator is a float or an array of floats
+func isFloatOperand(evaluator interface{}) bool {
+	switch evaluator.(type) {
+	case *FloatEvaluator, *FloatArrayEvaluator:
+		return true
+	}
+	return false
+}
+
+// promoteIntToFloat converts the int literal compared against a float operand to a float literal
+func promoteIntToFloat(a, b interface{}) (interface{}, interface{}) {
+	convert := func(evaluator interface{}) interface{} {
+		i, ok := evaluator.(*IntEvaluator)
+		if !ok || i.EvalFnc != nil || i.Field != "" || i.isDuration {
+			return evaluator
+		}
+		return &FloatEvaluator{Value: float64(i.Value)}
+	}
+
+	if isFloatOperand(a) {
+		b = convert(b)
+	} else if isFloatOperand(b) {
+		a = convert(a)
+	}
+	return a, b
+}
+
+// parseIntFieldValue converts the string literal compared against an int field to an int literal, when the model
+// accepts string literals for this field
+func parseIntFieldValue(a, b interface{}, model Model, pos lexer.Position) (interface{}, interface{}, error) {
+	parser, ok := model.(IntFieldParser)
+	if !ok {
+		return a, b, nil
+	}
+
+	convert := func(field Field, evaluator interface{}) (interface{}, error) {
+		str, ok := evaluator.(*StringEvaluator)
+		if !ok || str.EvalFnc != nil || str.Field != "" || str.ValueType != ScalarValueType {
+			return evaluator, nil
+		}
+
+		value, ok, err := parser.ParseIntField(field, str.Value)
+		if err != nil {
+			return nil, NewError(pos, "invalid value `%s` for field `%s`: %s", str.Value, field, err)
+		}
+		if !ok {
+			return evaluator, nil
+		}
+		return &IntEvaluator{Value: value}, nil
+	}
+
+	var err error
+	if field, ok := intField(a); ok {
+		b, err = convert(field, b)
+	} else if field, ok := intField(b); ok {
+		a, err = convert(field, a)
+	}
+	return a, b, err
+}
+
 func nodeToEvaluator(obj interface{}, opts *Opts, state *State) (interface{}, lexer.Position, error) {
 	var err error
 	var boolEvaluator *BoolEvaluator
@@ -396,6 +658,17 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *State) (interface{}, le
 			return nil, pos, err
 		}
 
+		// partials are evaluated against events mutated in place, their results can't be cached
+		if cmpBool, ok := cmp.(*BoolEvaluator); ok && opts.ResultCaching && state.field == "" {
+			if key, ok := opts.resultCacheKey(obj.Comparison); ok {
+				cmp = cacheComparison(key, cmpBool)
+			}
+		}
+
+		if cmpBool, ok := cmp.(*BoolEvaluator); ok && opts.Tracing {
+			cmp = traceComparison(obj.Comparison, cmpBool)
+		}
+
 		if obj.Op != nil {
 			cmpBool, ok := cmp.(*BoolEvaluator)
 			if !ok {
@@ -531,640 +804,75 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *State) (interface{}, le
 			return nil, pos, err
 		}
 
-		if obj.ArrayComparison != nil {
+		var op string
+		switch {
+		case obj.ArrayComparison != nil:
+			op = *obj.ArrayComparison.Op
 			next, pos, err = nodeToEvaluator(obj.ArrayComparison, opts, state)
 			if err != nil {
 				return nil, pos, err
 			}
 
-			switch unary := unary.(type) {
-			case *BoolEvaluator:
-				switch nextBool := next.(type) {
-				case *BoolArrayEvaluator:
-					boolEvaluator, err = ArrayBoolContains(unary, nextBool, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					if *obj.ArrayComparison.Op == "notin" {
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return boolEvaluator, obj.Pos, nil
-				default:
-					return nil, pos, NewArrayTypeError(pos, reflect.Array, reflect.Bool)
-				}
-			case *StringEvaluator:
-				switch nextString := next.(type) {
-				case *StringArrayEvaluator:
-					boolEvaluator, err = StringArrayContainsWrapper(unary, nextString, state)
-					if err != nil {
-						return nil, pos, err
-					}
-				case *StringValuesEvaluator:
-					boolEvaluator, err = StringValuesContainsWrapper(unary, nextString, state)
-					if err != nil {
-						return nil, pos, err
-					}
-				default:
-					return nil, pos, NewArrayTypeError(pos, reflect.Array, reflect.String)
-				}
-				if *obj.ArrayComparison.Op == "notin" {
-					return Not(boolEvaluator, state), obj.Pos, nil
-				}
-				return boolEvaluator, obj.Pos, nil
-			case *StringValuesEvaluator:
-				switch nextStringArray := next.(type) {
-				case *StringArrayEvaluator:
-					boolEvaluator, err = StringArrayMatchesWrapper(nextStringArray, unary, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					if *obj.ArrayComparison.Op == "notin" {
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return boolEvaluator, obj.Pos, nil
-				default:
-					return nil, pos, NewArrayTypeError(pos, reflect.Array, reflect.String)
-				}
-			case *StringArrayEvaluator:
-				switch nextStringArray := next.(type) {
-				case *StringValuesEvaluator:
-					boolEvaluator, err = StringArrayMatchesWrapper(unary, nextStringArray, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					if *obj.ArrayComparison.Op == "notin" {
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return boolEvaluator, obj.Pos, nil
-				default:
-					return nil, pos, NewArrayTypeError(pos, reflect.Array, reflect.String)
-				}
-			case *IntEvaluator:
-				switch nextInt := next.(type) {
-				case *IntArrayEvaluator:
-					boolEvaluator, err = IntArrayEquals(unary, nextInt, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					if *obj.ArrayComparison.Op == "notin" {
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return boolEvaluator, obj.Pos, nil
-				default:
-					return nil, pos, NewArrayTypeError(pos, reflect.Array, reflect.Int)
-				}
-			case *IntArrayEvaluator:
-				switch nextIntArray := next.(type) {
-				case *IntArrayEvaluator:
-					boolEvaluator, err = IntArrayMatches(unary, nextIntArray, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					if *obj.ArrayComparison.Op == "notin" {
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return boolEvaluator, obj.Pos, nil
-				default:
-					return nil, pos, NewArrayTypeError(pos, reflect.Array, reflect.Int)
-				}
-			case *CIDREvaluator:
-				switch nextCIDR := next.(type) {
-				case *CIDREvaluator:
-					nextIP, ok := next.(*CIDREvaluator)
-					if !ok {
-						return nil, pos, NewTypeError(pos, reflect.TypeOf(CIDREvaluator{}).Kind())
-					}
-
-					boolEvaluator, err = CIDREquals(unary, nextIP, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					switch *obj.ArrayComparison.Op {
-					case "in", "allin":
-						return boolEvaluator, obj.Pos, nil
-					case "notin":
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ArrayComparison.Op)
-				case *CIDRValuesEvaluator:
-					switch *obj.ArrayComparison.Op {
-					case "in", "allin":
-						boolEvaluator, err = CIDRValuesContains(unary, nextCIDR, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "notin":
-						boolEvaluator, err = CIDRValuesContains(unary, nextCIDR, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ArrayComparison.Op)
-				case *CIDRArrayEvaluator:
-					switch *obj.ArrayComparison.Op {
-					case "in", "allin":
-						boolEvaluator, err = CIDRArrayContains(unary, nextCIDR, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "notin":
-						boolEvaluator, err = CIDRArrayContains(unary, nextCIDR, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ArrayComparison.Op)
-				default:
-					return nil, pos, NewCIDRTypeError(pos, reflect.Array, next)
-				}
-			case *CIDRArrayEvaluator:
-				switch nextCIDR := next.(type) {
-				case *CIDRValuesEvaluator:
-					switch *obj.ArrayComparison.Op {
-					case "in":
-						boolEvaluator, err = CIDRArrayMatches(unary, nextCIDR, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "allin":
-						boolEvaluator, err = CIDRArrayMatchesAll(unary, nextCIDR, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "notin":
-						boolEvaluator, err = CIDRArrayMatches(unary, nextCIDR, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ArrayComparison.Op)
-				default:
-					return nil, pos, NewCIDRTypeError(pos, reflect.Array, next)
-				}
-			case *CIDRValuesEvaluator:
-				switch nextCIDR := next.(type) {
-				case *CIDREvaluator:
-					switch *obj.ArrayComparison.Op {
-					case "in", "allin":
-						boolEvaluator, err = CIDRValuesContains(nextCIDR, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "notin":
-						boolEvaluator, err = CIDRValuesContains(nextCIDR, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ArrayComparison.Op)
-				case *CIDRArrayEvaluator:
-					switch *obj.ArrayComparison.Op {
-					case "allin":
-						boolEvaluator, err = CIDRArrayMatchesAll(nextCIDR, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "in":
-						boolEvaluator, err = CIDRArrayMatches(nextCIDR, unary, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "notin":
-						boolEvaluator, err = CIDRArrayMatches(nextCIDR, unary, state)
-						if err != nil {
-							return nil, pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ArrayComparison.Op)
-				default:
-					return nil, pos, NewCIDRTypeError(pos, reflect.Array, next)
-				}
-			default:
-				return nil, pos, NewTypeError(pos, reflect.Array)
+			if _, isInt := unary.(*IntEvaluator); !isInt && isBitmaskOperator(op) {
+				return nil, pos, NewOpUnknownError(obj.Pos, op)
 			}
-		} else if obj.ScalarComparison != nil {
+		case obj.ScalarComparison != nil:
+			op = *obj.ScalarComparison.Op
 			next, pos, err = nodeToEvaluator(obj.ScalarComparison, opts, state)
 			if err != nil {
 				return nil, pos, err
 			}
 
-			switch unary := unary.(type) {
-			case *BoolEvaluator:
-				nextBool, ok := next.(*BoolEvaluator)
-				if !ok {
-					return nil, pos, NewTypeError(pos, reflect.Bool)
-				}
-
-				switch *obj.ScalarComparison.Op {
-				case "!=":
-					boolEvaluator, err = BoolEquals(unary, nextBool, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					return Not(boolEvaluator, state), obj.Pos, nil
-				case "==":
-					boolEvaluator, err = BoolEquals(unary, nextBool, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					return boolEvaluator, obj.Pos, nil
-				}
-				return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
-			case *BoolArrayEvaluator:
-				nextBool, ok := next.(*BoolEvaluator)
-				if !ok {
-					return nil, pos, NewTypeError(pos, reflect.Bool)
-				}
-
-				switch *obj.ScalarComparison.Op {
-				case "!=":
-					boolEvaluator, err = BoolArrayEquals(nextBool, unary, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					return Not(boolEvaluator, state), obj.Pos, nil
-				case "==":
-					boolEvaluator, err = BoolArrayEquals(nextBool, unary, state)
-					if err != nil {
-						return nil, pos, err
-					}
-					return boolEvaluator, obj.Pos, nil
-				}
-				return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
-			case *StringEvaluator:
-				nextString, ok := next.(*StringEvaluator)
-				if !ok {
-					return nil, pos, NewTypeError(pos, reflect.String)
-				}
-
-				switch *obj.ScalarComparison.Op {
-				case "!=":
-					boolEvaluator, err = StringEqualsWrapper(unary, nextString, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return Not(boolEvaluator, state), obj.Pos, nil
-				case "!~":
-					if nextString.EvalFnc != nil {
-						return nil, obj.Pos, &ErrNonStaticPattern{Field: nextString.Field}
-					}
-
-					// force pattern if needed
-					if nextString.ValueType == ScalarValueType {
-						nextString.ValueType = PatternValueType
-					}
-
-					boolEvaluator, err = StringEqualsWrapper(unary, nextString, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return Not(boolEvaluator, state), obj.Pos, nil
-				case "==":
-					boolEvaluator, err = StringEqualsWrapper(unary, nextString, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return boolEvaluator, obj.Pos, nil
-				case "=~":
-					if nextString.EvalFnc != nil {
-						return nil, obj.Pos, &ErrNonStaticPattern{Field: nextString.Field}
-					}
-
-					// force pattern if needed
-					if nextString.ValueType == ScalarValueType {
-						nextString.ValueType = PatternValueType
-					}
-
-					boolEvaluator, err = StringEqualsWrapper(unary, nextString, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return boolEvaluator, obj.Pos, nil
-				}
-				return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
-			case *CIDREvaluator:
-				switch nextIP := next.(type) {
-				case *CIDREvaluator:
-					switch *obj.ScalarComparison.Op {
-					case "!=":
-						boolEvaluator, err = CIDREquals(unary, nextIP, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					case "==":
-						boolEvaluator, err = CIDREquals(unary, nextIP, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
-				}
-			case *StringArrayEvaluator:
-				nextString, ok := next.(*StringEvaluator)
-				if !ok {
-					return nil, pos, NewTypeError(pos, reflect.String)
-				}
+			if unary, next, err = parseIntFieldValue(unary, next, state.model, pos); err != nil {
+				return nil, pos, err
+			}
 
-				switch *obj.ScalarComparison.Op {
-				case "!=":
-					boolEvaluator, err = StringArrayContainsWrapper(nextString, unary, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return Not(boolEvaluator, state), obj.Pos, nil
-				case "==":
-					boolEvaluator, err = StringArrayContainsWrapper(nextString, unary, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return boolEvaluator, obj.Pos, nil
-				case "!~":
-					if nextString.EvalFnc != nil {
-						return nil, obj.Pos, &ErrNonStaticPattern{Field: nextString.Field}
-					}
-
-					// force pattern if needed
-					if nextString.ValueType == ScalarValueType {
-						nextString.ValueType = PatternValueType
-					}
-
-					boolEvaluator, err = StringArrayContainsWrapper(nextString, unary, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return Not(boolEvaluator, state), obj.Pos, nil
-				case "=~":
-					if nextString.EvalFnc != nil {
-						return nil, obj.Pos, &ErrNonStaticPattern{Field: nextString.Field}
-					}
-
-					// force pattern if needed
-					if nextString.ValueType == ScalarValueType {
-						nextString.ValueType = PatternValueType
-					}
-
-					boolEvaluator, err = StringArrayContainsWrapper(nextString, unary, state)
-					if err != nil {
-						return nil, obj.Pos, err
-					}
-					return boolEvaluator, obj.Pos, nil
+			if opts.StringToIntCoercion {
+				if unary, next, err = coerceStringToInt(unary, next, pos); err != nil {
+					return nil, pos, err
 				}
-			case *IntEvaluator:
-				switch nextInt := next.(type) {
-				case *IntEvaluator:
-					if nextInt.isDuration {
-						if unary.isFromArithmeticOperation {
-							switch *obj.ScalarComparison.Op {
-							case "<":
-								boolEvaluator, err = DurationLesserThanArithmeticOperation(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case "<=":
-								boolEvaluator, err = DurationLesserOrEqualThanArithmeticOperation(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case ">":
-								boolEvaluator, err = DurationGreaterThanArithmeticOperation(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case ">=":
-								boolEvaluator, err = DurationGreaterOrEqualThanArithmeticOperation(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case "==":
-								boolEvaluator, err = DurationEqualArithmeticOperation(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							}
+			}
 
-						} else {
-							switch *obj.ScalarComparison.Op {
-							case "<":
-								boolEvaluator, err = DurationLesserThan(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case "<=":
-								boolEvaluator, err = DurationLesserOrEqualThan(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case ">":
-								boolEvaluator, err = DurationGreaterThan(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case ">=":
-								boolEvaluator, err = DurationGreaterOrEqualThan(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							case "==":
-								boolEvaluator, err = DurationEqual(unary, nextInt, state)
-								if err != nil {
-									return nil, obj.Pos, err
-								}
-								return boolEvaluator, obj.Pos, nil
-							}
-						}
-					} else {
-						switch *obj.ScalarComparison.Op {
-						case "<":
-							boolEvaluator, err = LesserThan(unary, nextInt, state)
-							if err != nil {
-								return nil, obj.Pos, err
-							}
-							return boolEvaluator, obj.Pos, nil
-						case "<=":
-							boolEvaluator, err = LesserOrEqualThan(unary, nextInt, state)
-							if err != nil {
-								return nil, obj.Pos, err
-							}
-							return boolEvaluator, obj.Pos, nil
-						case ">":
-							boolEvaluator, err = GreaterThan(unary, nextInt, state)
-							if err != nil {
-								return nil, obj.Pos, err
-							}
-							return boolEvaluator, obj.Pos, nil
-						case ">=":
-							boolEvaluator, err = GreaterOrEqualThan(unary, nextInt, state)
-							if err != nil {
-								return nil, obj.Pos, err
-							}
-							return boolEvaluator, obj.Pos, nil
-						case "!=":
-							boolEvaluator, err = IntEquals(unary, nextInt, state)
-							if err != nil {
-								return nil, obj.Pos, err
-							}
+			unary, next = promoteIntToFloat(unary, next)
+		case obj.CustomComparison != nil:
+			op = *obj.CustomComparison.Op
+			next, pos, err = nodeToEvaluator(obj.CustomComparison, opts, state)
+			if err != nil {
+				return nil, pos, err
+			}
+		default:
+			return unary, pos, nil
+		}
 
-							return Not(boolEvaluator, state), obj.Pos, nil
-						case "==":
-							boolEvaluator, err = IntEquals(unary, nextInt, state)
-							if err != nil {
-								return nil, obj.Pos, err
-							}
-							return boolEvaluator, obj.Pos, nil
-						default:
-							return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
-						}
-					}
-				case *IntArrayEvaluator:
-					nextIntArray := next.(*IntArrayEvaluator)
-
-					switch *obj.ScalarComparison.Op {
-					case "<":
-						boolEvaluator, err = IntArrayLesserThan(unary, nextIntArray, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "<=":
-						boolEvaluator, err = IntArrayLesserOrEqualThan(unary, nextIntArray, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case ">":
-						boolEvaluator, err = IntArrayGreaterThan(unary, nextIntArray, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case ">=":
-						boolEvaluator, err = IntArrayGreaterOrEqualThan(unary, nextIntArray, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "!=":
-						boolEvaluator, err = IntArrayEquals(unary, nextIntArray, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					case "==":
-						boolEvaluator, err = IntArrayEquals(unary, nextIntArray, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					default:
-						return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
-					}
-				}
-				return nil, pos, NewTypeError(pos, reflect.Int)
-			case *IntArrayEvaluator:
-				nextInt, ok := next.(*IntEvaluator)
-				if !ok {
-					return nil, pos, NewTypeError(pos, reflect.Int)
-				}
+		compile, knownOperands := builtinOperators.lookup(op, unary, next)
+		if compile == nil {
+			var known bool
+			compile, known = opts.Operators.lookup(op, unary, next)
+			knownOperands = knownOperands || known
+		}
 
-				if nextInt.isDuration {
-					switch *obj.ScalarComparison.Op {
-					case "<":
-						boolEvaluator, err = DurationArrayLesserThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "<=":
-						boolEvaluator, err = DurationArrayLesserOrEqualThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case ">":
-						boolEvaluator, err = DurationArrayGreaterThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case ">=":
-						boolEvaluator, err = DurationArrayGreaterOrEqualThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					}
-				} else {
-					switch *obj.ScalarComparison.Op {
-					case "<":
-						boolEvaluator, err = IntArrayGreaterThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "<=":
-						boolEvaluator, err = IntArrayGreaterOrEqualThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case ">":
-						boolEvaluator, err = IntArrayLesserThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case ">=":
-						boolEvaluator, err = IntArrayLesserOrEqualThan(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					case "!=":
-						boolEvaluator, err = IntArrayEquals(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return Not(boolEvaluator, state), obj.Pos, nil
-					case "==":
-						boolEvaluator, err = IntArrayEquals(nextInt, unary, state)
-						if err != nil {
-							return nil, obj.Pos, err
-						}
-						return boolEvaluator, obj.Pos, nil
-					}
-					return nil, pos, NewOpUnknownError(obj.Pos, *obj.ScalarComparison.Op)
-				}
+		if compile == nil {
+			if knownOperands || obj.CustomComparison != nil {
+				return nil, pos, NewOpUnknownError(obj.Pos, op)
 			}
-		} else {
-			return unary, pos, nil
+			if obj.ArrayComparison != nil {
+				return nil, pos, arrayComparisonTypeError(pos, unary, next)
+			}
+			return nil, pos, scalarComparisonTypeError(pos, unary)
 		}
 
+		boolEvaluator, err = compile(unary, next, opts, state)
+		if err != nil {
+			return nil, obj.Pos, err
+		}
+		return boolEvaluator, obj.Pos, nil
+
 	case *ast.ArrayComparison:
 		return nodeToEvaluator(obj.Array, opts, state)
 
+	case *ast.CustomComparison:
+		return nodeToEvaluator(obj.Next, opts, state)
+
 	case *ast.ScalarComparison:
 		return nodeToEvaluator(obj.Next, opts, state)
 
@@ -1183,6 +891,14 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *State) (interface{}, le
 				}
 
 				return Not(unaryBool, state), obj.Pos, nil
+			case "exists":
+				switch unaryString := unary.(type) {
+				case *StringEvaluator:
+					return StringExists(unaryString, state), obj.Pos, nil
+				case *StringArrayEvaluator:
+					return StringArrayExists(unaryString, state), obj.Pos, nil
+				}
+				return nil, pos, NewTypeError(pos, reflect.String)
 			case "-":
 				unaryInt, ok := unary.(*IntEvaluator)
 				if !ok {
@@ -1204,8 +920,14 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *State) (interface{}, le
 		return nodeToEvaluator(obj.Primary, opts, state)
 	case *ast.Primary:
 		switch {
+		case obj.Call != nil:
+			return callToEvaluator(obj.Call, opts, state)
 		case obj.Ident != nil:
 			return identToEvaluator(&ident{Pos: obj.Pos, Ident: obj.Ident}, opts, state)
+		case obj.Float != nil:
+			return &FloatEvaluator{
+				Value: *obj.Float,
+			}, obj.Pos, nil
 		case obj.Number != nil:
 			return &IntEvaluator{
 				Value: *obj.Number,
@@ -1279,3 +1001,30 @@ func nodeToEvaluator(obj interface{}, opts *Opts, state *State) (interface{}, le
 
 	return nil, lexer.Position{}, NewError(lexer.Position{}, "unknown entity '%s'", reflect.TypeOf(obj))
 }
+
+// callToEvaluator returns the evaluator of a predicate call, the arguments being string literals
+func callToEvaluator(call *ast.Call, opts *Opts, state *State) (interface{}, lexer.Position, error) {
+	predicate := opts.Predicates[call.Name]
+	if predicate == nil {
+		return nil, call.Pos, &ErrPredicateNotFound{Name: call.Name}
+	}
+
+	args := make([]string, 0, len(call.Args))
+	for _, arg := range call.Args {
+		if arg.String == nil {
+			return nil, arg.Pos, NewError(arg.Pos, "the arguments of `%s` must be strings", call.Name)
+		}
+		args = append(args, *arg.String)
+	}
+
+	evaluator, err := predicate(args)
+	if err != nil {
+		return nil, call.Pos, NewError(call.Pos, "invalid call of `%s`: %s", call.Name, err)
+	}
+
+	if evaluator.Field != "" {
+		state.UpdateFields(evaluator.Field)
+	}
+
+	return evaluator, call.Pos, nil
+}
@@ -8,10 +8,12 @@ package eval
 
 import (
 	"container/list"
+	"errors"
 	"fmt"
 	"net"
 	"os"
 	"runtime"
+	"slices"
 	"strings"
 	"syscall"
 	"testing"
@@ -146,6 +148,49 @@ func TestSimpleString(t *testing.T) {
 	}
 }
 
+func TestStringOrdering(t *testing.T) {
+	event := &testEvent{
+		process: testProcess{
+			name: "1.2.10",
+		},
+		open: testOpen{
+			filename: "/var/log/b.log",
+		},
+	}
+
+	tests := []struct {
+		Expr     string
+		Expected bool
+	}{
+		{Expr: `process.name > "1.2.0"`, Expected: true},
+		{Expr: `process.name > "1.2.9"`, Expected: false},
+		{Expr: `process.name < "1.2.9"`, Expected: true},
+		{Expr: `process.name >= "1.2.10"`, Expected: true},
+		{Expr: `process.name <= "1.2.10"`, Expected: true},
+		{Expr: `process.name < "1.2.10"`, Expected: false},
+		{Expr: `"1.3" > process.name`, Expected: true},
+		{Expr: `open.filename > "/var/log/a.log"`, Expected: true},
+		{Expr: `open.filename < "/var/log/c.log"`, Expected: true},
+		{Expr: `open.filename >= "/var/log/c.log"`, Expected: false},
+		{Expr: `open.filename > process.name`, Expected: false},
+	}
+
+	for _, test := range tests {
+		result, _, err := eval(t, event, test.Expr)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t`\n%s", test.Expected, result, test.Expr)
+		}
+	}
+
+	if _, _, err := eval(t, event, `process.name > ~"1.*"`); err == nil {
+		t.Error("expected an error when ordering a pattern")
+	}
+}
+
 func TestSimpleInt(t *testing.T) {
 	event := &testEvent{
 		process: testProcess{
@@ -179,6 +224,50 @@ func TestSimpleInt(t *testing.T) {
 	}
 }
 
+func TestSimpleFloat(t *testing.T) {
+	event := &testEvent{
+		process: testProcess{
+			score:  3.75,
+			scores: []float64{1.5, 4.25},
+		},
+	}
+
+	tests := []struct {
+		Expr     string
+		Expected bool
+	}{
+		{Expr: `1.5 < 2.5`, Expected: true},
+		{Expr: `process.score == 3.75`, Expected: true},
+		{Expr: `process.score != 3.75`, Expected: false},
+		{Expr: `process.score > 3.5`, Expected: true},
+		{Expr: `process.score >= 3.75`, Expected: true},
+		{Expr: `process.score < 3.75`, Expected: false},
+		{Expr: `process.score <= -1.5`, Expected: false},
+		{Expr: `3.5 < process.score`, Expected: true},
+		{Expr: `process.score > 3`, Expected: true},
+		{Expr: `process.score < 4`, Expected: true},
+		{Expr: `process.scores > 4.0`, Expected: true},
+		{Expr: `process.scores < 1.5`, Expected: false},
+		{Expr: `process.scores == 1.5`, Expected: true},
+		{Expr: `2 > process.scores`, Expected: true},
+	}
+
+	for _, test := range tests {
+		result, _, err := eval(t, event, test.Expr)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t`\n%s", test.Expected, result, test.Expr)
+		}
+	}
+
+	if _, _, err := eval(t, event, `process.score == "3.75"`); err == nil {
+		t.Error("expected a type error comparing a float field to a string")
+	}
+}
+
 func TestSimpleBool(t *testing.T) {
 	event := &testEvent{}
 
@@ -287,6 +376,156 @@ func TestSimpleBitOperations(t *testing.T) {
 	}
 }
 
+func TestRegexpFullMatch(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Expr     string
+		Expected bool
+	}{
+		{Name: "bash", Expr: `process.name =~ r"sh"`, Expected: true},
+		{Name: "bash", Expr: `process.name fullmatch r"sh"`, Expected: false},
+		{Name: "bash", Expr: `process.name fullmatch r"(ba)?sh"`, Expected: true},
+		{Name: "bash", Expr: `process.name fullmatch r"sh|zsh"`, Expected: false},
+		{Name: "bash", Expr: `process.name fullmatch "bash"`, Expected: true},
+		{Name: "bash", Expr: `process.name fullmatch ~"ba*"`, Expected: true},
+		{Name: "sh", Expr: `process.name =~ r"sh"`, Expected: true},
+		{Name: "sh", Expr: `process.name fullmatch r"sh"`, Expected: true},
+		{Name: "sh", Expr: `process.name fullmatch r"sh|zsh"`, Expected: true},
+		{Name: "sh", Expr: `process.name fullmatch "bash"`, Expected: false},
+	}
+
+	for _, test := range tests {
+		event := &testEvent{
+			process: testProcess{
+				name: test.Name,
+			},
+		}
+
+		result, _, err := eval(t, event, test.Expr)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t` for `%s`\n%s", test.Expected, result, test.Name, test.Expr)
+		}
+	}
+
+	t.Run("non-static", func(t *testing.T) {
+		if _, err := parseRule(`process.name fullmatch process.name`, &testModel{}, newOptsWithParams(testConstants, nil)); err == nil {
+			t.Error("expected a non static pattern error")
+		}
+	})
+}
+
+func TestExists(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Expr     string
+		Expected bool
+	}{
+		{Name: "bash", Expr: `exists process.name`, Expected: true},
+		{Name: "bash", Expr: `not exists process.name`, Expected: false},
+		{Name: "bash", Expr: `exists process.name && process.name == "bash"`, Expected: true},
+		{Name: "", Expr: `exists process.name`, Expected: false},
+		{Name: "", Expr: `not exists process.name`, Expected: true},
+		{Name: "", Expr: `!exists process.name || process.name == "bash"`, Expected: true},
+	}
+
+	for _, test := range tests {
+		event := &testEvent{
+			process: testProcess{
+				name: test.Name,
+			},
+		}
+
+		result, _, err := eval(t, event, test.Expr)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t` for `%s`\n%s", test.Expected, result, test.Name, test.Expr)
+		}
+	}
+
+	t.Run("array", func(t *testing.T) {
+		event := &testEvent{
+			process: testProcess{
+				array: []*testItem{{value: ""}, {value: "a"}},
+			},
+		}
+
+		result, _, err := eval(t, event, `exists process.array.value`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !result {
+			t.Error("expected an array with a non empty value to exist")
+		}
+
+		event.process.array = []*testItem{{value: ""}}
+		if result, _, _ = eval(t, event, `exists process.array.value`); result {
+			t.Error("expected an array of empty values not to exist")
+		}
+	})
+
+	t.Run("non-string", func(t *testing.T) {
+		if _, err := parseRule(`exists process.pid`, &testModel{}, newOptsWithParams(testConstants, nil)); err == nil {
+			t.Error("expected a type error for an int field")
+		}
+	})
+}
+
+func TestBitmaskOperators(t *testing.T) {
+	event := &testEvent{
+		open: testOpen{
+			flags: syscall.O_CREAT | syscall.O_RDWR,
+			mode:  syscall.O_CREAT | syscall.O_RDWR | syscall.O_TRUNC,
+		},
+	}
+
+	tests := []struct {
+		Expr     string
+		Expected bool
+	}{
+		{Expr: `open.flags subset O_CREAT | O_RDWR | O_TRUNC`, Expected: true},
+		{Expr: `open.flags subset O_CREAT | O_RDWR`, Expected: true},
+		{Expr: `open.flags subset O_CREAT`, Expected: false},
+		{Expr: `open.flags subset [ O_CREAT, O_RDWR, O_EXCL ]`, Expected: true},
+		{Expr: `open.flags subset [ O_CREAT, O_EXCL ]`, Expected: false},
+		{Expr: `open.flags subset open.mode`, Expected: true},
+		{Expr: `open.mode subset open.flags`, Expected: false},
+		{Expr: `open.flags superset O_CREAT`, Expected: true},
+		{Expr: `open.flags superset [ O_CREAT, O_RDWR ]`, Expected: true},
+		{Expr: `open.flags superset [ O_CREAT, O_TRUNC ]`, Expected: false},
+		{Expr: `open.mode superset open.flags`, Expected: true},
+		{Expr: `O_CREAT superset open.flags`, Expected: false},
+		{Expr: `open.flags intersects O_TRUNC | O_CREAT`, Expected: true},
+		{Expr: `open.flags intersects [ O_TRUNC, O_EXCL ]`, Expected: false},
+		{Expr: `open.flags intersects open.mode`, Expected: true},
+		{Expr: `3 subset 7`, Expected: true},
+		{Expr: `7 subset 3`, Expected: false},
+	}
+
+	for _, test := range tests {
+		result, _, err := eval(t, event, test.Expr)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t`\n%s", test.Expected, result, test.Expr)
+		}
+	}
+
+	for _, expr := range []string{`process.name subset [ "a", "b" ]`, `process.name superset "a"`, `process.is_root intersects true`} {
+		if _, _, err := eval(t, event, expr); err == nil {
+			t.Errorf("expected an error for `%s`", expr)
+		}
+	}
+}
+
 func TestStringMatcher(t *testing.T) {
 	event := &testEvent{
 		process: testProcess{
@@ -790,19 +1029,81 @@ func TestLegacyField(t *testing.T) {
 	opts := newOptsWithParams(nil, legacyFields)
 
 	tests := []struct {
-		Expr     string
-		Expected bool
+		Expr         string
+		Expected     bool
+		LegacyFields []Field
 	}{
-		{Expr: `process.legacy_name == "/tmp/secrets"`, Expected: true},
+		{Expr: `process.legacy_name == "/tmp/secrets"`, Expected: true, LegacyFields: []Field{"process.legacy_name"}},
+		{Expr: `process.legacy_name == "/tmp/secrets" || process.legacy_name == "/tmp/passwords"`, Expected: true, LegacyFields: []Field{"process.legacy_name"}},
 		{Expr: `process.random_name == "/tmp/secrets"`, Expected: false},
 		{Expr: `process.name == "/tmp/secrets"`, Expected: true},
 	}
 
 	for _, test := range tests {
-		_, err := parseRule(test.Expr, model, opts)
+		rule, err := parseRule(test.Expr, model, opts)
 		if err == nil != test.Expected {
 			t.Errorf("expected result `%t` not found, got `%t`\n%s", test.Expected, err == nil, test.Expr)
 		}
+		if err == nil && !slices.Equal(rule.GetLegacyFields(), test.LegacyFields) {
+			t.Errorf("expected legacy fields `%v` not found, got `%v`\n%s", test.LegacyFields, rule.GetLegacyFields(), test.Expr)
+		}
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	model := &testModel{}
+
+	event := &testEvent{
+		process: testProcess{
+			name: "/usr/bin/cat",
+		},
+	}
+
+	opts := newOptsWithParams(nil, nil)
+	opts.WithPredicates(map[string]Predicate{
+		"has_name": func(args []string) (*BoolEvaluator, error) {
+			if len(args) != 1 {
+				return nil, errors.New("expects one name")
+			}
+			name := args[0]
+			return &BoolEvaluator{
+				Field: "process.name",
+				EvalFnc: func(ctx *Context) bool {
+					return ctx.Event.(*testEvent).process.name == name
+				},
+			}, nil
+		},
+	})
+
+	tests := []struct {
+		Expr     string
+		Compiled bool
+		Expected bool
+	}{
+		{Expr: `has_name("/usr/bin/cat")`, Compiled: true, Expected: true},
+		{Expr: `has_name("/usr/bin/ls")`, Compiled: true, Expected: false},
+		{Expr: `!has_name("/usr/bin/ls") && process.name != ""`, Compiled: true, Expected: true},
+		{Expr: `has_name()`, Compiled: false},
+		{Expr: `has_name(1)`, Compiled: false},
+		{Expr: `has_path("/usr/bin/cat")`, Compiled: false},
+	}
+
+	for _, test := range tests {
+		rule, err := parseRule(test.Expr, model, opts)
+		if err == nil != test.Compiled {
+			t.Errorf("expected compilation `%t`, got `%v`\n%s", test.Compiled, err, test.Expr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+
+		if result := rule.Eval(NewContext(event)); result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t`\n%s", test.Expected, result, test.Expr)
+		}
+		if !slices.Contains(rule.GetFields(), "process.name") {
+			t.Errorf("expected the field of the predicate in `%v`\n%s", rule.GetFields(), test.Expr)
+		}
 	}
 }
 
@@ -951,6 +1252,55 @@ func TestRegister(t *testing.T) {
 	}
 }
 
+func TestRegisterIndex(t *testing.T) {
+	event := &testEvent{
+		process: testProcess{},
+	}
+
+	event.process.list = list.New()
+	event.process.list.PushBack(&testItem{key: 10, value: "AAA"})
+	event.process.list.PushBack(&testItem{key: 100, value: "BBB"})
+
+	event.process.array = []*testItem{
+		{key: 1000, value: "EEEE", flag: true},
+		{key: 1002, value: "DDDD", flag: false},
+	}
+
+	tests := []struct {
+		Expr     string
+		Expected bool
+	}{
+		{Expr: `process.list[0].key == 10`, Expected: true},
+		{Expr: `process.list[0].key == 100`, Expected: false},
+		{Expr: `process.list[1].key == 100`, Expected: true},
+		{Expr: `process.list[1].value == "AAA"`, Expected: false},
+		{Expr: `process.list[2].key == 10`, Expected: false},
+		{Expr: `process.list[2].key != 10`, Expected: true},
+		{Expr: `process.list[0].key == 10 && process.list[1].value == "BBB"`, Expected: true},
+		{Expr: `process.list[0].key == 10 && process.array[0].key == 1000`, Expected: true},
+		{Expr: `process.list[1].key == 100 && process.array[1].key == 1000`, Expected: false},
+		{Expr: `process.list[A].key == 100 && process.list[0].value == "AAA"`, Expected: true},
+		{Expr: `process.list.value == "BBB" && process.list[0].value == "BBB"`, Expected: false},
+	}
+
+	for _, test := range tests {
+		result, _, err := eval(t, event, test.Expr)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t`\n%s", test.Expected, result, test.Expr)
+		}
+	}
+
+	t.Run("not an iterator", func(t *testing.T) {
+		if _, err := parseRule(`process.name[0] == "abc"`, &testModel{}, newOptsWithParams(nil, nil)); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
 func TestRegisterPartial(t *testing.T) {
 	event := &testEvent{
 		process: testProcess{},
@@ -1609,3 +1959,112 @@ func BenchmarkPool(b *testing.B) {
 		pool.pool.Put(ctx)
 	}
 }
+
+func TestFieldNotFoundSuggestion(t *testing.T) {
+	tests := []struct {
+		expr       string
+		suggestion Field
+	}{
+		{
+			expr:       `open.filenam == "/etc/shadow"`,
+			suggestion: "open.filename",
+		},
+		{
+			expr:       `process.gdi == 0`,
+			suggestion: "process.gid",
+		},
+		{
+			expr: `kernel.module.signature == "abc"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			rule, err := NewRule("id1", test.expr, ast.NewParsingContext(false), newOptsWithParams(nil, nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = rule.GenEvaluator(&testModel{})
+			if err == nil {
+				t.Fatal("should return an error")
+			}
+
+			var errFieldNotFound *ErrFieldNotFound
+			if !errors.As(err, &errFieldNotFound) {
+				t.Fatalf("should return a field not found error, got: %v", err)
+			}
+
+			if errFieldNotFound.Suggestion != test.suggestion {
+				t.Errorf("expected suggestion `%s`, got `%s`", test.suggestion, errFieldNotFound.Suggestion)
+			}
+
+			if test.suggestion != "" && !strings.Contains(err.Error(), "did you mean `"+test.suggestion+"`") {
+				t.Errorf("expected the suggestion in the error message, got: %s", err)
+			}
+		})
+	}
+}
+
+func TestStringToIntCoercion(t *testing.T) {
+	event := &testEvent{
+		process: testProcess{
+			name: "577",
+		},
+		open: testOpen{
+			flags: 577,
+		},
+	}
+
+	tests := []struct {
+		expr     string
+		expected bool
+		err      bool
+	}{
+		{expr: `open.flags == "577"`, expected: true},
+		{expr: `"577" == open.flags`, expected: true},
+		{expr: `open.flags != "577"`, expected: false},
+		{expr: `open.flags > "500"`, expected: true},
+		{expr: `open.flags == "578"`, expected: false},
+		{expr: `open.flags == "O_CREAT"`, err: true},
+		{expr: `open.flags == "5 7 7"`, err: true},
+		{expr: `process.name == "577"`, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			opts := newOptsWithParams(nil, nil).WithStringToIntCoercion(true)
+
+			rule, err := NewRule("id1", test.expr, ast.NewParsingContext(false), opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = rule.GenEvaluator(&testModel{})
+			if test.err {
+				if err == nil {
+					t.Fatal("should return an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if result := rule.Eval(NewContext(event)); result != test.expected {
+				t.Errorf("expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		rule, err := NewRule("id1", `open.flags == "577"`, ast.NewParsingContext(false), newOptsWithParams(nil, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := rule.GenEvaluator(&testModel{}); err == nil {
+			t.Error("should return an error without coercion")
+		}
+	})
+}
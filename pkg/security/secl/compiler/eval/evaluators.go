@@ -86,6 +86,38 @@ func (i *IntEvaluator) IsStatic() bool {
 	return i.EvalFnc == nil
 }
 
+// FloatEvaluator returns a float as result of the evaluation
+type FloatEvaluator struct {
+	EvalFnc     func(ctx *Context) float64
+	Field       Field
+	Value       float64
+	Weight      int
+	OpOverrides *OpOverrides
+
+	// used during compilation of partial
+	isDeterministic bool
+}
+
+// Eval returns the result of the evaluation
+func (f *FloatEvaluator) Eval(ctx *Context) interface{} {
+	return f.EvalFnc(ctx)
+}
+
+// IsDeterministicFor returns whether the evaluator is partial
+func (f *FloatEvaluator) IsDeterministicFor(field Field) bool {
+	return f.isDeterministic || (f.Field != "" && f.Field == field)
+}
+
+// GetField returns field name used by this evaluator
+func (f *FloatEvaluator) GetField() string {
+	return f.Field
+}
+
+// IsStatic returns whether the evaluator is a scalar
+func (f *FloatEvaluator) IsStatic() bool {
+	return f.EvalFnc == nil
+}
+
 // StringEvaluator returns a string as result of the evaluation
 type StringEvaluator struct {
 	EvalFnc       func(ctx *Context) string
@@ -280,6 +312,43 @@ func (i *IntArrayEvaluator) AppendValues(values ...int) {
 	i.Values = append(i.Values, values...)
 }
 
+// FloatArrayEvaluator returns an array of float
+type FloatArrayEvaluator struct {
+	EvalFnc     func(ctx *Context) []float64
+	Field       Field
+	Values      []float64
+	Weight      int
+	OpOverrides *OpOverrides
+
+	// used during compilation of partial
+	isDeterministic bool
+}
+
+// Eval returns the result of the evaluation
+func (f *FloatArrayEvaluator) Eval(ctx *Context) interface{} {
+	return f.EvalFnc(ctx)
+}
+
+// IsDeterministicFor returns whether the evaluator is partial
+func (f *FloatArrayEvaluator) IsDeterministicFor(field Field) bool {
+	return f.isDeterministic || (f.Field != "" && f.Field == field)
+}
+
+// GetField returns field name used by this evaluator
+func (f *FloatArrayEvaluator) GetField() string {
+	return f.Field
+}
+
+// IsStatic returns whether the evaluator is a scalar
+func (f *FloatArrayEvaluator) IsStatic() bool {
+	return f.EvalFnc == nil
+}
+
+// AppendValues to the array evaluator
+func (f *FloatArrayEvaluator) AppendValues(values ...float64) {
+	f.Values = append(f.Values, values...)
+}
+
 // BoolArrayEvaluator returns an array of bool
 type BoolArrayEvaluator struct {
 	EvalFnc     func(ctx *Context) []bool
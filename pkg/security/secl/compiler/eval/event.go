@@ -29,6 +29,12 @@ type Event interface {
 	GetTags() []string
 }
 
+// FieldsLister is implemented by the events able to list all the fields they expose
+type FieldsLister interface {
+	// GetFields returns all the fields of the event
+	GetFields() []Field
+}
+
 func eventTypeFromFields(model Model, state *State) (EventType, error) {
 	var eventType EventType
 
@@ -0,0 +1,173 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type gitIgnorePattern struct {
+	re      *regexp.Regexp
+	negated bool
+	dirOnly bool
+}
+
+// GitIgnoreMatcher matches paths against a list of patterns using the gitignore syntax, relative to the root
+// directory:
+//   - a pattern without a slash, other than a trailing one, matches a name at any level, like `*.key`
+//   - a pattern with a leading or inner slash is anchored to the root directory, like `/etc/shadow` or `etc/shadow`
+//   - a pattern with a trailing slash only matches directories, the paths below them being matched
+//   - `**` matches any number of directories, like `**/secrets/*` or `/home/**/.ssh`
+//   - a pattern starting with `!` re-includes the paths matched by the previous patterns
+//
+// As with git, the last pattern matching a path wins, and a path below a matched directory can't be re-included.
+type GitIgnoreMatcher struct {
+	patterns        []gitIgnorePattern
+	caseInsensitive bool
+}
+
+// NewGitIgnoreMatcher returns a new matcher without any pattern
+func NewGitIgnoreMatcher(caseInsensitive bool) *GitIgnoreMatcher {
+	return &GitIgnoreMatcher{
+		caseInsensitive: caseInsensitive,
+	}
+}
+
+// Add adds a pattern, as a line of a gitignore file, to the matcher. The blank lines and the comments are ignored.
+func (m *GitIgnoreMatcher) Add(line string) error {
+	pattern := strings.TrimRight(line, " \t")
+	if strings.HasSuffix(pattern, "\\") && strings.HasSuffix(line, " ") {
+		// an escaped trailing space is kept
+		pattern += " "
+	}
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return nil
+	}
+
+	var p gitIgnorePattern
+	if strings.HasPrefix(pattern, "!") {
+		p.negated = true
+		pattern = pattern[1:]
+	}
+	if strings.HasSuffix(pattern, "/") {
+		p.dirOnly = true
+		pattern = strings.TrimRight(pattern, "/")
+	}
+	if pattern == "" {
+		return fmt.Errorf("invalid gitignore pattern `%s`", line)
+	}
+
+	var expr strings.Builder
+	if m.caseInsensitive {
+		expr.WriteString("(?i)")
+	}
+
+	// a pattern with a slash is relative to the root directory, otherwise it matches a name at any level
+	if strings.Contains(pattern, "/") {
+		expr.WriteString("^/")
+		if strings.HasPrefix(pattern, "**/") {
+			expr.WriteString("(?:.*/)?")
+			pattern = pattern[3:]
+		}
+		pattern = strings.TrimPrefix(pattern, "/")
+	} else {
+		expr.WriteString("^(?:.*/)?")
+	}
+
+	if err := gitIgnorePatternToRegexp(pattern, &expr); err != nil {
+		return fmt.Errorf("invalid gitignore pattern `%s`: %w", line, err)
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return fmt.Errorf("invalid gitignore pattern `%s`: %w", line, err)
+	}
+	p.re = re
+
+	m.patterns = append(m.patterns, p)
+	return nil
+}
+
+func gitIgnorePatternToRegexp(pattern string, expr *strings.Builder) error {
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; c {
+		case '*':
+			if !strings.HasPrefix(pattern[i:], "**") {
+				expr.WriteString("[^/]*")
+				continue
+			}
+
+			leading := i == 0 || pattern[i-1] == '/'
+			switch rest := pattern[i+2:]; {
+			case leading && rest == "":
+				// a trailing `/**` matches everything inside the directory
+				expr.WriteString(".*")
+			case leading && strings.HasPrefix(rest, "/"):
+				// `/**/` matches zero or more directories
+				expr.WriteString("(?:.*/)?")
+				i++
+			default:
+				// any other `**` is a regular star
+				expr.WriteString("[^/]*")
+			}
+			i++
+		case '?':
+			expr.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(pattern[i+1:], ']')
+			if end < 0 {
+				return fmt.Errorf("unterminated character class")
+			}
+			class := pattern[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(pattern) {
+				i++
+			}
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return nil
+}
+
+// Len returns the number of patterns of the matcher
+func (m *GitIgnoreMatcher) Len() int {
+	return len(m.patterns)
+}
+
+// Matches returns whether the given absolute path is matched by the patterns
+func (m *GitIgnoreMatcher) Matches(path string) bool {
+	// a path below a matched directory is matched whatever the patterns matching the path itself
+	for i := 1; i < len(path); i++ {
+		if path[i] == '/' && m.match(path[:i], true) {
+			return true
+		}
+	}
+	return m.match(path, false)
+}
+
+func (m *GitIgnoreMatcher) match(path string, isDir bool) bool {
+	for i := len(m.patterns) - 1; i >= 0; i-- {
+		p := &m.patterns[i]
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.re.MatchString(path) {
+			return !p.negated
+		}
+	}
+	return false
+}
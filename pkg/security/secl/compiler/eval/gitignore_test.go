@@ -0,0 +1,152 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"testing"
+)
+
+func TestGitIgnoreMatcher(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		paths    map[string]bool
+	}{
+		{
+			name:     "double-star",
+			patterns: []string{"**/secrets/*"},
+			paths: map[string]bool{
+				"/secrets/token":             true,
+				"/opt/app/secrets/token":     true,
+				"/opt/app/secrets/db/passwd": true,
+				"/opt/app/secrets":           false,
+				"/opt/app/not-secrets/token": false,
+			},
+		},
+		{
+			name:     "negation",
+			patterns: []string{"# logs of the host", "", "*.log", "!audit.log"},
+			paths: map[string]bool{
+				"/var/log/syslog.log":     true,
+				"/home/user/app/out.log":  true,
+				"/var/log/audit.log":      false,
+				"/var/log/audit/audit.lo": false,
+			},
+		},
+		{
+			name:     "directory-anchored",
+			patterns: []string{"/build/"},
+			paths: map[string]bool{
+				"/build/out.o":       true,
+				"/build/obj/main.o":  true,
+				"/build":             false,
+				"/src/build/out.o":   false,
+				"/buildroot/out.o":   false,
+				"/src/main/build.go": false,
+			},
+		},
+		{
+			name:     "directory-unanchored",
+			patterns: []string{"node_modules/"},
+			paths: map[string]bool{
+				"/home/user/app/node_modules/left-pad/index.js": true,
+				"/node_modules/x":             true,
+				"/home/user/app/node_modules": false,
+			},
+		},
+		{
+			name:     "anchored",
+			patterns: []string{"etc/shadow", "/home/**/.ssh/id_*", "/usr/lib/**"},
+			paths: map[string]bool{
+				"/etc/shadow":                 true,
+				"/home/user/.ssh/id_rsa":      true,
+				"/home/.ssh/id_ed25519":       true,
+				"/usr/lib/x86_64/libc.so":     true,
+				"/backup/etc/shadow":          false,
+				"/home/user/.ssh/known_hosts": false,
+				"/usr/lib":                    false,
+			},
+		},
+		{
+			name:     "no-reinclusion-below-directory",
+			patterns: []string{"/etc/", "!/etc/hosts"},
+			paths: map[string]bool{
+				"/etc/hosts":  true,
+				"/etc/passwd": true,
+			},
+		},
+		{
+			name:     "reinclusion",
+			patterns: []string{"/etc/*", "!/etc/hosts"},
+			paths: map[string]bool{
+				"/etc/hosts":  false,
+				"/etc/passwd": true,
+			},
+		},
+		{
+			name:     "wildcards",
+			patterns: []string{"/tmp/?.sh", "/tmp/[a-c]*.py", "/tmp/[!a-c]*.pl", "\\!important", "\\#hash"},
+			paths: map[string]bool{
+				"/tmp/x.sh":       true,
+				"/tmp/xy.sh":      false,
+				"/tmp/b64.py":     true,
+				"/tmp/d64.py":     false,
+				"/tmp/d64.pl":     true,
+				"/tmp/b64.pl":     false,
+				"/tmp/!important": true,
+				"/tmp/#hash":      true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matcher := NewGitIgnoreMatcher(false)
+			for _, pattern := range test.patterns {
+				if err := matcher.Add(pattern); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for path, expected := range test.paths {
+				if matcher.Matches(path) != expected {
+					t.Errorf("expected `%s` to match %v: %v", path, test.patterns, expected)
+				}
+			}
+		})
+	}
+
+	t.Run("comments", func(t *testing.T) {
+		matcher := NewGitIgnoreMatcher(false)
+		for _, pattern := range []string{"", "   ", "# comment"} {
+			if err := matcher.Add(pattern); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if matcher.Len() != 0 {
+			t.Errorf("expected no pattern, got %d", matcher.Len())
+		}
+	})
+
+	t.Run("case-insensitive", func(t *testing.T) {
+		matcher := NewGitIgnoreMatcher(true)
+		if err := matcher.Add("**/Secrets/*"); err != nil {
+			t.Fatal(err)
+		}
+		if !matcher.Matches("/opt/SECRETS/token") {
+			t.Error("expected a case insensitive match")
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, pattern := range []string{"/tmp/[a-c", "!", "/"} {
+			if err := NewGitIgnoreMatcher(false).Add(pattern); err == nil {
+				t.Errorf("expected an error for `%s`", pattern)
+			}
+		}
+	})
+}
@@ -0,0 +1,126 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"slices"
+	"strings"
+)
+
+type globSetNode struct {
+	children map[string]*globSetNode
+	// globs holds the indexes of the patterns whose literal directory prefix ends at this node
+	globs []int
+}
+
+// GlobSet matches values against a set of glob patterns at once. The patterns are indexed by the literal segments
+// preceding their first wildcard, so that a value is only tested against the patterns sharing its leading
+// directories instead of all of them.
+type GlobSet struct {
+	root            globSetNode
+	patterns        []string
+	globs           []*Glob
+	caseInsensitive bool
+}
+
+// NewGlobSet returns a new empty set of glob patterns
+func NewGlobSet(caseInsensitive bool) *GlobSet {
+	return &GlobSet{
+		caseInsensitive: caseInsensitive,
+	}
+}
+
+// Add adds a glob pattern to the set, the patterns being matched in the order they were added
+func (s *GlobSet) Add(pattern string) error {
+	glob, err := NewGlob(pattern, s.caseInsensitive, false)
+	if err != nil {
+		return err
+	}
+
+	node := &s.root
+	if strings.HasPrefix(pattern, "/") {
+		segments := strings.Split(pattern[1:], "/")
+		// the last segment is the base name, indexed only when it is literal
+		for i, segment := range segments {
+			if strings.Contains(segment, "*") || (i == len(segments)-1 && segment == "") {
+				break
+			}
+			node = node.child(s.key(segment))
+		}
+	}
+
+	node.globs = append(node.globs, len(s.globs))
+	s.patterns = append(s.patterns, pattern)
+	s.globs = append(s.globs, glob)
+
+	return nil
+}
+
+func (n *globSetNode) child(segment string) *globSetNode {
+	child := n.children[segment]
+	if child == nil {
+		if n.children == nil {
+			n.children = make(map[string]*globSetNode)
+		}
+		child = &globSetNode{}
+		n.children[segment] = child
+	}
+	return child
+}
+
+func (s *GlobSet) key(segment string) string {
+	if s.caseInsensitive {
+		return strings.ToLower(segment)
+	}
+	return segment
+}
+
+// Len returns the number of patterns of the set
+func (s *GlobSet) Len() int {
+	return len(s.globs)
+}
+
+// Match returns the first pattern, in the order they were added, matching the given value
+func (s *GlobSet) Match(value string) (string, bool) {
+	// the patterns not starting with a literal directory are candidates for any value
+	candidates := s.root.globs
+
+	if strings.HasPrefix(value, "/") {
+		node := &s.root
+		for rest := value[1:]; node != nil; {
+			segment, next, found := strings.Cut(rest, "/")
+			if node = node.children[s.key(segment)]; node == nil {
+				break
+			}
+			if len(node.globs) > 0 {
+				candidates = append(slices.Clip(candidates), node.globs...)
+			}
+			if !found {
+				break
+			}
+			rest = next
+		}
+	}
+
+	// the candidates were copied when extended, restore the order of addition
+	if len(candidates) > len(s.root.globs) {
+		slices.Sort(candidates)
+	}
+
+	for _, index := range candidates {
+		if s.globs[index].Matches(value) {
+			return s.patterns[index], true
+		}
+	}
+	return "", false
+}
+
+// Matches returns whether the value matches one of the patterns of the set
+func (s *GlobSet) Matches(value string) bool {
+	_, found := s.Match(value)
+	return found
+}
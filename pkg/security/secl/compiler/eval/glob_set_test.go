@@ -0,0 +1,144 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestGlobSet(t *testing.T) {
+	// overlapping patterns, the first one added matching being returned
+	patterns := []string{
+		"/etc/shadow",
+		"/etc/ssh/*_key",
+		"/etc/**",
+		"/var/log/*/access.log",
+		"/var/log/*/*.log",
+		"/*/secrets/*",
+		"/usr/bin/",
+		"/usr/*/python*",
+	}
+
+	set := NewGlobSet(false)
+	for _, pattern := range patterns {
+		if err := set.Add(pattern); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if set.Len() != len(patterns) {
+		t.Errorf("expected %d patterns, got %d", len(patterns), set.Len())
+	}
+
+	tests := []struct {
+		value    string
+		expected string
+	}{
+		{value: "/etc/shadow", expected: "/etc/shadow"},
+		{value: "/etc/ssh/ssh_host_rsa_key", expected: "/etc/ssh/*_key"},
+		{value: "/etc/ssh/sshd_config", expected: "/etc/**"},
+		{value: "/etc/passwd", expected: "/etc/**"},
+		{value: "/var/log/nginx/access.log", expected: "/var/log/*/access.log"},
+		{value: "/var/log/nginx/error.log", expected: "/var/log/*/*.log"},
+		{value: "/etc/secrets/token", expected: "/etc/**"},
+		{value: "/opt/secrets/token", expected: "/*/secrets/*"},
+		{value: "/usr/bin/python3", expected: "/usr/*/python*"},
+		{value: "/usr/local/bin/python3", expected: ""},
+		{value: "/tmp/shadow", expected: ""},
+		{value: "/ETC/SHADOW", expected: ""},
+	}
+
+	for _, test := range tests {
+		pattern, found := set.Match(test.value)
+		if found != (test.expected != "") || pattern != test.expected {
+			t.Errorf("expected `%s` to match `%s`, got `%s` (%v)", test.value, test.expected, pattern, found)
+		}
+
+		// the result has to be the one of the sequential evaluation of the patterns
+		var sequential string
+		for _, p := range patterns {
+			if glob, _ := NewGlob(p, false, false); glob.Matches(test.value) {
+				sequential = p
+				break
+			}
+		}
+		if sequential != pattern {
+			t.Errorf("expected `%s` to match `%s` as the sequential evaluation, got `%s`", test.value, sequential, pattern)
+		}
+	}
+
+	t.Run("case-insensitive", func(t *testing.T) {
+		set := NewGlobSet(true)
+		for _, pattern := range patterns {
+			if err := set.Add(pattern); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if pattern, _ := set.Match("/ETC/SHADOW"); pattern != "/etc/shadow" {
+			t.Errorf("expected `/etc/shadow`, got `%s`", pattern)
+		}
+		if pattern, _ := set.Match("/Var/Log/nginx/ACCESS.LOG"); pattern != "/var/log/*/access.log" {
+			t.Errorf("expected `/var/log/*/access.log`, got `%s`", pattern)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if err := NewGlobSet(false).Add("/etc/**/shadow"); err == nil {
+			t.Error("expected an error for an invalid pattern")
+		}
+	})
+}
+
+func BenchmarkGlobSet(b *testing.B) {
+	var patterns []string
+	for i := 0; i != 1000; i++ {
+		patterns = append(patterns, fmt.Sprintf("/opt/vendor-%d/*/bin/*", i))
+	}
+	patterns = append(patterns, "/usr/lib/*/*.so")
+
+	paths := []string{"/opt/vendor-999/app/bin/server", "/usr/lib/x86_64-linux-gnu/libc.so", "/tmp/ls"}
+
+	b.Run("sequential", func(b *testing.B) {
+		var globs []*Glob
+		for _, pattern := range patterns {
+			glob, err := NewGlob(pattern, false, false)
+			if err != nil {
+				b.Fatal(err)
+			}
+			globs = append(globs, glob)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, path := range paths {
+				for _, glob := range globs {
+					if glob.Matches(path) {
+						break
+					}
+				}
+			}
+		}
+	})
+
+	b.Run("set", func(b *testing.B) {
+		set := NewGlobSet(false)
+		for _, pattern := range patterns {
+			if err := set.Add(pattern); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, path := range paths {
+				set.Match(path)
+			}
+		}
+	})
+}
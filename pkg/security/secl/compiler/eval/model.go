@@ -16,3 +16,11 @@ type Model interface {
 	// GetFieldRestrictions returns the event type for which the field is available
 	GetFieldRestrictions(field Field) []EventType
 }
+
+// IntFieldParser is implemented by the models accepting string literals as values of some of their int fields, the
+// literals being converted at compile time, ex: symbolic file modes
+type IntFieldParser interface {
+	// ParseIntField returns the int value of the string literal compared against the given field, false when the
+	// field doesn't accept string literals
+	ParseIntField(field Field, value string) (int, bool, error)
+}
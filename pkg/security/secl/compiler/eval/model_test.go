@@ -34,6 +34,8 @@ type testProcess struct {
 	list      *list.List
 	array     []*testItem
 	createdAt int64
+	score     float64
+	scores    []float64
 
 	// overridden values
 	orName        string
@@ -87,6 +89,18 @@ func (e *testEvent) GetTags() []string {
 	return []string{}
 }
 
+func (e *testEvent) GetFields() []Field {
+	return []Field{
+		"mkdir.filename", "mkdir.mode",
+		"network.cidr", "network.cidrs", "network.ip", "network.ips",
+		"open.filename", "open.flags", "open.mode", "open.opened_at",
+		"process.argv0", "process.array.flag", "process.array.key", "process.array.value", "process.created_at",
+		"process.gid", "process.is_root", "process.list.flag", "process.list.key", "process.list.value",
+		"process.name", "process.or_array.value", "process.or_name", "process.pid", "process.score", "process.scores", "process.uid",
+		"retval",
+	}
+}
+
 func (m *testModel) NewEvent() Event {
 	return &testEvent{}
 }
@@ -217,6 +231,20 @@ func (m *testModel) GetEvaluator(field Field, regID RegisterID) (Evaluator, erro
 			Field: field,
 		}, nil
 
+	case "process.score":
+
+		return &FloatEvaluator{
+			EvalFnc: func(ctx *Context) float64 { return ctx.Event.(*testEvent).process.score },
+			Field:   field,
+		}, nil
+
+	case "process.scores":
+
+		return &FloatArrayEvaluator{
+			EvalFnc: func(ctx *Context) []float64 { return ctx.Event.(*testEvent).process.scores },
+			Field:   field,
+		}, nil
+
 	case "process.is_root":
 
 		return &BoolEvaluator{
@@ -609,6 +637,14 @@ func (e *testEvent) GetFieldValue(field Field) (interface{}, error) {
 
 		return e.process.pid, nil
 
+	case "process.score":
+
+		return e.process.score, nil
+
+	case "process.scores":
+
+		return e.process.scores, nil
+
 	case "process.is_root":
 
 		return e.process.isRoot, nil
@@ -689,6 +725,14 @@ func (e *testEvent) GetFieldMetadata(field Field) (string, reflect.Kind, error)
 
 		return "", reflect.Int, nil
 
+	case "process.score":
+
+		return "", reflect.Float64, nil
+
+	case "process.scores":
+
+		return "", reflect.Float64, nil
+
 	case "process.is_root":
 
 		return "", reflect.Bool, nil
@@ -809,6 +853,16 @@ func (e *testEvent) SetFieldValue(field Field, value interface{}) error {
 		e.process.pid = value.(int)
 		return nil
 
+	case "process.score":
+
+		e.process.score = value.(float64)
+		return nil
+
+	case "process.scores":
+
+		e.process.scores = value.([]float64)
+		return nil
+
 	case "process.is_root":
 
 		e.process.isRoot = value.(bool)
@@ -0,0 +1,249 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+)
+
+// OperandType lists the types of the values the custom operators apply to
+type OperandType interface {
+	int | float64 | string | bool
+}
+
+// operatorCompileFnc compiles an operator applied to operands of the evaluator types it was registered with
+type operatorCompileFnc func(a interface{}, b interface{}, opts *Opts, state *State) (*BoolEvaluator, error)
+
+type operatorKey struct {
+	token string
+	left  reflect.Type
+	right reflect.Type
+}
+
+type operandsKey struct {
+	left  reflect.Type
+	right reflect.Type
+}
+
+var operatorTokenRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// OperatorRegistry maps the comparison operators to their implementations, keyed by the evaluator types of their
+// operands. The built-in operators of the SECL grammar are registered in a registry of their own, consulted before
+// the registry of the custom operators.
+type OperatorRegistry struct {
+	operators map[operatorKey]operatorCompileFnc
+	tokens    map[string]bool
+	operands  map[operandsKey]bool
+}
+
+// NewOperatorRegistry returns a new registry
+func NewOperatorRegistry() *OperatorRegistry {
+	return &OperatorRegistry{
+		operators: make(map[operatorKey]operatorCompileFnc),
+		tokens:    make(map[string]bool),
+		operands:  make(map[operandsKey]bool),
+	}
+}
+
+func (r *OperatorRegistry) register(token string, left reflect.Type, right reflect.Type, fnc operatorCompileFnc) error {
+	key := operatorKey{token: token, left: left, right: right}
+	if _, exists := r.operators[key]; exists {
+		return &ErrOperatorConflict{Token: token}
+	}
+
+	r.operators[key] = fnc
+	r.tokens[token] = true
+	r.operands[operandsKey{left: left, right: right}] = true
+
+	return nil
+}
+
+// lookup returns the implementation of the operator applied to the given evaluators, and whether an operator is
+// registered for the evaluator types of the operands, when the token isn't
+func (r *OperatorRegistry) lookup(token string, a interface{}, b interface{}) (operatorCompileFnc, bool) {
+	if r == nil {
+		return nil, false
+	}
+
+	left, right := reflect.TypeOf(a), reflect.TypeOf(b)
+	if fnc := r.operators[operatorKey{token: token, left: left, right: right}]; fnc != nil {
+		return fnc, true
+	}
+	return nil, r.operands[operandsKey{left: left, right: right}]
+}
+
+// RegisterOperator adds a custom operator applying to operands of types A and B. When an operand is an array, the
+// operator matches if it matches for any of its values. The token has to be an identifier, and can't redefine a
+// built-in operator or a keyword of the grammar, or an operator already registered for the same types of operands.
+func RegisterOperator[A, B OperandType](r *OperatorRegistry, token string, fnc func(a A, b B) bool) error {
+	if builtinOperators.tokens[token] || ast.IsKeyword(token) {
+		return &ErrOperatorConflict{Token: token}
+	}
+
+	if !operatorTokenRegex.MatchString(token) {
+		return fmt.Errorf("invalid operator `%s`: not an identifier", token)
+	}
+
+	if fnc == nil {
+		return fmt.Errorf("invalid operator `%s`: no implementation", token)
+	}
+
+	leftScalar, leftArray := operandEvaluatorTypes[A]()
+	rightScalar, rightArray := operandEvaluatorTypes[B]()
+
+	for _, key := range []operatorKey{
+		{token: token, left: leftScalar, right: rightScalar},
+		{token: token, left: leftScalar, right: rightArray},
+		{token: token, left: leftArray, right: rightScalar},
+		{token: token, left: leftArray, right: rightArray},
+	} {
+		if _, exists := r.operators[key]; exists {
+			return &ErrOperatorConflict{Token: token}
+		}
+	}
+
+	compile := customOperator(fnc)
+	for _, left := range []reflect.Type{leftScalar, leftArray} {
+		for _, right := range []reflect.Type{rightScalar, rightArray} {
+			if err := r.register(token, left, right, compile); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// operandEvaluatorTypes returns the types of the scalar and array evaluators of T values
+func operandEvaluatorTypes[T OperandType]() (reflect.Type, reflect.Type) {
+	var value T
+	switch any(value).(type) {
+	case int:
+		return reflect.TypeOf(&IntEvaluator{}), reflect.TypeOf(&IntArrayEvaluator{})
+	case float64:
+		return reflect.TypeOf(&FloatEvaluator{}), reflect.TypeOf(&FloatArrayEvaluator{})
+	case string:
+		return reflect.TypeOf(&StringEvaluator{}), reflect.TypeOf(&StringArrayEvaluator{})
+	default:
+		return reflect.TypeOf(&BoolEvaluator{}), reflect.TypeOf(&BoolArrayEvaluator{})
+	}
+}
+
+// operand gives access to the values of an operand of a custom operator, either a scalar or an array
+type operand[T OperandType] struct {
+	weight int
+	// value returns the value of a scalar operand
+	value func(ctx *Context) T
+	// values returns the values of an array operand
+	values func(ctx *Context) []T
+}
+
+// newOperand returns the operand of a custom operator matching the given evaluator, of one of the types returned by
+// operandEvaluatorTypes for T
+func newOperand[T OperandType](evaluator interface{}) *operand[T] {
+	switch e := evaluator.(type) {
+	case *IntEvaluator:
+		return scalarOperand(any(e.EvalFnc).(func(ctx *Context) T), any(e.Value).(T), e.Weight)
+	case *FloatEvaluator:
+		return scalarOperand(any(e.EvalFnc).(func(ctx *Context) T), any(e.Value).(T), e.Weight)
+	case *StringEvaluator:
+		return scalarOperand(any(e.EvalFnc).(func(ctx *Context) T), any(e.Value).(T), e.Weight)
+	case *BoolEvaluator:
+		return scalarOperand(any(e.EvalFnc).(func(ctx *Context) T), any(e.Value).(T), e.Weight)
+	case *IntArrayEvaluator:
+		return arrayOperand(any(e.EvalFnc).(func(ctx *Context) []T), any(e.Values).([]T), e.Weight)
+	case *FloatArrayEvaluator:
+		return arrayOperand(any(e.EvalFnc).(func(ctx *Context) []T), any(e.Values).([]T), e.Weight)
+	case *StringArrayEvaluator:
+		return arrayOperand(any(e.EvalFnc).(func(ctx *Context) []T), any(e.Values).([]T), e.Weight)
+	case *BoolArrayEvaluator:
+		return arrayOperand(any(e.EvalFnc).(func(ctx *Context) []T), any(e.Values).([]T), e.Weight)
+	}
+	return nil
+}
+
+func scalarOperand[T OperandType](evalFnc func(ctx *Context) T, value T, weight int) *operand[T] {
+	if evalFnc == nil {
+		evalFnc = func(_ *Context) T { return value }
+	}
+	return &operand[T]{weight: weight, value: evalFnc}
+}
+
+func arrayOperand[T OperandType](evalFnc func(ctx *Context) []T, values []T, weight int) *operand[T] {
+	if evalFnc == nil {
+		evalFnc = func(_ *Context) []T { return values }
+	}
+	return &operand[T]{weight: weight, values: evalFnc}
+}
+
+// customOperator returns the compile function of a custom operator. When an operand is an array, the operator
+// matches if it matches for any of its values.
+func customOperator[A, B OperandType](fnc func(a A, b B) bool) operatorCompileFnc {
+	return func(a interface{}, b interface{}, _ *Opts, state *State) (*BoolEvaluator, error) {
+		ea, eb := newOperand[A](a), newOperand[B](b)
+
+		var evalFnc BoolEvalFnc
+		switch {
+		case ea.value != nil && eb.value != nil:
+			evalFnc = func(ctx *Context) bool {
+				return fnc(ea.value(ctx), eb.value(ctx))
+			}
+		case ea.value != nil:
+			evalFnc = func(ctx *Context) bool {
+				aValue := ea.value(ctx)
+				for _, bValue := range eb.values(ctx) {
+					if fnc(aValue, bValue) {
+						return true
+					}
+				}
+				return false
+			}
+		case eb.value != nil:
+			evalFnc = func(ctx *Context) bool {
+				bValue := eb.value(ctx)
+				for _, aValue := range ea.values(ctx) {
+					if fnc(aValue, bValue) {
+						return true
+					}
+				}
+				return false
+			}
+		default:
+			evalFnc = func(ctx *Context) bool {
+				bValues := eb.values(ctx)
+				for _, aValue := range ea.values(ctx) {
+					for _, bValue := range bValues {
+						if fnc(aValue, bValue) {
+							return true
+						}
+					}
+				}
+				return false
+			}
+		}
+
+		ev, evb := a.(Evaluator), b.(Evaluator)
+		isDc := isArithmDeterministic(ev, evb, state)
+
+		if ev.IsStatic() && evb.IsStatic() {
+			return &BoolEvaluator{
+				Value:           evalFnc(nil),
+				isDeterministic: isDc,
+			}, nil
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          ea.weight + eb.weight,
+			isDeterministic: isDc,
+		}, nil
+	}
+}
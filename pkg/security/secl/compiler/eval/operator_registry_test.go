@@ -0,0 +1,107 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestOperatorRegistry(t *testing.T) {
+	registry := NewOperatorRegistry()
+
+	divisibleBy := func(a int, b int) bool {
+		return b != 0 && a%b == 0
+	}
+	if err := RegisterOperator(registry, "divisible_by", divisibleBy); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := RegisterOperator(registry, "ends_with", strings.HasSuffix); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := newOptsWithParams(testConstants, nil).WithOperators(registry)
+
+	event := &testEvent{
+		process: testProcess{
+			name:  "bash",
+			pid:   42,
+			array: []*testItem{{value: "/bin/sh"}, {value: "/usr/bin/zsh"}},
+		},
+	}
+
+	tests := []struct {
+		Expr     string
+		Expected bool
+	}{
+		{Expr: `process.pid divisible_by 7`, Expected: true},
+		{Expr: `process.pid divisible_by 5`, Expected: false},
+		{Expr: `process.pid divisible_by 0`, Expected: false},
+		{Expr: `process.pid divisible_by 7 && process.name == "bash"`, Expected: true},
+		{Expr: `process.name == "bash" and process.pid divisible_by 5`, Expected: false},
+		{Expr: `!(process.pid divisible_by 5)`, Expected: true},
+		{Expr: `process.name ends_with "sh"`, Expected: true},
+		{Expr: `process.array.value ends_with "zsh"`, Expected: true},
+		{Expr: `process.array.value ends_with "csh"`, Expected: false},
+	}
+
+	for _, test := range tests {
+		rule, err := parseRule(test.Expr, &testModel{}, opts)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result := rule.Eval(NewContext(event)); result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t` for `%s`", test.Expected, result, test.Expr)
+		}
+	}
+
+	t.Run("unknown-operator", func(t *testing.T) {
+		if _, err := parseRule(`process.pid multiple_of 7`, &testModel{}, opts); err == nil {
+			t.Error("expected an error for an operator not registered")
+		}
+	})
+
+	t.Run("wrong-operand-kinds", func(t *testing.T) {
+		if _, err := parseRule(`process.name divisible_by 7`, &testModel{}, opts); err == nil {
+			t.Error("expected an error for an operator not registered for the kinds of its operands")
+		}
+	})
+
+	t.Run("no-registry", func(t *testing.T) {
+		if _, err := parseRule(`process.pid divisible_by 7`, &testModel{}, newOptsWithParams(testConstants, nil)); err == nil {
+			t.Error("expected an error for a custom operator without registry")
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		var conflict *ErrOperatorConflict
+
+		for _, token := range []string{"==", "fullmatch", "in", "and", "divisible_by"} {
+			err := RegisterOperator(registry, token, divisibleBy)
+			if !errors.As(err, &conflict) {
+				t.Errorf("expected a conflict error when redefining `%s`, got %v", token, err)
+			}
+		}
+
+		// the same token can be registered for other types of operands
+		if err := RegisterOperator(registry, "divisible_by", func(a string, b int) bool { return b != 0 && len(a)%b == 0 }); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		if err := RegisterOperator(registry, "not an identifier", divisibleBy); err == nil {
+			t.Error("expected an error for an invalid token")
+		}
+		if err := RegisterOperator[int, int](registry, "nil_op", nil); err == nil {
+			t.Error("expected an error for an operator without implementation")
+		}
+	})
+}
@@ -7,6 +7,7 @@
 package eval
 
 import (
+	"errors"
 	"net"
 	"strings"
 )
@@ -383,6 +384,84 @@ func StringEquals(a *StringEvaluator, b *StringEvaluator, state *State) (*BoolEv
 	}, nil
 }
 
+// stringCompare evaluates the lexical ordering of two strings with strings.Compare, cmp being applied to the result.
+// Like StringExists, it doesn't provide any value to the approvers.
+func stringCompare(a *StringEvaluator, b *StringEvaluator, cmp func(int) bool, state *State) (*BoolEvaluator, error) {
+	isDc := isArithmDeterministic(a, b, state)
+
+	if (a.EvalFnc == nil && a.ValueType != ScalarValueType) || (b.EvalFnc == nil && b.ValueType != ScalarValueType) {
+		return nil, errors.New("only scalar strings can be ordered")
+	}
+
+	if a.EvalFnc != nil && b.EvalFnc != nil {
+		ea, eb := a.EvalFnc, b.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return cmp(strings.Compare(ea(ctx), eb(ctx)))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight + b.Weight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	if a.EvalFnc == nil && b.EvalFnc == nil {
+		return &BoolEvaluator{
+			Value:           cmp(strings.Compare(a.Value, b.Value)),
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	if a.EvalFnc != nil {
+		ea, eb := a.EvalFnc, b.Value
+
+		evalFnc := func(ctx *Context) bool {
+			return cmp(strings.Compare(ea(ctx), eb))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	ea, eb := a.Value, b.EvalFnc
+
+	evalFnc := func(ctx *Context) bool {
+		return cmp(strings.Compare(ea, eb(ctx)))
+	}
+
+	return &BoolEvaluator{
+		EvalFnc:         evalFnc,
+		Weight:          b.Weight,
+		isDeterministic: isDc,
+	}, nil
+}
+
+// StringGreaterThan evaluates whether a string is lexically greater than another one. The ordering is the byte-wise
+// lexical one, not a semantic versioning one, ex: "10.0" < "9.0"
+func StringGreaterThan(a *StringEvaluator, b *StringEvaluator, state *State) (*BoolEvaluator, error) {
+	return stringCompare(a, b, func(r int) bool { return r > 0 }, state)
+}
+
+// StringGreaterOrEqualThan evaluates whether a string is lexically greater than or equal to another one
+func StringGreaterOrEqualThan(a *StringEvaluator, b *StringEvaluator, state *State) (*BoolEvaluator, error) {
+	return stringCompare(a, b, func(r int) bool { return r >= 0 }, state)
+}
+
+// StringLesserThan evaluates whether a string is lexically lesser than another one
+func StringLesserThan(a *StringEvaluator, b *StringEvaluator, state *State) (*BoolEvaluator, error) {
+	return stringCompare(a, b, func(r int) bool { return r < 0 }, state)
+}
+
+// StringLesserOrEqualThan evaluates whether a string is lexically lesser than or equal to another one
+func StringLesserOrEqualThan(a *StringEvaluator, b *StringEvaluator, state *State) (*BoolEvaluator, error) {
+	return stringCompare(a, b, func(r int) bool { return r <= 0 }, state)
+}
+
 // Not !true operator
 func Not(a *BoolEvaluator, state *State) *BoolEvaluator {
 	isDc := a.IsDeterministicFor(state.field)
@@ -412,6 +491,66 @@ func Not(a *BoolEvaluator, state *State) *BoolEvaluator {
 	}
 }
 
+// StringExists evaluates whether a string value is not empty. Unlike `!= ""`, it doesn't provide any value to the
+// approvers.
+func StringExists(a *StringEvaluator, state *State) *BoolEvaluator {
+	isDc := a.IsDeterministicFor(state.field)
+
+	if a.EvalFnc != nil {
+		ea := a.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return ea(ctx) != ""
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight,
+			isDeterministic: isDc,
+		}
+	}
+
+	return &BoolEvaluator{
+		Value:           a.Value != "",
+		Weight:          a.Weight,
+		isDeterministic: isDc,
+	}
+}
+
+// StringArrayExists evaluates whether an array of strings contains a value that is not empty
+func StringArrayExists(a *StringArrayEvaluator, state *State) *BoolEvaluator {
+	isDc := a.IsDeterministicFor(state.field)
+
+	op := func(values []string) bool {
+		for _, value := range values {
+			if value != "" {
+				return true
+			}
+		}
+		return false
+	}
+
+	if a.EvalFnc != nil {
+		ea := a.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return op(ea(ctx))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight,
+			isDeterministic: isDc,
+		}
+	}
+
+	return &BoolEvaluator{
+		Value:           op(a.Values),
+		Weight:          a.Weight,
+		isDeterministic: isDc,
+	}
+}
+
 // Minus -int operator
 func Minus(a *IntEvaluator, state *State) *IntEvaluator {
 	isDc := a.IsDeterministicFor(state.field)
@@ -437,6 +576,253 @@ func Minus(a *IntEvaluator, state *State) *IntEvaluator {
 	}
 }
 
+// intBitmaskCompare evaluates a bitmask comparison, a zero value type meaning that the values of the other operand
+// can't be used to approve the field of an operand
+func intBitmaskCompare(a *IntEvaluator, b *IntEvaluator, state *State, aValueType, bValueType FieldValueType, bitmaskOp func(a int, b int) bool) (*BoolEvaluator, error) {
+	isDc := isArithmDeterministic(a, b, state)
+
+	if a.Field != "" && aValueType != 0 {
+		if err := state.UpdateFieldValues(a.Field, FieldValue{Value: b.Value, Type: aValueType}); err != nil {
+			return nil, err
+		}
+	}
+
+	if b.Field != "" && bValueType != 0 {
+		if err := state.UpdateFieldValues(b.Field, FieldValue{Value: a.Value, Type: bValueType}); err != nil {
+			return nil, err
+		}
+	}
+
+	if a.EvalFnc != nil && b.EvalFnc != nil {
+		ea, eb := a.EvalFnc, b.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return bitmaskOp(ea(ctx), eb(ctx))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight + b.Weight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	if a.EvalFnc == nil && b.EvalFnc == nil {
+		return &BoolEvaluator{
+			Value:           bitmaskOp(a.Value, b.Value),
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	if a.EvalFnc != nil {
+		ea, eb := a.EvalFnc, b.Value
+
+		evalFnc := func(ctx *Context) bool {
+			return bitmaskOp(ea(ctx), eb)
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Field:           a.Field,
+			Weight:          a.Weight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	ea, eb := a.Value, b.EvalFnc
+
+	evalFnc := func(ctx *Context) bool {
+		return bitmaskOp(ea, eb(ctx))
+	}
+
+	return &BoolEvaluator{
+		EvalFnc:         evalFnc,
+		Field:           b.Field,
+		Weight:          b.Weight,
+		isDeterministic: isDc,
+	}, nil
+}
+
+// IntSubset evaluates whether all the bits of a are set in b
+func IntSubset(a *IntEvaluator, b *IntEvaluator, state *State) (*BoolEvaluator, error) {
+	// a can have no bit set at all, only b can be approved
+	return intBitmaskCompare(a, b, state, 0, BitmaskValueType, func(a int, b int) bool {
+		return a&^b == 0
+	})
+}
+
+// IntSuperset evaluates whether all the bits of b are set in a
+func IntSuperset(a *IntEvaluator, b *IntEvaluator, state *State) (*BoolEvaluator, error) {
+	return intBitmaskCompare(a, b, state, BitmaskValueType, 0, func(a int, b int) bool {
+		return a&b == b
+	})
+}
+
+// IntIntersects evaluates whether a and b have at least one bit set in common
+func IntIntersects(a *IntEvaluator, b *IntEvaluator, state *State) (*BoolEvaluator, error) {
+	return intBitmaskCompare(a, b, state, BitmaskValueType, BitmaskValueType, func(a int, b int) bool {
+		return a&b != 0
+	})
+}
+
+// StringBloomFilterContains evaluates whether a value is possibly in a bloom filter, a negative answer being certain
+// while a positive one can be a false positive
+func StringBloomFilterContains(a *StringEvaluator, filter *BloomFilter, state *State) (*BoolEvaluator, error) {
+	isDc := a.IsDeterministicFor(state.field)
+
+	if a.EvalFnc != nil {
+		ea := a.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return filter.MayContain(ea(ctx))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight + BloomFilterWeight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	return &BoolEvaluator{
+		Value:           filter.MayContain(a.Value),
+		Weight:          a.Weight + BloomFilterWeight,
+		isDeterministic: isDc,
+	}, nil
+}
+
+// StringArrayBloomFilterContains evaluates whether one of the values of an array is possibly in a bloom filter
+func StringArrayBloomFilterContains(a *StringArrayEvaluator, filter *BloomFilter, state *State) (*BoolEvaluator, error) {
+	isDc := a.IsDeterministicFor(state.field)
+
+	op := func(values []string) bool {
+		for _, value := range values {
+			if filter.MayContain(value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if a.EvalFnc != nil {
+		ea := a.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return op(ea(ctx))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight + BloomFilterWeight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	return &BoolEvaluator{
+		Value:           op(a.Values),
+		Weight:          a.Weight + BloomFilterWeight,
+		isDeterministic: isDc,
+	}, nil
+}
+
+// StringGlobSetMatches evaluates whether a value matches one of the patterns of a glob set
+func StringGlobSetMatches(a *StringEvaluator, set *GlobSet, state *State) (*BoolEvaluator, error) {
+	return stringMatches(a, set.Matches, GlobSetWeight, state)
+}
+
+// StringArrayGlobSetMatches evaluates whether one of the values of an array matches one of the patterns of a glob set
+func StringArrayGlobSetMatches(a *StringArrayEvaluator, set *GlobSet, state *State) (*BoolEvaluator, error) {
+	return stringArrayMatches(a, set.Matches, GlobSetWeight, state)
+}
+
+// StringGitIgnoreMatches evaluates whether a path is matched by the patterns of a gitignore matcher
+func StringGitIgnoreMatches(a *StringEvaluator, matcher *GitIgnoreMatcher, state *State) (*BoolEvaluator, error) {
+	return stringMatches(a, matcher.Matches, GitIgnoreWeight, state)
+}
+
+// StringArrayGitIgnoreMatches evaluates whether one of the paths of an array is matched by the patterns of a gitignore
+// matcher
+func StringArrayGitIgnoreMatches(a *StringArrayEvaluator, matcher *GitIgnoreMatcher, state *State) (*BoolEvaluator, error) {
+	return stringArrayMatches(a, matcher.Matches, GitIgnoreWeight, state)
+}
+
+// StringSetContains evaluates whether a value is in a string set, counting the matches of its entries
+func StringSetContains(a *StringEvaluator, set *StringSet, state *State) (*BoolEvaluator, error) {
+	if a.EvalFnc == nil {
+		// a static value is not a match of the set, it's only evaluated once while compiling the rule
+		return stringMatches(a, set.Contains, StringSetWeight, state)
+	}
+	return stringMatches(a, set.Match, StringSetWeight, state)
+}
+
+// StringArraySetContains evaluates whether one of the values of an array is in a string set, counting the matches of
+// its entries
+func StringArraySetContains(a *StringArrayEvaluator, set *StringSet, state *State) (*BoolEvaluator, error) {
+	if a.EvalFnc == nil {
+		return stringArrayMatches(a, set.Contains, StringSetWeight, state)
+	}
+	return stringArrayMatches(a, set.Match, StringSetWeight, state)
+}
+
+// stringMatches evaluates whether a value is matched by the given function
+func stringMatches(a *StringEvaluator, matches func(string) bool, weight int, state *State) (*BoolEvaluator, error) {
+	isDc := a.IsDeterministicFor(state.field)
+
+	if a.EvalFnc != nil {
+		ea := a.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return matches(ea(ctx))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight + weight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	return &BoolEvaluator{
+		Value:           matches(a.Value),
+		Weight:          a.Weight + weight,
+		isDeterministic: isDc,
+	}, nil
+}
+
+// stringArrayMatches evaluates whether one of the values of an array is matched by the given function
+func stringArrayMatches(a *StringArrayEvaluator, matches func(string) bool, weight int, state *State) (*BoolEvaluator, error) {
+	isDc := a.IsDeterministicFor(state.field)
+
+	op := func(values []string) bool {
+		for _, value := range values {
+			if matches(value) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if a.EvalFnc != nil {
+		ea := a.EvalFnc
+
+		evalFnc := func(ctx *Context) bool {
+			return op(ea(ctx))
+		}
+
+		return &BoolEvaluator{
+			EvalFnc:         evalFnc,
+			Weight:          a.Weight + weight,
+			isDeterministic: isDc,
+		}, nil
+	}
+
+	return &BoolEvaluator{
+		Value:           op(a.Values),
+		Weight:          a.Weight + weight,
+		isDeterministic: isDc,
+	}, nil
+}
+
 // StringArrayContains evaluates array of strings against a value
 func StringArrayContains(a *StringEvaluator, b *StringArrayEvaluator, state *State) (*BoolEvaluator, error) {
 	isDc := isArithmDeterministic(a, b, state)
@@ -67,12 +67,36 @@ func (s *VariableStore) Get(name string) VariableValue {
 	return s.Variables[name]
 }
 
+// Predicate returns the evaluator of a predicate called in a rule with the given string literal arguments
+type Predicate func(args []string) (*BoolEvaluator, error)
+
 // Opts are the options to be passed to the evaluator
 type Opts struct {
 	LegacyFields  map[Field]Field
 	Constants     map[string]interface{}
 	VariableStore *VariableStore
 	MacroStore    *MacroStore
+	// StringToIntCoercion enables the conversion of the string literals compared against int fields
+	StringToIntCoercion bool
+	// Tracing enables the recording of the results of the leaf predicates in the trace of the context
+	Tracing bool
+	// BloomFilters are the bloom filters that can be used with the `in_bloom` operator, by name
+	BloomFilters map[string]*BloomFilter
+	// GlobSets are the sets of glob patterns that can be used with the `in_globs` operator, by name
+	GlobSets map[string]*GlobSet
+	// StringSets are the hash sets of strings that can be used with the `in_set` operator, by name
+	StringSets map[string]*StringSet
+	// GitIgnoreMatchers are the matchers of gitignore patterns that can be used with the `in_gitignore` operator, by name
+	GitIgnoreMatchers map[string]*GitIgnoreMatcher
+	// Predicates are the predicates that can be called in the rules, like `has_env("LD_PRELOAD")`, by name
+	Predicates map[string]Predicate
+	// Operators is the registry of the custom operators that can be used in the rules
+	Operators *OperatorRegistry
+	// ResultCaching enables the caching of the results of the leaf predicates shared by several rules, for the
+	// evaluation of one event
+	ResultCaching bool
+
+	resultCacheScope *resultCacheScope
 }
 
 // WithConstants set constants
@@ -105,6 +129,38 @@ func (o *Opts) WithLegacyFields(fields map[Field]Field) *Opts {
 	return o
 }
 
+// WithPredicates set the predicates
+func (o *Opts) WithPredicates(predicates map[string]Predicate) *Opts {
+	o.Predicates = predicates
+	return o
+}
+
+// WithStringToIntCoercion enables or disables the coercion of the string literals compared against int fields
+func (o *Opts) WithStringToIntCoercion(enabled bool) *Opts {
+	o.StringToIntCoercion = enabled
+	return o
+}
+
+// WithTracing enables or disables the tracing of the leaf predicates, rules compiled without tracing having no
+// tracing overhead
+func (o *Opts) WithTracing(enabled bool) *Opts {
+	o.Tracing = enabled
+	return o
+}
+
+// WithOperators set the registry of the custom operators
+func (o *Opts) WithOperators(registry *OperatorRegistry) *Opts {
+	o.Operators = registry
+	return o
+}
+
+// WithResultCaching enables or disables the caching of the results of the leaf predicates shared by several rules.
+// The results are cached in the context, and dropped once it's reset or set to another event.
+func (o *Opts) WithResultCaching(enabled bool) *Opts {
+	o.ResultCaching = enabled
+	return o
+}
+
 // WithMacroStore set the macro store
 func (o *Opts) WithMacroStore(store *MacroStore) *Opts {
 	o.MacroStore = store
@@ -128,3 +184,39 @@ func (o *Opts) AddVariable(name string, variable VariableValue) *Opts {
 	o.VariableStore.Add(name, variable)
 	return o
 }
+
+// AddBloomFilter add a bloom filter usable with the `in_bloom` operator
+func (o *Opts) AddBloomFilter(name string, filter *BloomFilter) *Opts {
+	if o.BloomFilters == nil {
+		o.BloomFilters = make(map[string]*BloomFilter)
+	}
+	o.BloomFilters[name] = filter
+	return o
+}
+
+// AddGlobSet add a set of glob patterns usable with the `in_globs` operator
+func (o *Opts) AddGlobSet(name string, set *GlobSet) *Opts {
+	if o.GlobSets == nil {
+		o.GlobSets = make(map[string]*GlobSet)
+	}
+	o.GlobSets[name] = set
+	return o
+}
+
+// AddGitIgnoreMatcher add a matcher of gitignore patterns usable with the `in_gitignore` operator
+func (o *Opts) AddGitIgnoreMatcher(name string, matcher *GitIgnoreMatcher) *Opts {
+	if o.GitIgnoreMatchers == nil {
+		o.GitIgnoreMatchers = make(map[string]*GitIgnoreMatcher)
+	}
+	o.GitIgnoreMatchers[name] = matcher
+	return o
+}
+
+// AddStringSet add a hash set of strings usable with the `in_set` operator
+func (o *Opts) AddStringSet(name string, set *StringSet) *Opts {
+	if o.StringSets == nil {
+		o.StringSets = make(map[string]*StringSet)
+	}
+	o.StringSets[name] = set
+	return o
+}
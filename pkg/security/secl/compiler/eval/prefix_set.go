@@ -0,0 +1,92 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"strings"
+)
+
+type pathPrefixNode struct {
+	children map[string]*pathPrefixNode
+	terminal bool
+}
+
+// PathPrefixSet matches paths against a set of directory prefixes, in a time proportional to the length of the path
+// instead of the number of prefixes. A path matches a prefix when it is located below the prefix directory, the same
+// way as the `/prefix/**` glob pattern.
+type PathPrefixSet struct {
+	root pathPrefixNode
+	size int
+}
+
+// NewPathPrefixSet returns a new set with the given directory prefixes
+func NewPathPrefixSet(prefixes ...string) *PathPrefixSet {
+	var set PathPrefixSet
+	for _, prefix := range prefixes {
+		set.Add(prefix)
+	}
+	return &set
+}
+
+// Add adds a directory prefix to the set, a trailing `/` being ignored
+func (p *PathPrefixSet) Add(prefix string) {
+	node := &p.root
+	for _, segment := range strings.Split(strings.Trim(prefix, "/"), "/") {
+		child := node.children[segment]
+		if child == nil {
+			if node.children == nil {
+				node.children = make(map[string]*pathPrefixNode)
+			}
+			child = &pathPrefixNode{}
+			node.children[segment] = child
+		}
+		node = child
+	}
+
+	if !node.terminal {
+		node.terminal = true
+		p.size++
+	}
+}
+
+// Len returns the number of prefixes of the set
+func (p *PathPrefixSet) Len() int {
+	return p.size
+}
+
+// Matches returns whether the path is located below one of the prefixes of the set
+func (p *PathPrefixSet) Matches(path string) bool {
+	if len(path) == 0 || path[0] != '/' {
+		return false
+	}
+
+	node := &p.root
+	for rest := path[1:]; ; {
+		segment, next, found := strings.Cut(rest, "/")
+		if node.terminal && segment != "" {
+			return true
+		}
+		if !found {
+			return false
+		}
+
+		if node = node.children[segment]; node == nil {
+			return false
+		}
+		rest = next
+	}
+}
+
+// pathPrefixFromGlob returns the directory prefix of a `/prefix/**` glob pattern, if the pattern has this form and
+// doesn't contain any other wildcard
+func pathPrefixFromGlob(pattern string) (string, bool) {
+	prefix, found := strings.CutSuffix(pattern, "/**")
+	if !found || len(prefix) < 2 || prefix[0] != '/' || strings.Contains(prefix, "*") || strings.Contains(prefix, "//") {
+		return "", false
+	}
+	return prefix, true
+}
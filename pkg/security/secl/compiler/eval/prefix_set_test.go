@@ -0,0 +1,168 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+)
+
+func TestPathPrefixSet(t *testing.T) {
+	prefixes := []string{"/usr", "/usr/local/bin", "/opt/datadog-agent/embedded/bin", "/var/lib/docker/"}
+	set := NewPathPrefixSet(prefixes...)
+
+	if set.Len() != 4 {
+		t.Errorf("expected 4 prefixes, got %d", set.Len())
+	}
+
+	tests := []struct {
+		path     string
+		expected bool
+	}{
+		{path: "/usr/bin/ls", expected: true},
+		{path: "/usr/local/bin/python3", expected: true},
+		{path: "/usr/local", expected: true},
+		{path: "/usr", expected: false},
+		{path: "/usr/", expected: false},
+		{path: "/usrbin/ls", expected: false},
+		{path: "/opt/datadog-agent/embedded/bin/python", expected: true},
+		{path: "/opt/datadog-agent/embedded/lib/libssl.so", expected: false},
+		{path: "/opt/datadog-agent/embedded/bin", expected: false},
+		{path: "/var/lib/docker/overlay2/merged/bin/sh", expected: true},
+		{path: "/var/lib/dockerd", expected: false},
+		{path: "/bin/sh", expected: false},
+		{path: "usr/bin/ls", expected: false},
+		{path: "", expected: false},
+	}
+
+	for _, test := range tests {
+		if result := set.Matches(test.path); result != test.expected {
+			t.Errorf("expected `%s` to match: %v, got %v", test.path, test.expected, result)
+		}
+
+		// the set has to behave as the equivalent list of globs
+		var globMatches bool
+		for _, prefix := range prefixes {
+			glob, err := NewGlob(strings.TrimSuffix(prefix, "/")+"/**", false, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			globMatches = globMatches || glob.Matches(test.path)
+		}
+		if globMatches != test.expected {
+			t.Errorf("expected globs to match `%s`: %v, got %v", test.path, test.expected, globMatches)
+		}
+	}
+}
+
+func TestPathPrefixSetValues(t *testing.T) {
+	model := &testModel{}
+	opts := newOptsWithParams(make(map[string]interface{}), nil)
+
+	// large allowlists can be registered as macros
+	macro, err := NewMacro(
+		"allowed_dirs",
+		`[ ~"/usr/**", ~"/opt/*/bin/**", ~"/usr/local/bin/**", "/bin/sh" ]`,
+		model,
+		ast.NewParsingContext(false),
+		opts,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts.MacroStore.Add(macro)
+
+	tests := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "/usr/bin/ls", expected: true},
+		{name: "/usr/local/bin/python3", expected: true},
+		{name: "/opt/datadog-agent/bin/agent", expected: true},
+		{name: "/opt/datadog-agent/lib/agent", expected: false},
+		{name: "/bin/sh", expected: true},
+		{name: "/bin/bash", expected: false},
+		{name: "/usr", expected: false},
+	}
+
+	for _, expr := range []string{`process.name in allowed_dirs`, `process.name in [~"/usr/**", ~"/opt/*/bin/**", ~"/usr/local/bin/**", "/bin/sh"]`} {
+		rule, err := parseRule(expr, model, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, test := range tests {
+			ctx := NewContext(&testEvent{process: testProcess{name: test.name}})
+			if result := rule.Eval(ctx); result != test.expected {
+				t.Errorf("expected `%s` to be %v for `%s`, got %v", expr, test.expected, test.name, result)
+			}
+		}
+	}
+}
+
+func BenchmarkPathPrefixSet(b *testing.B) {
+	var prefixes []string
+	for i := 0; i != 2000; i++ {
+		prefixes = append(prefixes, fmt.Sprintf("/opt/vendor-%d/app/bin", i))
+	}
+
+	paths := []string{"/opt/vendor-1999/app/bin/server", "/usr/bin/ls"}
+
+	b.Run("linear", func(b *testing.B) {
+		var globs []*Glob
+		for _, prefix := range prefixes {
+			glob, err := NewGlob(prefix+"/**", false, false)
+			if err != nil {
+				b.Fatal(err)
+			}
+			globs = append(globs, glob)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, path := range paths {
+				for _, glob := range globs {
+					if glob.Matches(path) {
+						break
+					}
+				}
+			}
+		}
+	})
+
+	b.Run("linear-prefix", func(b *testing.B) {
+		dirs := make([]string, 0, len(prefixes))
+		for _, prefix := range prefixes {
+			dirs = append(dirs, prefix+"/")
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, path := range paths {
+				for _, dir := range dirs {
+					if strings.HasPrefix(path, dir) {
+						break
+					}
+				}
+			}
+		}
+	})
+
+	b.Run("trie", func(b *testing.B) {
+		set := NewPathPrefixSet(prefixes...)
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			for _, path := range paths {
+				set.Matches(path)
+			}
+		}
+	})
+}
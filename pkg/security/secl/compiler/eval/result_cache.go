@@ -0,0 +1,251 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+)
+
+// resultCacheScope holds the identifiers of the cached predicates of the rules compiled with the same options, usually
+// the ones of a rule set. The identifiers are released with the options, once the rule set is replaced on reload.
+type resultCacheScope struct {
+	ids map[string]int
+}
+
+// resultCacheKey identifies a cached predicate in the context. The scope is part of the key, so that the rules compiled
+// with different options, and evaluated with the same context, never share a result for different predicates.
+type resultCacheKey struct {
+	scope *resultCacheScope
+	id    int
+}
+
+// resultCacheKey returns the key under which the result of the given leaf predicate is cached, identical predicates
+// of the rules compiled with these options sharing the same key. Only the predicates whose result depends on nothing
+// but the event are cached: the ones using iterator registers or variables, whose values change between the rules,
+// are not.
+func (o *Opts) resultCacheKey(obj *ast.Comparison) (resultCacheKey, bool) {
+	key, ok := o.comparisonKey(obj)
+	if !ok {
+		return resultCacheKey{}, false
+	}
+
+	if o.resultCacheScope == nil {
+		o.resultCacheScope = &resultCacheScope{ids: make(map[string]int)}
+	}
+	scope := o.resultCacheScope
+
+	id, exists := scope.ids[key]
+	if !exists {
+		id = len(scope.ids)
+		scope.ids[key] = id
+	}
+	return resultCacheKey{scope: scope, id: id}, true
+}
+
+// comparisonKey returns a key identifying a leaf predicate, a field optionally compared to a static operand. The
+// identifiers are resolved with the options, so that the key doesn't depend on the options the rule is compiled with.
+func (o *Opts) comparisonKey(obj *ast.Comparison) (string, bool) {
+	field := comparisonField(obj)
+	if field == "" {
+		return "", false
+	}
+
+	var sb strings.Builder
+	if !o.writeIdentKey(&sb, field) {
+		return "", false
+	}
+
+	switch {
+	case obj.ScalarComparison != nil:
+		primary := comparisonPrimary(obj.ScalarComparison.Next)
+		if primary == nil {
+			return "", false
+		}
+		sb.WriteString(" " + *obj.ScalarComparison.Op + " ")
+		if !o.writePrimaryKey(&sb, primary) {
+			return "", false
+		}
+	case obj.ArrayComparison != nil:
+		sb.WriteString(" " + *obj.ArrayComparison.Op + " ")
+		if !o.writeArrayKey(&sb, obj.ArrayComparison.Array) {
+			return "", false
+		}
+	case obj.CustomComparison != nil:
+		// the implementation of a custom operator may not be deterministic
+		return "", false
+	}
+
+	return sb.String(), true
+}
+
+// writeIdentKey writes the key of an identifier: the value of a static constant, or the name of a field, legacy
+// names being replaced by the current ones. Macros, defined per set of options, and iterators aren't keyed.
+func (o *Opts) writeIdentKey(sb *strings.Builder, ident string) bool {
+	if strings.ContainsAny(ident, "[]") || o.MacroStore.Contains(ident) {
+		return false
+	}
+
+	if constant, ok := o.Constants[ident]; ok {
+		switch constant := constant.(type) {
+		case *BoolEvaluator:
+			if constant.EvalFnc != nil {
+				return false
+			}
+			sb.WriteString(strconv.FormatBool(constant.Value))
+		case *IntEvaluator:
+			if constant.EvalFnc != nil {
+				return false
+			}
+			sb.WriteString(strconv.Itoa(constant.Value))
+		case *StringEvaluator:
+			if constant.EvalFnc != nil || constant.ValueType != ScalarValueType {
+				return false
+			}
+			sb.WriteString(strconv.Quote(constant.Value))
+		default:
+			return false
+		}
+		return true
+	}
+
+	if newField, ok := o.LegacyFields[ident]; ok {
+		ident = newField
+	}
+	sb.WriteString(ident)
+	return true
+}
+
+// comparisonPrimary returns the operand of a comparison when it's a single primary
+func comparisonPrimary(obj *ast.Comparison) *ast.Primary {
+	if obj == nil || obj.ScalarComparison != nil || obj.ArrayComparison != nil || obj.CustomComparison != nil {
+		return nil
+	}
+	if obj.ArithmeticOperation == nil || len(obj.ArithmeticOperation.Rest) != 0 {
+		return nil
+	}
+
+	bitOperation := obj.ArithmeticOperation.First
+	if bitOperation == nil || bitOperation.Op != nil || bitOperation.Unary == nil || bitOperation.Unary.Op != nil {
+		return nil
+	}
+	return bitOperation.Unary.Primary
+}
+
+func (o *Opts) writePrimaryKey(sb *strings.Builder, obj *ast.Primary) bool {
+	switch {
+	case obj.Ident != nil:
+		if !o.writeIdentKey(sb, *obj.Ident) {
+			return false
+		}
+	case obj.Number != nil:
+		sb.WriteString(strconv.Itoa(*obj.Number))
+	case obj.Duration != nil:
+		sb.WriteString(strconv.Itoa(*obj.Duration) + "ns")
+	case obj.String != nil:
+		if variableRegex.MatchString(*obj.String) {
+			return false
+		}
+		sb.WriteString(strconv.Quote(*obj.String))
+	case obj.Pattern != nil:
+		sb.WriteString("~" + strconv.Quote(*obj.Pattern))
+	case obj.Regexp != nil:
+		sb.WriteString("r" + strconv.Quote(*obj.Regexp))
+	case obj.IP != nil:
+		sb.WriteString(*obj.IP)
+	case obj.CIDR != nil:
+		sb.WriteString(*obj.CIDR)
+	default:
+		return false
+	}
+	return true
+}
+
+func (o *Opts) writeArrayKey(sb *strings.Builder, obj *ast.Array) bool {
+	switch {
+	case obj.CIDR != nil:
+		sb.WriteString(*obj.CIDR)
+	case obj.Ident != nil:
+		if !o.writeIdentKey(sb, *obj.Ident) {
+			return false
+		}
+	case len(obj.StringMembers) != 0:
+		sb.WriteString("[")
+		for _, member := range obj.StringMembers {
+			switch {
+			case member.String != nil:
+				if variableRegex.MatchString(*member.String) {
+					return false
+				}
+				sb.WriteString(strconv.Quote(*member.String))
+			case member.Pattern != nil:
+				sb.WriteString("~" + strconv.Quote(*member.Pattern))
+			case member.Regexp != nil:
+				sb.WriteString("r" + strconv.Quote(*member.Regexp))
+			}
+			sb.WriteString(",")
+		}
+		sb.WriteString("]")
+	case len(obj.CIDRMembers) != 0:
+		sb.WriteString("[")
+		for _, member := range obj.CIDRMembers {
+			if member.IP != nil {
+				sb.WriteString(*member.IP)
+			} else if member.CIDR != nil {
+				sb.WriteString(*member.CIDR)
+			}
+			sb.WriteString(",")
+		}
+		sb.WriteString("]")
+	case len(obj.Numbers) != 0:
+		sb.WriteString("[")
+		for _, number := range obj.Numbers {
+			sb.WriteString(strconv.Itoa(number) + ",")
+		}
+		sb.WriteString("]")
+	case len(obj.Idents) != 0:
+		sb.WriteString("[")
+		for _, ident := range obj.Idents {
+			if !o.writeIdentKey(sb, ident) {
+				return false
+			}
+			sb.WriteString(",")
+		}
+		sb.WriteString("]")
+	default:
+		// variables
+		return false
+	}
+	return true
+}
+
+// cacheComparison wraps the evaluator of a leaf predicate so that its result is computed once per event, and then
+// shared by all the rules using the same predicate. The cache is held by the context, and dropped when the context
+// is reset or set to another event.
+func cacheComparison(key resultCacheKey, evaluator *BoolEvaluator) *BoolEvaluator {
+	if evaluator.EvalFnc == nil {
+		return evaluator
+	}
+
+	cached := *evaluator
+	evalFnc := evaluator.EvalFnc
+	cached.EvalFnc = func(ctx *Context) bool {
+		if result, ok := ctx.resultCache[key]; ok {
+			return result
+		}
+
+		result := evalFnc(ctx)
+		if ctx.resultCache == nil {
+			ctx.resultCache = make(map[resultCacheKey]bool)
+		}
+		ctx.resultCache[key] = result
+		return result
+	}
+	return &cached
+}
@@ -0,0 +1,228 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+)
+
+func TestResultCache(t *testing.T) {
+	opts := newOptsWithParams(testConstants, nil).WithResultCaching(true)
+
+	var rules []*Rule
+	for _, expr := range []string{
+		`process.name == "/usr/bin/cat" && process.uid == 1000`,
+		`process.name == "/usr/bin/cat" && process.uid == 0`,
+		`process.name == "/usr/bin/cat"`,
+	} {
+		rule, err := parseRule(expr, &testModel{}, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rules = append(rules, rule)
+	}
+
+	evalRules := func(ctx *Context) []bool {
+		var results []bool
+		for _, rule := range rules {
+			results = append(results, rule.Eval(ctx))
+		}
+		return results
+	}
+
+	event := &testEvent{
+		process: testProcess{
+			name: "/usr/bin/cat",
+			uid:  1000,
+		},
+	}
+
+	ctx := NewContext(event)
+	if results := evalRules(ctx); fmt.Sprint(results) != "[true false true]" {
+		t.Fatalf("unexpected results: %v", results)
+	}
+
+	t.Run("shared-within-event", func(t *testing.T) {
+		// the cached result of the shared predicate is used for the current event
+		event.process.name = "/usr/bin/vi"
+		if result := rules[2].Eval(ctx); !result {
+			t.Error("expected the cached result of the predicate")
+		}
+	})
+
+	t.Run("new-event", func(t *testing.T) {
+		ctx.SetEvent(event)
+		if results := evalRules(ctx); fmt.Sprint(results) != "[false false false]" {
+			t.Errorf("unexpected results once the event is set: %v", results)
+		}
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		event.process.name = "/usr/bin/cat"
+		event.process.uid = 0
+
+		ctx.Reset()
+		ctx.SetEvent(event)
+		if results := evalRules(ctx); fmt.Sprint(results) != "[false true true]" {
+			t.Errorf("unexpected results once the context is reset: %v", results)
+		}
+	})
+
+	t.Run("per-context", func(t *testing.T) {
+		// another rule set evaluating the same event, mutated in between, with its own context
+		event.process.name = "/usr/bin/vi"
+		if result := rules[2].Eval(NewContext(event)); result {
+			t.Error("expected the result to be computed for the mutated event")
+		}
+	})
+
+	t.Run("partial", func(t *testing.T) {
+		event.process.name = "/usr/bin/cat"
+		ctx := NewContext(event)
+
+		result, err := rules[2].PartialEval(ctx, "process.name")
+		if err != nil || !result {
+			t.Fatalf("unexpected partial result: %v, %v", result, err)
+		}
+
+		// partials are evaluated against events mutated in place
+		event.process.name = "/usr/bin/vi"
+		result, err = rules[2].PartialEval(ctx, "process.name")
+		if err != nil || result {
+			t.Errorf("unexpected partial result for the mutated event: %v, %v", result, err)
+		}
+	})
+
+	t.Run("not-cached", func(t *testing.T) {
+		for _, expr := range []string{
+			`process.name == "${str}"`,
+			`process.list[A].key == 10 && process.list[A].value == "AAA"`,
+			`process.uid + 1 == 1001`,
+		} {
+			rule, err := NewRule("id", expr, ast.NewParsingContext(false), opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, comparison := range []*ast.Comparison{rule.ast.BooleanExpression.Expression.Comparison} {
+				if key, ok := opts.comparisonKey(comparison); ok {
+					t.Errorf("unexpected cache key `%s` for `%s`", key, expr)
+				}
+			}
+		}
+	})
+}
+
+func TestResultCacheSharedContext(t *testing.T) {
+	// two rule sets compiled with their own options, and evaluated with the same context
+	newRule := func(expr string, constants map[string]interface{}) *Rule {
+		t.Helper()
+
+		rule, err := parseRule(expr, &testModel{}, newOptsWithParams(constants, nil).WithResultCaching(true))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rule
+	}
+
+	event := &testEvent{
+		process: testProcess{
+			name: "/usr/bin/cat",
+			uid:  1000,
+		},
+	}
+
+	t.Run("different-predicates", func(t *testing.T) {
+		first := newRule(`process.name == "/usr/bin/cat"`, nil)
+		second := newRule(`process.uid == 0`, nil)
+
+		ctx := NewContext(event)
+		if !first.Eval(ctx) {
+			t.Error("expected the first rule to match")
+		}
+		if second.Eval(ctx) {
+			t.Error("expected the second rule not to use the result of the first one")
+		}
+	})
+
+	t.Run("different-constants", func(t *testing.T) {
+		first := newRule(`process.uid == TEST_UID`, map[string]interface{}{"TEST_UID": &IntEvaluator{Value: 1000}})
+		second := newRule(`process.uid == TEST_UID`, map[string]interface{}{"TEST_UID": &IntEvaluator{Value: 0}})
+
+		ctx := NewContext(event)
+		if !first.Eval(ctx) {
+			t.Error("expected the first rule to match")
+		}
+		if second.Eval(ctx) {
+			t.Error("expected the second rule not to use the result of the first one")
+		}
+	})
+
+	t.Run("same-predicate", func(t *testing.T) {
+		first := newRule(`process.name == "/usr/bin/cat"`, nil)
+		second := newRule(`process.name == "/usr/bin/cat" && process.uid == 1000`, nil)
+
+		ctx := NewContext(event)
+		if !first.Eval(ctx) {
+			t.Error("expected the first rule to match")
+		}
+
+		// the results are cached per set of options, the second rule computes its own
+		event.process.name = "/usr/bin/vi"
+		defer func() { event.process.name = "/usr/bin/cat" }()
+		if second.Eval(ctx) {
+			t.Error("expected the second rule not to use the result cached for the first one")
+		}
+	})
+}
+
+func BenchmarkResultCache(b *testing.B) {
+	event := &testEvent{
+		process: testProcess{
+			name: "/usr/bin/ls",
+			uid:  1,
+		},
+	}
+
+	for _, caching := range []bool{false, true} {
+		b.Run(fmt.Sprintf("caching-%t", caching), func(b *testing.B) {
+			opts := newOptsWithParams(nil, nil).WithResultCaching(caching)
+
+			var rules []*Rule
+			for i := 0; i != 100; i++ {
+				expr := fmt.Sprintf(`process.name =~ "/usr/*/l*" && process.name in [~"/usr/bin/*", ~"/usr/sbin/*"] && process.uid == %d`, i)
+
+				rule, err := parseRule(expr, &testModel{}, opts)
+				if err != nil {
+					b.Fatal(err)
+				}
+				rules = append(rules, rule)
+			}
+
+			ctx := NewContext(nil)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ctx.Reset()
+				ctx.SetEvent(event)
+
+				var matches int
+				for _, rule := range rules {
+					if rule.Eval(ctx) {
+						matches++
+					}
+				}
+				if matches != 1 {
+					b.Fatalf("unexpected matches: %d", matches)
+				}
+			}
+		})
+	}
+}
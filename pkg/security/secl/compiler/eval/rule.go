@@ -44,8 +44,9 @@ type RuleEvaluator struct {
 	Eval      BoolEvalFnc
 	EventType EventType
 
-	fieldValues map[Field][]FieldValue
-	fields      []Field
+	fieldValues  map[Field][]FieldValue
+	fields       []Field
+	legacyFields []Field
 
 	partialEvals map[Field]BoolEvalFnc
 
@@ -154,6 +155,14 @@ func (r *Rule) GetFields() []Field {
 	return fields
 }
 
+// GetLegacyFields returns the legacy fields used by the expression of the Rule, as resolved when it was compiled
+func (r *Rule) GetLegacyFields() []Field {
+	if r.evaluator == nil {
+		return nil
+	}
+	return r.evaluator.legacyFields
+}
+
 // GetPprofLabels returns the pprof labels
 func (r *Rule) GetPprofLabels() utils.LabelSet {
 	return r.pprofLabels
@@ -271,11 +280,12 @@ func NewRuleEvaluator(rule *ast.Rule, model Model, opts *Opts) (*RuleEvaluator,
 	}
 
 	return &RuleEvaluator{
-		Eval:        evalBool.EvalFnc,
-		EventType:   eventType,
-		fieldValues: state.fieldValues,
-		fields:      KeysOfMap(state.fieldValues),
-		registers:   state.registers,
+		Eval:         evalBool.EvalFnc,
+		EventType:    eventType,
+		fieldValues:  state.fieldValues,
+		fields:       KeysOfMap(state.fieldValues),
+		legacyFields: state.legacyFields,
+		registers:    state.registers,
 	}, nil
 }
 
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+// EventTypeRuleIndex indexes compiled rules by the event type they apply to. It allows to discard the rules that can
+// never match an event knowing only its type, the subsets being computed once when the index is built.
+type EventTypeRuleIndex struct {
+	byEventType map[EventType][]*Rule
+	anyEvent    []*Rule
+}
+
+// NewEventTypeRuleIndex returns a new index of the given compiled rules. The rules whose fields apply to any event
+// type, like the process fields, are considered for all the event types.
+func NewEventTypeRuleIndex(rules ...*Rule) (*EventTypeRuleIndex, error) {
+	index := &EventTypeRuleIndex{
+		byEventType: make(map[EventType][]*Rule),
+	}
+
+	var eventTypes []EventType
+	for _, rule := range rules {
+		eventType, err := rule.GetEventType()
+		if err != nil {
+			return nil, err
+		}
+
+		if eventType == "" {
+			index.anyEvent = append(index.anyEvent, rule)
+			for _, evt := range eventTypes {
+				index.byEventType[evt] = append(index.byEventType[evt], rule)
+			}
+			continue
+		}
+
+		if _, exists := index.byEventType[eventType]; !exists {
+			eventTypes = append(eventTypes, eventType)
+			// the rules applying to any event type added so far, kept in their order
+			index.byEventType[eventType] = append([]*Rule{}, index.anyEvent...)
+		}
+		index.byEventType[eventType] = append(index.byEventType[eventType], rule)
+	}
+
+	return index, nil
+}
+
+// GetRules returns the rules that may match an event of the given type
+func (i *EventTypeRuleIndex) GetRules(eventType EventType) []*Rule {
+	if rules, exists := i.byEventType[eventType]; exists {
+		return rules
+	}
+	return i.anyEvent
+}
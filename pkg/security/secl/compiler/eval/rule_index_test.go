@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+)
+
+func TestEventTypeRuleIndex(t *testing.T) {
+	exprs := map[string]string{
+		"open_1":  `open.filename == "/etc/passwd"`,
+		"process": `process.name == "/usr/bin/cat"`,
+		"mkdir":   `mkdir.filename == "/tmp/test"`,
+		"open_2":  `open.flags & O_CREAT > 0 && process.uid == 0`,
+	}
+
+	var rules []*Rule
+	for _, id := range []string{"open_1", "process", "mkdir", "open_2"} {
+		rule, err := NewRule(id, exprs[id], ast.NewParsingContext(false), newOptsWithParams(testConstants, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := rule.GenEvaluator(&testModel{}); err != nil {
+			t.Fatal(err)
+		}
+		rules = append(rules, rule)
+	}
+
+	index, err := NewEventTypeRuleIndex(rules...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ruleIDs := func(rules []*Rule) []string {
+		var ids []string
+		for _, rule := range rules {
+			ids = append(ids, rule.ID)
+		}
+		return ids
+	}
+
+	for eventType, expected := range map[EventType][]string{
+		"open":    {"open_1", "process", "open_2"},
+		"mkdir":   {"process", "mkdir"},
+		"network": {"process"},
+	} {
+		if ids := ruleIDs(index.GetRules(eventType)); !slices.Equal(ids, expected) {
+			t.Errorf("expected the rules of `%s` to be %v, got %v", eventType, expected, ids)
+		}
+	}
+
+	t.Run("not-compiled", func(t *testing.T) {
+		rule, err := NewRule("not_compiled", `open.filename == "/etc/passwd"`, ast.NewParsingContext(false), newOptsWithParams(testConstants, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewEventTypeRuleIndex(rule); err == nil {
+			t.Error("expected an error for a rule not compiled")
+		}
+	})
+}
@@ -8,6 +8,7 @@ package eval
 
 import (
 	"regexp"
+	"slices"
 )
 
 // StateRegexpCache is used to cache regexps used in the rule compilation process
@@ -24,6 +25,9 @@ type State struct {
 	macros      map[MacroID]*MacroEvaluator
 	regexpCache StateRegexpCache
 	registers   []Register
+	indexes     []Register
+	// legacyFields lists the legacy fields used, each of them once
+	legacyFields []Field
 }
 
 // UpdateFields updates the fields used in the rule
@@ -33,6 +37,13 @@ func (s *State) UpdateFields(field Field) {
 	}
 }
 
+// UpdateLegacyFields records the use of a legacy field
+func (s *State) UpdateLegacyFields(field Field) {
+	if !slices.Contains(s.legacyFields, field) {
+		s.legacyFields = append(s.legacyFields, field)
+	}
+}
+
 // UpdateFieldValues updates the field values
 func (s *State) UpdateFieldValues(field Field, value FieldValue) error {
 	values := s.fieldValues[field]
@@ -51,6 +62,14 @@ func (s *State) UpdateFieldValues(field Field, value FieldValue) error {
 	return s.model.ValidateField(field, value)
 }
 
+// HasRegister returns whether a register or an index is used, so far in the compilation, on the given iterator field
+func (s *State) HasRegister(itField Field) bool {
+	isItField := func(r Register) bool {
+		return r.Field == itField
+	}
+	return slices.ContainsFunc(s.registers, isItField) || slices.ContainsFunc(s.indexes, isItField)
+}
+
 // NewState returns a new State
 func NewState(model Model, field Field, macros map[MacroID]*MacroEvaluator) *State {
 	if macros == nil {
@@ -0,0 +1,68 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"sync/atomic"
+)
+
+// StringSet is a hash set of strings, used to match the values against large lists of exact values in constant time,
+// like a list of known bad process names. The matches of each entry are counted, so that the entries actually firing
+// can be reported.
+type StringSet struct {
+	entries map[string]*atomic.Uint64
+}
+
+// NewStringSet returns a new set holding the given values
+func NewStringSet(values ...string) *StringSet {
+	s := &StringSet{
+		entries: make(map[string]*atomic.Uint64, len(values)),
+	}
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
+
+// Add adds a value to the set. The values have to be added before the set is used by the rules.
+func (s *StringSet) Add(value string) {
+	if _, exists := s.entries[value]; !exists {
+		s.entries[value] = &atomic.Uint64{}
+	}
+}
+
+// Len returns the number of values of the set
+func (s *StringSet) Len() int {
+	return len(s.entries)
+}
+
+// Contains returns whether the value is in the set, without counting it as a match
+func (s *StringSet) Contains(value string) bool {
+	_, exists := s.entries[value]
+	return exists
+}
+
+// Match returns whether the value is in the set, counting it as a match of its entry
+func (s *StringSet) Match(value string) bool {
+	hits, exists := s.entries[value]
+	if exists {
+		hits.Add(1)
+	}
+	return exists
+}
+
+// FlushHits returns the number of matches of each entry since the last flush, the entries without any match being
+// omitted
+func (s *StringSet) FlushHits() map[string]uint64 {
+	hits := make(map[string]uint64)
+	for value, count := range s.entries {
+		if n := count.Swap(0); n > 0 {
+			hits[value] = n
+		}
+	}
+	return hits
+}
@@ -0,0 +1,144 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"testing"
+)
+
+func knownBadNames(count int) []string {
+	var names []string
+	for i := 0; i != count; i++ {
+		names = append(names, fmt.Sprintf("miner-%d", i))
+	}
+	return names
+}
+
+func TestStringSet(t *testing.T) {
+	set := NewStringSet(knownBadNames(1000)...)
+	set.Add("miner-0")
+
+	if set.Len() != 1000 {
+		t.Errorf("expected 1000 values, got %d", set.Len())
+	}
+
+	if !set.Match("miner-999") {
+		t.Error("expected `miner-999` to be in the set")
+	}
+	if set.Match("bash") {
+		t.Error("expected `bash` not to be in the set")
+	}
+	if !set.Match("miner-999") || !set.Contains("miner-42") {
+		t.Error("expected `miner-999` and `miner-42` to be in the set")
+	}
+
+	// only the matches are counted, not the lookups with Contains
+	if hits := set.FlushHits(); !maps.Equal(hits, map[string]uint64{"miner-999": 2}) {
+		t.Errorf("unexpected hits: %v", hits)
+	}
+	if hits := set.FlushHits(); len(hits) != 0 {
+		t.Errorf("expected the hits to be flushed, got %v", hits)
+	}
+}
+
+func TestStringSetOperator(t *testing.T) {
+	set := NewStringSet(knownBadNames(1000)...)
+	opts := newOptsWithParams(testConstants, nil).AddStringSet("known_bad", set)
+
+	tests := []struct {
+		Expr     string
+		Name     string
+		Expected bool
+	}{
+		{Expr: `process.name in_set "known_bad"`, Name: "miner-512", Expected: true},
+		{Expr: `process.name in_set "known_bad"`, Name: "miner-1000", Expected: false},
+		{Expr: `!(process.name in_set "known_bad")`, Name: "bash", Expected: true},
+		{Expr: `process.array.value in_set "known_bad"`, Name: "bash", Expected: true},
+	}
+
+	for _, test := range tests {
+		event := &testEvent{
+			process: testProcess{
+				name:  test.Name,
+				array: []*testItem{{value: "sh"}, {value: "miner-7"}},
+			},
+		}
+
+		rule, err := parseRule(test.Expr, &testModel{}, opts)
+		if err != nil {
+			t.Fatalf("error while evaluating `%s`: %s", test.Expr, err)
+		}
+
+		if result := rule.Eval(NewContext(event)); result != test.Expected {
+			t.Errorf("expected result `%t` not found, got `%t` for `%s` with `%s`", test.Expected, result, test.Expr, test.Name)
+		}
+	}
+
+	if hits := set.FlushHits(); !maps.Equal(hits, map[string]uint64{"miner-512": 1, "miner-7": 1}) {
+		t.Errorf("unexpected hits: %v", hits)
+	}
+
+	t.Run("static", func(t *testing.T) {
+		rule, err := parseRule(`"miner-1" in_set "known_bad"`, &testModel{}, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rule.Eval(NewContext(&testEvent{})) {
+			t.Error("expected `miner-1` to be in the set")
+		}
+		if hits := set.FlushHits(); len(hits) != 0 {
+			t.Errorf("expected a static value not to be counted, got %v", hits)
+		}
+	})
+
+	t.Run("not-registered", func(t *testing.T) {
+		if _, err := parseRule(`process.name in_set "unknown"`, &testModel{}, opts); err == nil {
+			t.Error("expected an error for a string set not registered")
+		}
+	})
+}
+
+func BenchmarkStringSet(b *testing.B) {
+	names := knownBadNames(1000)
+	event := &testEvent{
+		process: testProcess{
+			name: "bash",
+		},
+	}
+
+	var comparisons []string
+	for _, name := range names {
+		comparisons = append(comparisons, fmt.Sprintf(`process.name == "%s"`, name))
+	}
+
+	exprs := map[string]string{
+		"or":     strings.Join(comparisons, " || "),
+		"in_set": `process.name in_set "known_bad"`,
+	}
+
+	for name, expr := range exprs {
+		b.Run(name, func(b *testing.B) {
+			opts := newOptsWithParams(nil, nil).AddStringSet("known_bad", NewStringSet(names...))
+
+			rule, err := parseRule(expr, &testModel{}, opts)
+			if err != nil {
+				b.Fatal(err)
+			}
+			evaluator := rule.GetEvaluator()
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if evaluator.Eval(NewContext(event)) {
+					b.Fatal("unexpected result")
+				}
+			}
+		})
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"regexp"
 	"slices"
 	"strings"
+
+	lru "github.com/hashicorp/golang-lru/v2"
 )
 
 // StringCmpOpts defines options to apply during string comparison
@@ -47,11 +49,19 @@ func (s *StringValues) AppendFieldValue(value FieldValue) {
 
 // Compile all the values
 func (s *StringValues) Compile(opts StringCmpOpts) error {
+	var prefixes *PathPrefixSet
+
 	for _, value := range s.fieldValues {
 		// fast path for scalar value without specific comparison behavior
 		if opts == DefaultStringCmpOpts && value.Type == ScalarValueType {
 			str := value.Value.(string)
 			s.scalars = append(s.scalars, str)
+		} else if prefix, ok := s.pathPrefix(value, opts); ok {
+			// `/prefix/**` globs are all matched at once
+			if prefixes == nil {
+				prefixes = NewPathPrefixSet()
+			}
+			prefixes.Add(prefix)
 		} else {
 			str, ok := value.Value.(string)
 			if !ok {
@@ -66,9 +76,24 @@ func (s *StringValues) Compile(opts StringCmpOpts) error {
 		}
 	}
 
+	if prefixes != nil {
+		s.stringMatchers = append(s.stringMatchers, prefixes)
+	}
+
 	return nil
 }
 
+func (s *StringValues) pathPrefix(value FieldValue, opts StringCmpOpts) (string, bool) {
+	if opts != DefaultStringCmpOpts || value.Type != GlobValueType {
+		return "", false
+	}
+	str, ok := value.Value.(string)
+	if !ok {
+		return "", false
+	}
+	return pathPrefixFromGlob(str)
+}
+
 // GetScalarValues return the scalar values
 func (s *StringValues) GetScalarValues() []string {
 	return s.scalars
@@ -125,6 +150,36 @@ type RegexpStringMatcher struct {
 
 var stringBigOrRe = regexp.MustCompile(`^(?:\.\*)?\(([a-zA-Z_|]+)\)(?:\.\*)?$`)
 
+// maxCompiledRegexps is the maximum number of compiled regular expressions kept in the cache
+const maxCompiledRegexps = 1024
+
+// compiledRegexps caches the compiled regular expressions, the same expressions being often used by several rules.
+// The expressions come from the policies, the least recently used ones are evicted to bound the cache.
+var compiledRegexps *lru.Cache[string, *regexp.Regexp]
+
+func init() {
+	compiledRegexps, _ = lru.New[string, *regexp.Regexp](maxCompiledRegexps)
+}
+
+func compileRegexp(pattern string) (*regexp.Regexp, error) {
+	if re, ok := compiledRegexps.Get(pattern); ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	compiledRegexps.Add(pattern, re)
+
+	return re, nil
+}
+
+// AnchorRegexp returns the given regular expression anchored so that it matches only full values
+func AnchorRegexp(pattern string) string {
+	return "^(?:" + pattern + ")$"
+}
+
 // Compile a regular expression based pattern
 func (r *RegexpStringMatcher) Compile(pattern string, caseInsensitive bool) error {
 	if !caseInsensitive {
@@ -139,7 +194,7 @@ func (r *RegexpStringMatcher) Compile(pattern string, caseInsensitive bool) erro
 		pattern = "(?i)" + pattern
 	}
 
-	re, err := regexp.Compile(pattern)
+	re, err := compileRegexp(pattern)
 	if err != nil {
 		return err
 	}
@@ -276,6 +276,34 @@ func BenchmarkRegexpEvaluator(b *testing.B) {
 		}
 	})
 
+	b.Run("anchored", func(b *testing.B) {
+		var matcher RegexpStringMatcher
+		if err := matcher.Compile(AnchorRegexp("(ba)?sh"), false); err != nil {
+			b.Fatal(err)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if !matcher.Matches("bash") {
+				b.Fatal("unexpected result")
+			}
+		}
+	})
+
+	b.Run("unanchored", func(b *testing.B) {
+		var matcher RegexpStringMatcher
+		if err := matcher.Compile("(ba)?sh", false); err != nil {
+			b.Fatal(err)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			if !matcher.Matches("bash") {
+				b.Fatal("unexpected result")
+			}
+		}
+	})
+
 	b.Run("without stars", func(b *testing.B) {
 		pattern := "(restore|recovery|readme|instruction|how_to|ransom)"
 
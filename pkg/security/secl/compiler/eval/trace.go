@@ -0,0 +1,82 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+)
+
+// TraceEntry holds the result of the evaluation of a leaf predicate of a rule
+type TraceEntry struct {
+	Field    Field
+	Operator string
+	Result   bool
+}
+
+// Trace is a buffer, provided by the caller through the context, in which the evaluation of the leaf predicates of
+// the rules compiled with tracing enabled are recorded, in their evaluation order
+type Trace struct {
+	Entries []TraceEntry
+}
+
+// Reset empties the trace, keeping the allocated buffer
+func (t *Trace) Reset() {
+	t.Entries = t.Entries[:0]
+}
+
+// comparisonField returns the field on which a comparison applies, when it's a simple identifier
+func comparisonField(obj *ast.Comparison) Field {
+	if obj.ArithmeticOperation == nil || len(obj.ArithmeticOperation.Rest) != 0 {
+		return ""
+	}
+
+	bitOperation := obj.ArithmeticOperation.First
+	if bitOperation == nil || bitOperation.Op != nil || bitOperation.Unary == nil {
+		return ""
+	}
+
+	if primary := bitOperation.Unary.Primary; primary != nil && primary.Ident != nil {
+		return *primary.Ident
+	}
+	return ""
+}
+
+// traceComparison wraps the evaluator of a leaf predicate so that its results are recorded in the trace of the
+// context. Only the dynamic predicates are traced, the static ones being resolved at compile time.
+func traceComparison(obj *ast.Comparison, evaluator *BoolEvaluator) *BoolEvaluator {
+	if evaluator.EvalFnc == nil {
+		return evaluator
+	}
+
+	var operator string
+	if obj.ScalarComparison != nil {
+		operator = *obj.ScalarComparison.Op
+	} else if obj.ArrayComparison != nil {
+		operator = *obj.ArrayComparison.Op
+	} else if obj.CustomComparison != nil {
+		operator = *obj.CustomComparison.Op
+	} else if comparisonField(obj) == "" {
+		// not a leaf predicate, the sub expressions are traced on their own
+		return evaluator
+	}
+
+	field := evaluator.Field
+	if field == "" {
+		field = comparisonField(obj)
+	}
+
+	traced := *evaluator
+	evalFnc := evaluator.EvalFnc
+	traced.EvalFnc = func(ctx *Context) bool {
+		result := evalFnc(ctx)
+		if ctx.Trace != nil {
+			ctx.Trace.Entries = append(ctx.Trace.Entries, TraceEntry{Field: field, Operator: operator, Result: result})
+		}
+		return result
+	}
+	return &traced
+}
@@ -0,0 +1,98 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package eval holds eval related files
+package eval
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	event := &testEvent{
+		process: testProcess{
+			name: "/usr/bin/cat",
+			uid:  1000,
+		},
+		open: testOpen{
+			filename: "/etc/shadow",
+		},
+	}
+
+	tests := []struct {
+		expr     string
+		expected bool
+		trace    []TraceEntry
+	}{
+		{
+			expr:     `process.name == "/usr/bin/cat" && process.uid == 1000`,
+			expected: true,
+			trace: []TraceEntry{
+				{Field: "process.name", Operator: "==", Result: true},
+				{Field: "process.uid", Operator: "==", Result: true},
+			},
+		},
+		{
+			expr:     `(process.name == "/usr/bin/vi" && process.uid == 0) || open.filename in [ "/etc/passwd", "/etc/shadow" ]`,
+			expected: true,
+			trace: []TraceEntry{
+				{Field: "process.name", Operator: "==", Result: false},
+				{Field: "open.filename", Operator: "in", Result: true},
+			},
+		},
+		{
+			expr:     `process.uid != 1000 or (open.filename =~ "/etc/*" and not process.is_root)`,
+			expected: true,
+			trace: []TraceEntry{
+				{Field: "process.uid", Operator: "!=", Result: false},
+				{Field: "open.filename", Operator: "=~", Result: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			opts := newOptsWithParams(testConstants, nil).WithTracing(true)
+
+			rule, err := parseRule(test.expr, &testModel{}, opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := NewContext(event)
+			ctx.Trace = &Trace{}
+
+			if result := rule.Eval(ctx); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+
+			if !reflect.DeepEqual(ctx.Trace.Entries, test.trace) {
+				t.Errorf("unexpected trace for `%s`: %+v", test.expr, ctx.Trace.Entries)
+			}
+
+			// no trace buffer, no recording
+			ctx = NewContext(event)
+			if result := rule.Eval(ctx); result != test.expected {
+				t.Errorf("expected `%s` to be %v without trace, got %v", test.expr, test.expected, result)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		rule, err := parseRule(tests[0].expr, &testModel{}, newOptsWithParams(testConstants, nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := NewContext(event)
+		ctx.Trace = &Trace{}
+		rule.Eval(ctx)
+
+		if len(ctx.Trace.Entries) != 0 {
+			t.Errorf("expected no trace entry, got %+v", ctx.Trace.Entries)
+		}
+	})
+}
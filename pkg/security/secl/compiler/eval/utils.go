@@ -63,3 +63,53 @@ func KeysOfMap[M ~map[K]V, K comparable, V any](m M) []K {
 	}
 	return r
 }
+
+// SuggestField returns the field of the given list the closest to the given unknown field, or an empty string if
+// none is close enough to be a plausible typo
+func SuggestField(field Field, fields []Field) Field {
+	// allow roughly one edit every four characters, up to three
+	maxDistance := min(max(len(field)/4, 1), 3)
+
+	var suggestion Field
+	bestDistance := maxDistance + 1
+	for _, candidate := range fields {
+		if distance := editDistance(field, candidate, bestDistance); distance < bestDistance {
+			suggestion, bestDistance = candidate, distance
+		}
+	}
+
+	return suggestion
+}
+
+// editDistance returns the Levenshtein distance between a and b. The computation stops as soon as the
+// distance is known to be at least limit, in which case limit is returned.
+func editDistance(a, b string, limit int) int {
+	if diff := len(a) - len(b); diff >= limit || -diff >= limit {
+		return limit
+	}
+
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		rowMin := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			rowMin = min(rowMin, curr[j])
+		}
+		if rowMin >= limit {
+			return limit
+		}
+		prev, curr = curr, prev
+	}
+
+	return min(prev[len(b)], limit)
+}
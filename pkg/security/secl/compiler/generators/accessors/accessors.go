@@ -112,7 +112,7 @@ func isNetType(kind string) bool {
 
 func isBasicType(kind string) bool {
 	switch kind {
-	case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64", "net.IPNet":
+	case "string", "bool", "int", "int8", "int16", "int32", "int64", "uint8", "uint16", "uint32", "uint64", "float64", "net.IPNet":
 		return true
 	}
 	return false
@@ -132,7 +132,7 @@ func isBasicTypeForGettersOnly(kind string) bool {
 
 func qualifiedType(module *common.Module, kind string) string {
 	switch kind {
-	case "int", "string", "bool":
+	case "int", "float64", "string", "bool":
 		return kind
 	default:
 		return module.SourcePkgPrefix + kind
@@ -140,7 +140,7 @@ func qualifiedType(module *common.Module, kind string) string {
 }
 
 // handleBasic adds fields of "basic" type to list of exposed SECL fields of the module
-func handleBasic(module *common.Module, field seclField, name, alias, aliasPrefix, prefix, kind, event string, restrictedTo []string, opOverrides, commentText, containerStructName string, iterator *common.StructField, isArray bool) {
+func handleBasic(module *common.Module, field seclField, name, alias, aliasPrefix, prefix, kind, event string, restrictedTo, optionalFor []string, opOverrides, commentText, containerStructName string, iterator *common.StructField, isArray bool) {
 	if verbose {
 		fmt.Printf("handleBasic name: %s, kind: %s, alias: %s, isArray: %v\n", name, kind, alias, isArray)
 	}
@@ -170,6 +170,7 @@ func handleBasic(module *common.Module, field seclField, name, alias, aliasPrefi
 		GettersOnly:  field.gettersOnly,
 		Ref:          field.ref,
 		RestrictedTo: restrictedTo,
+		OptionalFor:  optionalFor,
 	}
 
 	module.Fields[alias] = newStructField
@@ -200,6 +201,7 @@ func handleBasic(module *common.Module, field seclField, name, alias, aliasPrefi
 			GettersOnly:  field.gettersOnly,
 			Ref:          field.ref,
 			RestrictedTo: restrictedTo,
+			OptionalFor:  optionalFor,
 		}
 
 		module.Fields[alias] = newStructField
@@ -266,7 +268,7 @@ func addLengthOpField(module *common.Module, alias string, field *common.StructF
 }
 
 // handleIterator adds iterator to list of exposed SECL iterators of the module
-func handleIterator(module *common.Module, field seclField, fieldType, iterator, aliasPrefix, prefixedFieldName, event string, restrictedTo []string, fieldCommentText, opOverrides string, isPointer, isArray bool) *common.StructField {
+func handleIterator(module *common.Module, field seclField, fieldType, iterator, aliasPrefix, prefixedFieldName, event string, restrictedTo, optionalFor []string, fieldCommentText, opOverrides string, isPointer, isArray bool) *common.StructField {
 	alias := field.name
 	if aliasPrefix != "" {
 		alias = aliasPrefix + "." + field.name
@@ -287,6 +289,7 @@ func handleIterator(module *common.Module, field seclField, fieldType, iterator,
 		Check:            field.check,
 		Ref:              field.ref,
 		RestrictedTo:     restrictedTo,
+		OptionalFor:      optionalFor,
 	}
 
 	lengthField := addLengthOpField(module, alias, module.Iterators[alias])
@@ -297,7 +300,7 @@ func handleIterator(module *common.Module, field seclField, fieldType, iterator,
 }
 
 // handleFieldWithHandler adds non-embedded fields with handlers to list of exposed SECL fields and event types of the module
-func handleFieldWithHandler(module *common.Module, field seclField, aliasPrefix, prefix, prefixedFieldName, fieldType, containerStructName, event string, restrictedTo []string, fieldCommentText, opOverrides, handler string, isPointer, isArray bool, fieldIterator *common.StructField) {
+func handleFieldWithHandler(module *common.Module, field seclField, aliasPrefix, prefix, prefixedFieldName, fieldType, containerStructName, event string, restrictedTo, optionalFor []string, fieldCommentText, opOverrides, handler string, isPointer, isArray bool, fieldIterator *common.StructField) {
 	alias := field.name
 
 	if aliasPrefix != "" {
@@ -331,11 +334,14 @@ func handleFieldWithHandler(module *common.Module, field seclField, aliasPrefix,
 		GettersOnly:      field.gettersOnly,
 		Ref:              field.ref,
 		RestrictedTo:     restrictedTo,
+		OptionalFor:      optionalFor,
 	}
 	module.Fields[alias] = newStructField
 
 	if field.lengthField {
-		addLengthOpField(module, alias, module.Fields[alias])
+		lengthField := addLengthOpField(module, alias, module.Fields[alias])
+		// the length of an array is a scalar
+		lengthField.IsArray = false
 	}
 
 	if _, ok := module.EventTypes[event]; !ok {
@@ -442,7 +448,7 @@ func parseFieldDef(def string) (seclField, error) {
 }
 
 // handleSpecRecursive is a recursive function that walks through the fields of a module
-func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interface{}, prefix, aliasPrefix, event string, restrictedTo []string, iterator *common.StructField, dejavu map[string]bool) {
+func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interface{}, prefix, aliasPrefix, event string, restrictedTo, optionalFor []string, iterator *common.StructField, dejavu map[string]bool) {
 	if verbose {
 		fmt.Printf("handleSpec spec: %+v, prefix: %s, aliasPrefix %s, event %s, iterator %+v\n", spec, prefix, aliasPrefix, event, iterator)
 	}
@@ -458,7 +464,7 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 		return
 	}
 
-	prevrestrictedTo := restrictedTo
+	prevrestrictedTo, prevOptionalFor := restrictedTo, optionalFor
 
 	for _, field := range structType.Fields.List {
 		fieldCommentText := field.Comment.Text()
@@ -482,6 +488,10 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 			restrictedTo = strings.Split(e, ",")
 		}
 
+		if e, ok := tag.Lookup("optional_for"); ok {
+			optionalFor = strings.Split(e, ",")
+		}
+
 		if isEmbedded := len(field.Names) == 0; isEmbedded { // embedded as in a struct embedded in another struct
 			if fieldTag, found := tag.Lookup("field"); found && fieldTag == "-" {
 				continue
@@ -503,7 +513,7 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 				embedded := astFiles.LookupSymbol(ident.Name)
 				if embedded != nil {
 					handleEmbedded(module, ident.Name, prefix, event, restrictedTo, field.Type)
-					handleSpecRecursive(module, astFiles, embedded.Decl, name, aliasPrefix, event, restrictedTo, fieldIterator, dejavu)
+					handleSpecRecursive(module, astFiles, embedded.Decl, name, aliasPrefix, event, restrictedTo, optionalFor, fieldIterator, dejavu)
 				} else {
 					log.Printf("failed to resolve symbol for identifier %+v in %s", ident.Name, pkgname)
 				}
@@ -542,11 +552,11 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 				handleNonEmbedded(module, seclField, prefixedFieldName, event, restrictedTo, fieldType, isPointer, isArray)
 
 				if seclFieldIterator := seclField.iterator; seclFieldIterator != "" {
-					fieldIterator = handleIterator(module, seclField, fieldType, seclFieldIterator, aliasPrefix, prefixedFieldName, event, restrictedTo, fieldCommentText, opOverrides, isPointer, isArray)
+					fieldIterator = handleIterator(module, seclField, fieldType, seclFieldIterator, aliasPrefix, prefixedFieldName, event, restrictedTo, optionalFor, fieldCommentText, opOverrides, isPointer, isArray)
 				}
 
 				if handler := seclField.handler; handler != "" {
-					handleFieldWithHandler(module, seclField, aliasPrefix, prefix, prefixedFieldName, fieldType, seclField.containerStructName, event, restrictedTo, fieldCommentText, opOverrides, handler, isPointer, isArray, fieldIterator)
+					handleFieldWithHandler(module, seclField, aliasPrefix, prefix, prefixedFieldName, fieldType, seclField.containerStructName, event, restrictedTo, optionalFor, fieldCommentText, opOverrides, handler, isPointer, isArray, fieldIterator)
 
 					delete(dejavu, fieldBasename)
 					continue
@@ -570,7 +580,7 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 
 				alias := seclField.name
 				if isBasicType(fieldType) {
-					handleBasic(module, seclField, fieldBasename, alias, aliasPrefix, prefix, fieldType, event, restrictedTo, opOverrides, fieldCommentText, seclField.containerStructName, fieldIterator, isArray)
+					handleBasic(module, seclField, fieldBasename, alias, aliasPrefix, prefix, fieldType, event, restrictedTo, optionalFor, opOverrides, fieldCommentText, seclField.containerStructName, fieldIterator, isArray)
 				} else {
 					spec := astFiles.LookupSymbol(fieldType)
 					if spec != nil {
@@ -584,7 +594,7 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 							newAliasPrefix = aliasPrefix + "." + alias
 						}
 
-						handleSpecRecursive(module, astFiles, spec.Decl, newPrefix, newAliasPrefix, event, restrictedTo, fieldIterator, dejavu)
+						handleSpecRecursive(module, astFiles, spec.Decl, newPrefix, newAliasPrefix, event, restrictedTo, optionalFor, fieldIterator, dejavu)
 					} else {
 						log.Printf("failed to resolve symbol for type %+v in %s", fieldType, pkgname)
 					}
@@ -598,11 +608,11 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 				handleNonEmbedded(module, seclField, prefixedFieldName, event, restrictedTo, fieldType, isPointer, isArray)
 
 				if seclFieldIterator := seclField.iterator; seclFieldIterator != "" {
-					fieldIterator = handleIterator(module, seclField, fieldType, seclFieldIterator, aliasPrefix, prefixedFieldName, event, restrictedTo, fieldCommentText, opOverrides, isPointer, isArray)
+					fieldIterator = handleIterator(module, seclField, fieldType, seclFieldIterator, aliasPrefix, prefixedFieldName, event, restrictedTo, optionalFor, fieldCommentText, opOverrides, isPointer, isArray)
 				}
 
 				if handler := seclField.handler; handler != "" {
-					handleFieldWithHandler(module, seclField, aliasPrefix, prefix, prefixedFieldName, fieldType, seclField.containerStructName, event, restrictedTo, fieldCommentText, opOverrides, handler, isPointer, isArray, fieldIterator)
+					handleFieldWithHandler(module, seclField, aliasPrefix, prefix, prefixedFieldName, fieldType, seclField.containerStructName, event, restrictedTo, optionalFor, fieldCommentText, opOverrides, handler, isPointer, isArray, fieldIterator)
 
 					delete(dejavu, fieldBasename)
 					continue
@@ -620,7 +630,7 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 
 				alias := seclField.name
 				if isBasicTypeForGettersOnly(fieldType) {
-					handleBasic(module, seclField, fieldBasename, alias, aliasPrefix, prefix, fieldType, event, restrictedTo, opOverrides, fieldCommentText, seclField.containerStructName, fieldIterator, isArray)
+					handleBasic(module, seclField, fieldBasename, alias, aliasPrefix, prefix, fieldType, event, restrictedTo, optionalFor, opOverrides, fieldCommentText, seclField.containerStructName, fieldIterator, isArray)
 				} else {
 					spec := astFiles.LookupSymbol(fieldType)
 					if spec != nil {
@@ -634,7 +644,7 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 							newAliasPrefix = aliasPrefix + "." + alias
 						}
 
-						handleSpecRecursive(module, astFiles, spec.Decl, newPrefix, newAliasPrefix, event, restrictedTo, fieldIterator, dejavu)
+						handleSpecRecursive(module, astFiles, spec.Decl, newPrefix, newAliasPrefix, event, restrictedTo, optionalFor, fieldIterator, dejavu)
 					} else {
 						log.Printf("failed to resolve symbol for type %+v in %s", fieldType, pkgname)
 					}
@@ -646,7 +656,7 @@ func handleSpecRecursive(module *common.Module, astFiles *AstFiles, spec interfa
 			}
 		}
 
-		restrictedTo = prevrestrictedTo
+		restrictedTo, optionalFor = prevrestrictedTo, prevOptionalFor
 	}
 }
 
@@ -739,7 +749,7 @@ func parseFile(modelFile string, typesFile string, pkgName string) (*common.Modu
 	}
 
 	for _, spec := range astFiles.GetSpecs() {
-		handleSpecRecursive(module, astFiles, spec, "", "", "", nil, nil, make(map[string]bool))
+		handleSpecRecursive(module, astFiles, spec, "", "", "", nil, nil, nil, make(map[string]bool))
 	}
 
 	return module, nil
@@ -810,6 +820,11 @@ func pascalCaseFieldName(fieldName string) string {
 	return strings.Join(chunks, "")
 }
 
+// eventTypeConstant returns the name of the constant of an event type, e.g. EventLoadModule for load_module
+func eventTypeConstant(eventType string) string {
+	return "Event" + pascalCaseFieldName(eventType)
+}
+
 func getDefaultValueOfType(returnType string) string {
 	baseType, isArray := strings.CutPrefix(returnType, "[]")
 
@@ -838,6 +853,11 @@ func getDefaultValueOfType(returnType string) string {
 			return "[]uint64{}"
 		}
 		return "uint64(0)"
+	} else if baseType == "float64" {
+		if isArray {
+			return "[]float64{}"
+		}
+		return "0"
 	} else if baseType == "bool" {
 		if isArray {
 			return "[]bool{}"
@@ -913,7 +933,11 @@ func getHolder(allFields map[string]*common.StructField, field *common.StructFie
 func getChecks(allFields map[string]*common.StructField, field *common.StructField) []string {
 	var checks []string
 
+	// the length fields aren't registered, they share the checks of the field they measure, unless iterated
 	name := field.Name
+	if field.IsLength && !field.IsIterator && field.Iterator == nil {
+		name = strings.TrimSuffix(name, ".length")
+	}
 	for name != "" {
 		field := allFields[name]
 		if field == nil {
@@ -990,12 +1014,21 @@ func getFieldRestrictions(field *common.StructField) string {
 	return fmt.Sprintf(`[]eval.EventType{"%s"}`, strings.Join(field.RestrictedTo, `", "`))
 }
 
+func getFieldOptionalEventTypes(field *common.StructField) string {
+	if len(field.OptionalFor) == 0 {
+		return "nil"
+	}
+	return fmt.Sprintf(`[]eval.EventType{"%s"}`, strings.Join(field.OptionalFor, `", "`))
+}
+
 func getFieldReflectType(field *common.StructField) string {
 	switch field.ReturnType {
 	case "string":
 		return "reflect.String"
 	case "int":
 		return "reflect.Int"
+	case "float64":
+		return "reflect.Float64"
 	case "bool":
 		return "reflect.Bool"
 	case "net.IPNet":
@@ -1004,22 +1037,54 @@ func getFieldReflectType(field *common.StructField) string {
 	return ""
 }
 
+// isArrayField returns whether the value of the field is an array, the length of an iterator being a scalar
+func isArrayField(field *common.StructField) bool {
+	if field.IsLength && field.IsIterator {
+		return false
+	}
+	return field.Iterator != nil || field.IsArray
+}
+
+// getFieldIntKind returns the reflect kind of the Go type of an int field, empty for the other fields or when the type
+// isn't a builtin int type
+func getFieldIntKind(field *common.StructField) string {
+	if field.BasicType != "int" {
+		return ""
+	}
+
+	switch field.OrigType {
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return "reflect." + strings.ToUpper(field.OrigType[:1]) + field.OrigType[1:]
+	}
+	return ""
+}
+
+// isFilePathField returns whether the field is the path of a file
+func isFilePathField(field *common.StructField) bool {
+	return field.Handler == "ResolveFilePath" && field.ReturnType == "string" && !field.IsLength
+}
+
 var funcMap = map[string]interface{}{
-	"TrimPrefix":               strings.TrimPrefix,
-	"TrimSuffix":               strings.TrimSuffix,
-	"HasPrefix":                strings.HasPrefix,
-	"NewField":                 newField,
-	"GeneratePrefixNilChecks":  generatePrefixNilChecks,
-	"GetFieldHandler":          getFieldHandler,
-	"FieldADPrint":             fieldADPrint,
-	"GetChecks":                getChecks,
-	"GetHandlers":              getHandlers,
-	"PascalCaseFieldName":      pascalCaseFieldName,
-	"GetDefaultValueOfType":    getDefaultValueOfType,
-	"NeedScrubbed":             needScrubbed,
-	"AddSuffixToFuncPrototype": addSuffixToFuncPrototype,
-	"GetFieldRestrictions":     getFieldRestrictions,
-	"GetFieldReflectType":      getFieldReflectType,
+	"TrimPrefix":                 strings.TrimPrefix,
+	"TrimSuffix":                 strings.TrimSuffix,
+	"HasPrefix":                  strings.HasPrefix,
+	"NewField":                   newField,
+	"GeneratePrefixNilChecks":    generatePrefixNilChecks,
+	"GetFieldHandler":            getFieldHandler,
+	"FieldADPrint":               fieldADPrint,
+	"GetChecks":                  getChecks,
+	"GetHandlers":                getHandlers,
+	"PascalCaseFieldName":        pascalCaseFieldName,
+	"EventTypeConstant":          eventTypeConstant,
+	"GetDefaultValueOfType":      getDefaultValueOfType,
+	"NeedScrubbed":               needScrubbed,
+	"AddSuffixToFuncPrototype":   addSuffixToFuncPrototype,
+	"GetFieldRestrictions":       getFieldRestrictions,
+	"GetFieldOptionalEventTypes": getFieldOptionalEventTypes,
+	"GetFieldReflectType":        getFieldReflectType,
+	"IsArrayField":               isArrayField,
+	"GetFieldIntKind":            getFieldIntKind,
+	"IsFilePathField":            isFilePathField,
 }
 
 //go:embed accessors.tmpl
@@ -71,6 +71,7 @@ type StructField struct {
 	GettersOnly      bool
 	Ref              string
 	RestrictedTo     []string
+	OptionalFor      []string
 	IsIterator       bool
 }
 
@@ -84,6 +85,11 @@ func (sf *StructField) GetEvaluatorType() string {
 		if sf.Iterator != nil || sf.IsArray {
 			evaluatorType = "eval.IntArrayEvaluator"
 		}
+	} else if sf.ReturnType == "float64" {
+		evaluatorType = "eval.FloatEvaluator"
+		if sf.Iterator != nil || sf.IsArray {
+			evaluatorType = "eval.FloatArrayEvaluator"
+		}
 	} else if sf.ReturnType == "bool" {
 		evaluatorType = "eval.BoolEvaluator"
 		if sf.Iterator != nil || sf.IsArray {
@@ -110,6 +116,11 @@ func (sf *StructField) GetDefaultReturnValue() string {
 			return "[]int{}"
 		}
 		return "0"
+	} else if sf.ReturnType == "float64" {
+		if sf.Iterator != nil || sf.IsArray {
+			return "[]float64{}"
+		}
+		return "0"
 	} else if sf.ReturnType == "bool" {
 		if sf.Iterator != nil || sf.IsArray {
 			return "[]bool{}"
@@ -128,7 +139,7 @@ func (sf *StructField) GetDefaultReturnValue() string {
 
 // GetDefaultScalarReturnValue returns default scalar value for the given return type
 func (sf *StructField) GetDefaultScalarReturnValue() string {
-	if sf.ReturnType == "int" {
+	if sf.ReturnType == "int" || sf.ReturnType == "float64" {
 		return "0"
 	} else if sf.ReturnType == "bool" {
 		return "false"
@@ -153,6 +164,8 @@ func (sf *StructField) GetCacheName() string {
 		return "StringCache"
 	case "int":
 		return "IntCache"
+	case "float64":
+		return "FloatCache"
 	case "bool":
 		return "BoolCache"
 	default:
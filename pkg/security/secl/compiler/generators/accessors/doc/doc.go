@@ -82,6 +82,8 @@ func translateFieldType(rt string) string {
 	switch rt {
 	case "net.IPNet", "net.IP":
 		return "IP/CIDR"
+	case "float64":
+		return "float"
 	}
 	return rt
 }
@@ -480,6 +480,51 @@ func {{ .FuncName }}(a *{{ .Arg1Type }}, b *{{ .Arg2Type }}, state *State) (*{{
 				Op:             durationCompareArithmeticOperation("=="),
 				ValueType:      "ScalarValueType",
 			},
+			{
+				FuncName:       "FloatEquals",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare("=="),
+				ValueType:      "ScalarValueType",
+			},
+			{
+				FuncName:       "FloatGreaterThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare(">"),
+				ValueType:      "RangeValueType",
+			},
+			{
+				FuncName:       "FloatGreaterOrEqualThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare(">="),
+				ValueType:      "RangeValueType",
+			},
+			{
+				FuncName:       "FloatLesserThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare("<"),
+				ValueType:      "RangeValueType",
+			},
+			{
+				FuncName:       "FloatLesserOrEqualThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare("<="),
+				ValueType:      "RangeValueType",
+			},
 		},
 		ArrayOperators: []Operator{
 			{
@@ -582,6 +627,56 @@ func {{ .FuncName }}(a *{{ .Arg1Type }}, b *{{ .Arg2Type }}, state *State) (*{{
 				ArrayType:      "int",
 				ValueType:      "RangeValueType",
 			},
+			{
+				FuncName:       "FloatArrayEquals",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatArrayEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare("=="),
+				ArrayType:      "float64",
+				ValueType:      "ScalarValueType",
+			},
+			{
+				FuncName:       "FloatArrayGreaterThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatArrayEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare(">"),
+				ArrayType:      "float64",
+				ValueType:      "RangeValueType",
+			},
+			{
+				FuncName:       "FloatArrayGreaterOrEqualThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatArrayEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare(">="),
+				ArrayType:      "float64",
+				ValueType:      "RangeValueType",
+			},
+			{
+				FuncName:       "FloatArrayLesserThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatArrayEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare("<"),
+				ArrayType:      "float64",
+				ValueType:      "RangeValueType",
+			},
+			{
+				FuncName:       "FloatArrayLesserOrEqualThan",
+				Arg1Type:       "FloatEvaluator",
+				Arg2Type:       "FloatArrayEvaluator",
+				FuncReturnType: "BoolEvaluator",
+				EvalReturnType: "bool",
+				Op:             stdCompare("<="),
+				ArrayType:      "float64",
+				ValueType:      "RangeValueType",
+			},
 		},
 	}
 
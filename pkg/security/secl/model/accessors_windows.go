@@ -18,27 +18,164 @@ import (
 // to always require the math package
 var _ = math.MaxUint16
 
+// Event types of the model, as returned by GetEventTypes
+const (
+	EventChangePermission eval.EventType = "change_permission"
+	EventCreate           eval.EventType = "create"
+	EventCreateKey        eval.EventType = "create_key"
+	EventDelete           eval.EventType = "delete"
+	EventDeleteKey        eval.EventType = "delete_key"
+	EventExec             eval.EventType = "exec"
+	EventExit             eval.EventType = "exit"
+	EventOpenKey          eval.EventType = "open_key"
+	EventRename           eval.EventType = "rename"
+	EventSetKeyValue      eval.EventType = "set_key_value"
+	EventWrite            eval.EventType = "write"
+)
+
 func (m *Model) GetEventTypes() []eval.EventType {
-	return []eval.EventType{
-		eval.EventType("change_permission"),
-		eval.EventType("create"),
-		eval.EventType("create_key"),
-		eval.EventType("delete"),
-		eval.EventType("delete_key"),
-		eval.EventType("exec"),
-		eval.EventType("exit"),
-		eval.EventType("open_key"),
-		eval.EventType("rename"),
-		eval.EventType("set_key_value"),
-		eval.EventType("write"),
-	}
+	return m.filterEventTypes([]eval.EventType{
+		EventChangePermission,
+		EventCreate,
+		EventCreateKey,
+		EventDelete,
+		EventDeleteKey,
+		EventExec,
+		EventExit,
+		EventOpenKey,
+		EventRename,
+		EventSetKeyValue,
+		EventWrite,
+	})
 }
 func (m *Model) GetFieldRestrictions(field eval.Field) []eval.EventType {
 	switch field {
 	}
 	return nil
 }
+func (m *Model) GetFieldOptionalEventTypes(field eval.Field) []eval.EventType {
+	switch field {
+	case "container.created_at":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "container.id":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "container.runtime":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "container.tags":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.ancestors.exec_count":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.cmdline":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.cmdline_scrubbed":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.container.id":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.created_at":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.envp":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.envs":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.exec_count":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.file.name":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.file.name.length":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.file.path":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.file.path.length":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.file_events_count":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.has_ancestors":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.length":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.metadata_change_count":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.pid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.ppid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.user":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ancestors.user_sid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.cmdline":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.cmdline_scrubbed":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.container.id":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.created_at":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.envp":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.envs":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.exec_time":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.exit_time":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.file.name":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.file.name.length":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.file.path":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.file.path.length":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.file_events_count":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.has_ancestors":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.metadata_change_count":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.cmdline":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.cmdline_scrubbed":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.container.id":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.created_at":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.envp":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.envs":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.file.name":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.file.name.length":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.file.path":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.file.path.length":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.pid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.ppid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.user":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.parent.user_sid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.pid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.ppid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.user":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	case "process.user_sid":
+		return []eval.EventType{"dns", "imds", "raw_packet", "load_module", "unload_module"}
+	}
+	return nil
+}
 func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Evaluator, error) {
+	if m.isFieldDisabled(field) {
+		return nil, &eval.ErrFieldNotFound{Field: field}
+	}
 	switch field {
 	case "change_permission.new_sd":
 		return &eval.StringEvaluator{
@@ -450,6 +587,16 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "event.is_metadata_only":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveEventIsMetadataOnly(ev, &ev.BaseEvent)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "event.origin":
 		return &eval.StringEvaluator{
 			EvalFnc: func(ctx *eval.Context) string {
@@ -565,7 +712,8 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		}, nil
 	case "exec.file.path":
 		return &eval.StringEvaluator{
-			OpOverrides: eval.WindowsPathCmp,
+			OpOverrides:   eval.WindowsPathCmp,
+			StringCmpOpts: m.filePathStringCmpOpts(),
 			EvalFnc: func(ctx *eval.Context) string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
@@ -720,7 +868,8 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		}, nil
 	case "exit.file.path":
 		return &eval.StringEvaluator{
-			OpOverrides: eval.WindowsPathCmp,
+			OpOverrides:   eval.WindowsPathCmp,
+			StringCmpOpts: m.filePathStringCmpOpts(),
 			EvalFnc: func(ctx *eval.Context) string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
@@ -864,15 +1013,40 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.FunctionWeight,
 		}, nil
+	case "process.ancestors.ancestors.exec_count":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, &element.ProcessContext))
+					results = append(results, result)
+					return results
+				}
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, &pce.ProcessContext))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.cmdline":
 		return &eval.StringArrayEvaluator{
 			OpOverrides: eval.CaseInsensitiveCmp,
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -885,6 +1059,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
 					return ev.FieldHandlers.ResolveProcessCmdLine(ev, &pce.ProcessContext.Process)
 				})
@@ -897,9 +1074,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -912,6 +1086,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, nil, func(ev *Event, pce *ProcessCacheEntry) string {
 					return pce.ProcessContext.Process.ContainerID
 				})
@@ -925,9 +1102,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) []int {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.IntCache[field]; ok {
-					return result
-				}
 				var results []int
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -940,6 +1114,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
 					return int(ev.FieldHandlers.ResolveProcessCreatedAt(ev, &pce.ProcessContext.Process))
 				})
@@ -953,9 +1130,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -968,6 +1142,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result...)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIteratorArray(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) []string {
 					return ev.FieldHandlers.ResolveProcessEnvp(ev, &pce.ProcessContext.Process)
 				})
@@ -981,9 +1158,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -996,6 +1170,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result...)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIteratorArray(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) []string {
 					return ev.FieldHandlers.ResolveProcessEnvs(ev, &pce.ProcessContext.Process)
 				})
@@ -1004,15 +1181,22 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			}, Field: field,
 			Weight: 100 * eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.exec_count":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, ev.BaseEvent.ProcessContext)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.ancestors.file.name":
 		return &eval.StringArrayEvaluator{
 			OpOverrides: eval.CaseInsensitiveCmp,
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1025,6 +1209,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
 					return ev.FieldHandlers.ResolveFileBasename(ev, &pce.ProcessContext.Process.FileEvent)
 				})
@@ -1039,9 +1226,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) []int {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.IntCache[field]; ok {
-					return result
-				}
 				var results []int
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1054,6 +1238,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
 					return len(ev.FieldHandlers.ResolveFileBasename(ev, &pce.ProcessContext.Process.FileEvent))
 				})
@@ -1064,13 +1251,11 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		}, nil
 	case "process.ancestors.file.path":
 		return &eval.StringArrayEvaluator{
-			OpOverrides: eval.WindowsPathCmp,
+			OpOverrides:   eval.WindowsPathCmp,
+			StringCmpOpts: m.filePathStringCmpOpts(),
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1083,6 +1268,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
 					return ev.FieldHandlers.ResolveFilePath(ev, &pce.ProcessContext.Process.FileEvent)
 				})
@@ -1097,9 +1285,34 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) []int {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := len(ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.FileEvent))
+					results = append(results, result)
+					return results
+				}
 				if result, ok := ctx.IntCache[field]; ok {
 					return result
 				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return len(ev.FieldHandlers.ResolveFilePath(ev, &pce.ProcessContext.Process.FileEvent))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.file_events_count":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
 				var results []int
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1108,18 +1321,49 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 						return results
 					}
 					element := value
-					result := len(ev.FieldHandlers.ResolveFilePath(ev, &element.ProcessContext.Process.FileEvent))
+					result := int(ev.FieldHandlers.ResolveProcessFileEventsCount(ev, &element.ProcessContext))
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
-					return len(ev.FieldHandlers.ResolveFilePath(ev, &pce.ProcessContext.Process.FileEvent))
+					return int(ev.FieldHandlers.ResolveProcessFileEventsCount(ev, &pce.ProcessContext))
 				})
 				ctx.IntCache[field] = results
 				return results
 			}, Field: field,
 			Weight: eval.IteratorWeight,
 		}, nil
+	case "process.ancestors.has_ancestors":
+		return &eval.BoolArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []bool {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				var results []bool
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := ev.FieldHandlers.ResolveProcessHasAncestors(ev, &element.ProcessContext)
+					results = append(results, result)
+					return results
+				}
+				if result, ok := ctx.BoolCache[field]; ok {
+					return result
+				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) bool {
+					return ev.FieldHandlers.ResolveProcessHasAncestors(ev, &pce.ProcessContext)
+				})
+				ctx.BoolCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
 	case "process.ancestors.length":
 		return &eval.IntEvaluator{
 			EvalFnc: func(ctx *eval.Context) int {
@@ -1130,13 +1374,38 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.IteratorWeight,
 		}, nil
-	case "process.ancestors.pid":
+	case "process.ancestors.metadata_change_count":
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
 				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				var results []int
+				iterator := &ProcessAncestorsIterator{}
+				if regID != "" {
+					value := iterator.At(ctx, regID, ctx.Registers[regID])
+					if value == nil {
+						return results
+					}
+					element := value
+					result := int(ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, &element.ProcessContext))
+					results = append(results, result)
+					return results
+				}
 				if result, ok := ctx.IntCache[field]; ok {
 					return result
 				}
+				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) int {
+					return int(ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, &pce.ProcessContext))
+				})
+				ctx.IntCache[field] = results
+				return results
+			}, Field: field,
+			Weight: eval.IteratorWeight,
+		}, nil
+	case "process.ancestors.pid":
+		return &eval.IntArrayEvaluator{
+			EvalFnc: func(ctx *eval.Context) []int {
+				ctx.AppendResolvedField(field)
 				var results []int
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1149,6 +1418,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, nil, func(ev *Event, pce *ProcessCacheEntry) int {
 					return int(pce.ProcessContext.Process.PIDContext.Pid)
 				})
@@ -1161,9 +1433,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		return &eval.IntArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []int {
 				ctx.AppendResolvedField(field)
-				if result, ok := ctx.IntCache[field]; ok {
-					return result
-				}
 				var results []int
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1176,6 +1445,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.IntCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, nil, func(ev *Event, pce *ProcessCacheEntry) int {
 					return int(pce.ProcessContext.Process.PPid)
 				})
@@ -1189,9 +1461,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1204,6 +1473,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, ev, func(ev *Event, pce *ProcessCacheEntry) string {
 					return ev.FieldHandlers.ResolveUser(ev, &pce.ProcessContext.Process)
 				})
@@ -1216,9 +1488,6 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		return &eval.StringArrayEvaluator{
 			EvalFnc: func(ctx *eval.Context) []string {
 				ctx.AppendResolvedField(field)
-				if result, ok := ctx.StringCache[field]; ok {
-					return result
-				}
 				var results []string
 				iterator := &ProcessAncestorsIterator{}
 				if regID != "" {
@@ -1231,6 +1500,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 					results = append(results, result)
 					return results
 				}
+				if result, ok := ctx.StringCache[field]; ok {
+					return result
+				}
 				results = newAncestorsIterator(iterator, ctx, nil, func(ev *Event, pce *ProcessCacheEntry) string {
 					return pce.ProcessContext.Process.OwnerSidString
 				})
@@ -1314,7 +1586,8 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		}, nil
 	case "process.file.path":
 		return &eval.StringEvaluator{
-			OpOverrides: eval.WindowsPathCmp,
+			OpOverrides:   eval.WindowsPathCmp,
+			StringCmpOpts: m.filePathStringCmpOpts(),
 			EvalFnc: func(ctx *eval.Context) string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
@@ -1334,6 +1607,36 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			Field:  field,
 			Weight: eval.HandlerWeight,
 		}, nil
+	case "process.file_events_count":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessFileEventsCount(ev, ev.BaseEvent.ProcessContext)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.has_ancestors":
+		return &eval.BoolEvaluator{
+			EvalFnc: func(ctx *eval.Context) bool {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessHasAncestors(ev, ev.BaseEvent.ProcessContext)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
+	case "process.metadata_change_count":
+		return &eval.IntEvaluator{
+			EvalFnc: func(ctx *eval.Context) int {
+				ctx.AppendResolvedField(field)
+				ev := ctx.Event.(*Event)
+				return ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, ev.BaseEvent.ProcessContext)
+			},
+			Field:  field,
+			Weight: eval.HandlerWeight,
+		}, nil
 	case "process.parent.cmdline":
 		return &eval.StringEvaluator{
 			OpOverrides: eval.CaseInsensitiveCmp,
@@ -1420,6 +1723,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) int {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
 				return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
 			},
 			Field:  field,
@@ -1427,7 +1733,8 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 		}, nil
 	case "process.parent.file.path":
 		return &eval.StringEvaluator{
-			OpOverrides: eval.WindowsPathCmp,
+			OpOverrides:   eval.WindowsPathCmp,
+			StringCmpOpts: m.filePathStringCmpOpts(),
 			EvalFnc: func(ctx *eval.Context) string {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
@@ -1445,6 +1752,9 @@ func (m *Model) GetEvaluator(field eval.Field, regID eval.RegisterID) (eval.Eval
 			EvalFnc: func(ctx *eval.Context) int {
 				ctx.AppendResolvedField(field)
 				ev := ctx.Event.(*Event)
+				if !ev.BaseEvent.ProcessContext.HasParent() {
+					return 0
+				}
 				return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
 			},
 			Field:  field,
@@ -1928,6 +2238,7 @@ func (ev *Event) GetFields() []eval.Field {
 		"delete_key.registry.key_path",
 		"delete_key.registry.key_path.length",
 		"event.hostname",
+		"event.is_metadata_only",
 		"event.origin",
 		"event.os",
 		"event.service",
@@ -1968,16 +2279,21 @@ func (ev *Event) GetFields() []eval.Field {
 		"open_key.registry.key_name.length",
 		"open_key.registry.key_path",
 		"open_key.registry.key_path.length",
+		"process.ancestors.ancestors.exec_count",
 		"process.ancestors.cmdline",
 		"process.ancestors.container.id",
 		"process.ancestors.created_at",
 		"process.ancestors.envp",
 		"process.ancestors.envs",
+		"process.ancestors.exec_count",
 		"process.ancestors.file.name",
 		"process.ancestors.file.name.length",
 		"process.ancestors.file.path",
 		"process.ancestors.file.path.length",
+		"process.ancestors.file_events_count",
+		"process.ancestors.has_ancestors",
 		"process.ancestors.length",
+		"process.ancestors.metadata_change_count",
 		"process.ancestors.pid",
 		"process.ancestors.ppid",
 		"process.ancestors.user",
@@ -1991,6 +2307,9 @@ func (ev *Event) GetFields() []eval.Field {
 		"process.file.name.length",
 		"process.file.path",
 		"process.file.path.length",
+		"process.file_events_count",
+		"process.has_ancestors",
+		"process.metadata_change_count",
 		"process.parent.cmdline",
 		"process.parent.container.id",
 		"process.parent.created_at",
@@ -2067,15 +2386,15 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 	case "create.file.device_path":
 		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.CreateNewFile.File), nil
 	case "create.file.device_path.length":
-		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.CreateNewFile.File), nil
+		return len(ev.FieldHandlers.ResolveFimFilePath(ev, &ev.CreateNewFile.File)), nil
 	case "create.file.name":
 		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.CreateNewFile.File), nil
 	case "create.file.name.length":
-		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.CreateNewFile.File), nil
+		return len(ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.CreateNewFile.File)), nil
 	case "create.file.path":
 		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.CreateNewFile.File), nil
 	case "create.file.path.length":
-		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.CreateNewFile.File), nil
+		return len(ev.FieldHandlers.ResolveFileUserPath(ev, &ev.CreateNewFile.File)), nil
 	case "create.registry.key_name":
 		return ev.CreateRegistryKey.Registry.KeyName, nil
 	case "create.registry.key_name.length":
@@ -2095,15 +2414,15 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 	case "delete.file.device_path":
 		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.DeleteFile.File), nil
 	case "delete.file.device_path.length":
-		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.DeleteFile.File), nil
+		return len(ev.FieldHandlers.ResolveFimFilePath(ev, &ev.DeleteFile.File)), nil
 	case "delete.file.name":
 		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.DeleteFile.File), nil
 	case "delete.file.name.length":
-		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.DeleteFile.File), nil
+		return len(ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.DeleteFile.File)), nil
 	case "delete.file.path":
 		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.DeleteFile.File), nil
 	case "delete.file.path.length":
-		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.DeleteFile.File), nil
+		return len(ev.FieldHandlers.ResolveFileUserPath(ev, &ev.DeleteFile.File)), nil
 	case "delete.registry.key_name":
 		return ev.DeleteRegistryKey.Registry.KeyName, nil
 	case "delete.registry.key_name.length":
@@ -2122,6 +2441,8 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return len(ev.DeleteRegistryKey.Registry.KeyPath), nil
 	case "event.hostname":
 		return ev.FieldHandlers.ResolveHostname(ev, &ev.BaseEvent), nil
+	case "event.is_metadata_only":
+		return ev.FieldHandlers.ResolveEventIsMetadataOnly(ev, &ev.BaseEvent), nil
 	case "event.origin":
 		return ev.BaseEvent.Origin, nil
 	case "event.os":
@@ -2143,11 +2464,11 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 	case "exec.file.name":
 		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.Exec.Process.FileEvent), nil
 	case "exec.file.name.length":
-		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.Exec.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.Exec.Process.FileEvent)), nil
 	case "exec.file.path":
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Exec.Process.FileEvent), nil
 	case "exec.file.path.length":
-		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Exec.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.Exec.Process.FileEvent)), nil
 	case "exec.pid":
 		return int(ev.Exec.Process.PIDContext.Pid), nil
 	case "exec.ppid":
@@ -2173,11 +2494,11 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 	case "exit.file.name":
 		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.Exit.Process.FileEvent), nil
 	case "exit.file.name.length":
-		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.Exit.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.Exit.Process.FileEvent)), nil
 	case "exit.file.path":
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Exit.Process.FileEvent), nil
 	case "exit.file.path.length":
-		return ev.FieldHandlers.ResolveFilePath(ev, &ev.Exit.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.Exit.Process.FileEvent)), nil
 	case "exit.pid":
 		return int(ev.Exit.Process.PIDContext.Pid), nil
 	case "exit.ppid":
@@ -2202,6 +2523,18 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		return ev.OpenRegistryKey.Registry.KeyPath, nil
 	case "open_key.registry.key_path.length":
 		return len(ev.OpenRegistryKey.Registry.KeyPath), nil
+	case "process.ancestors.ancestors.exec_count":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, &element.ProcessContext)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.cmdline":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -2262,6 +2595,8 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 			ptr = iterator.Next()
 		}
 		return values, nil
+	case "process.ancestors.exec_count":
+		return ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, ev.BaseEvent.ProcessContext), nil
 	case "process.ancestors.file.name":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -2275,7 +2610,7 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		}
 		return values, nil
 	case "process.ancestors.file.name.length":
-		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent)), nil
 	case "process.ancestors.file.path":
 		var values []string
 		ctx := eval.NewContext(ev)
@@ -2289,11 +2624,47 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		}
 		return values, nil
 	case "process.ancestors.file.path.length":
-		return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.FileEvent)), nil
+	case "process.ancestors.file_events_count":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessFileEventsCount(ev, &element.ProcessContext)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
+	case "process.ancestors.has_ancestors":
+		var values []bool
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessHasAncestors(ev, &element.ProcessContext)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.length":
 		ctx := eval.NewContext(ev)
 		iterator := &ProcessAncestorsIterator{}
 		return iterator.Len(ctx), nil
+	case "process.ancestors.metadata_change_count":
+		var values []int
+		ctx := eval.NewContext(ev)
+		iterator := &ProcessAncestorsIterator{}
+		ptr := iterator.Front(ctx)
+		for ptr != nil {
+			element := ptr
+			result := ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, &element.ProcessContext)
+			values = append(values, result)
+			ptr = iterator.Next()
+		}
+		return values, nil
 	case "process.ancestors.pid":
 		var values []int
 		ctx := eval.NewContext(ev)
@@ -2355,11 +2726,17 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 	case "process.file.name":
 		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent), nil
 	case "process.file.name.length":
-		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)), nil
 	case "process.file.path":
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent), nil
 	case "process.file.path.length":
-		return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent), nil
+		return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)), nil
+	case "process.file_events_count":
+		return ev.FieldHandlers.ResolveProcessFileEventsCount(ev, ev.BaseEvent.ProcessContext), nil
+	case "process.has_ancestors":
+		return ev.FieldHandlers.ResolveProcessHasAncestors(ev, ev.BaseEvent.ProcessContext), nil
+	case "process.metadata_change_count":
+		return ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, ev.BaseEvent.ProcessContext), nil
 	case "process.parent.cmdline":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
@@ -2391,14 +2768,20 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 		}
 		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent), nil
 	case "process.parent.file.name.length":
-		return ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent), nil
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)), nil
 	case "process.parent.file.path":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return "", &eval.ErrNotSupported{Field: field}
 		}
 		return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent), nil
 	case "process.parent.file.path.length":
-		return ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent), nil
+		if !ev.BaseEvent.ProcessContext.HasParent() {
+			return 0, &eval.ErrNotSupported{Field: field}
+		}
+		return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)), nil
 	case "process.parent.pid":
 		if !ev.BaseEvent.ProcessContext.HasParent() {
 			return 0, &eval.ErrNotSupported{Field: field}
@@ -2430,27 +2813,27 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 	case "rename.file.destination.device_path":
 		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.RenameFile.New), nil
 	case "rename.file.destination.device_path.length":
-		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.RenameFile.New), nil
+		return len(ev.FieldHandlers.ResolveFimFilePath(ev, &ev.RenameFile.New)), nil
 	case "rename.file.destination.name":
 		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.RenameFile.New), nil
 	case "rename.file.destination.name.length":
-		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.RenameFile.New), nil
+		return len(ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.RenameFile.New)), nil
 	case "rename.file.destination.path":
 		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.RenameFile.New), nil
 	case "rename.file.destination.path.length":
-		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.RenameFile.New), nil
+		return len(ev.FieldHandlers.ResolveFileUserPath(ev, &ev.RenameFile.New)), nil
 	case "rename.file.device_path":
 		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.RenameFile.Old), nil
 	case "rename.file.device_path.length":
-		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.RenameFile.Old), nil
+		return len(ev.FieldHandlers.ResolveFimFilePath(ev, &ev.RenameFile.Old)), nil
 	case "rename.file.name":
 		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.RenameFile.Old), nil
 	case "rename.file.name.length":
-		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.RenameFile.Old), nil
+		return len(ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.RenameFile.Old)), nil
 	case "rename.file.path":
 		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.RenameFile.Old), nil
 	case "rename.file.path.length":
-		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.RenameFile.Old), nil
+		return len(ev.FieldHandlers.ResolveFileUserPath(ev, &ev.RenameFile.Old)), nil
 	case "set.registry.key_name":
 		return ev.SetRegistryKeyValue.Registry.KeyName, nil
 	case "set.registry.key_name.length":
@@ -2482,15 +2865,15 @@ func (ev *Event) GetFieldValue(field eval.Field) (interface{}, error) {
 	case "write.file.device_path":
 		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.WriteFile.File), nil
 	case "write.file.device_path.length":
-		return ev.FieldHandlers.ResolveFimFilePath(ev, &ev.WriteFile.File), nil
+		return len(ev.FieldHandlers.ResolveFimFilePath(ev, &ev.WriteFile.File)), nil
 	case "write.file.name":
 		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.WriteFile.File), nil
 	case "write.file.name.length":
-		return ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.WriteFile.File), nil
+		return len(ev.FieldHandlers.ResolveFimFileBasename(ev, &ev.WriteFile.File)), nil
 	case "write.file.path":
 		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.WriteFile.File), nil
 	case "write.file.path.length":
-		return ev.FieldHandlers.ResolveFileUserPath(ev, &ev.WriteFile.File), nil
+		return len(ev.FieldHandlers.ResolveFileUserPath(ev, &ev.WriteFile.File)), nil
 	}
 	return nil, &eval.ErrFieldNotFound{Field: field}
 }
@@ -2574,6 +2957,8 @@ func (ev *Event) GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kin
 		return "delete_key", reflect.Int, nil
 	case "event.hostname":
 		return "", reflect.String, nil
+	case "event.is_metadata_only":
+		return "", reflect.Bool, nil
 	case "event.origin":
 		return "", reflect.String, nil
 	case "event.os":
@@ -2654,6 +3039,8 @@ func (ev *Event) GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kin
 		return "open_key", reflect.String, nil
 	case "open_key.registry.key_path.length":
 		return "open_key", reflect.Int, nil
+	case "process.ancestors.ancestors.exec_count":
+		return "", reflect.Int, nil
 	case "process.ancestors.cmdline":
 		return "", reflect.String, nil
 	case "process.ancestors.container.id":
@@ -2664,6 +3051,8 @@ func (ev *Event) GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kin
 		return "", reflect.String, nil
 	case "process.ancestors.envs":
 		return "", reflect.String, nil
+	case "process.ancestors.exec_count":
+		return "", reflect.Int, nil
 	case "process.ancestors.file.name":
 		return "", reflect.String, nil
 	case "process.ancestors.file.name.length":
@@ -2672,8 +3061,14 @@ func (ev *Event) GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kin
 		return "", reflect.String, nil
 	case "process.ancestors.file.path.length":
 		return "", reflect.Int, nil
+	case "process.ancestors.file_events_count":
+		return "", reflect.Int, nil
+	case "process.ancestors.has_ancestors":
+		return "", reflect.Bool, nil
 	case "process.ancestors.length":
 		return "", reflect.Int, nil
+	case "process.ancestors.metadata_change_count":
+		return "", reflect.Int, nil
 	case "process.ancestors.pid":
 		return "", reflect.Int, nil
 	case "process.ancestors.ppid":
@@ -2700,6 +3095,12 @@ func (ev *Event) GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kin
 		return "", reflect.String, nil
 	case "process.file.path.length":
 		return "", reflect.Int, nil
+	case "process.file_events_count":
+		return "", reflect.Int, nil
+	case "process.has_ancestors":
+		return "", reflect.Bool, nil
+	case "process.metadata_change_count":
+		return "", reflect.Int, nil
 	case "process.parent.cmdline":
 		return "", reflect.String, nil
 	case "process.parent.container.id":
@@ -2801,6 +3202,220 @@ func (ev *Event) GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kin
 	}
 	return "", reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
 }
+
+// IsArrayField returns whether the value of the given field is an array, its kind, as reported by GetFieldMetadata,
+// being then the kind of the elements of the array
+func (ev *Event) IsArrayField(field eval.Field) (bool, error) {
+	switch field {
+	case "container.tags":
+		return true, nil
+	case "exec.envp":
+		return true, nil
+	case "exec.envs":
+		return true, nil
+	case "exit.envp":
+		return true, nil
+	case "exit.envs":
+		return true, nil
+	case "process.ancestors.ancestors.exec_count":
+		return true, nil
+	case "process.ancestors.cmdline":
+		return true, nil
+	case "process.ancestors.container.id":
+		return true, nil
+	case "process.ancestors.created_at":
+		return true, nil
+	case "process.ancestors.envp":
+		return true, nil
+	case "process.ancestors.envs":
+		return true, nil
+	case "process.ancestors.file.name":
+		return true, nil
+	case "process.ancestors.file.name.length":
+		return true, nil
+	case "process.ancestors.file.path":
+		return true, nil
+	case "process.ancestors.file.path.length":
+		return true, nil
+	case "process.ancestors.file_events_count":
+		return true, nil
+	case "process.ancestors.has_ancestors":
+		return true, nil
+	case "process.ancestors.metadata_change_count":
+		return true, nil
+	case "process.ancestors.pid":
+		return true, nil
+	case "process.ancestors.ppid":
+		return true, nil
+	case "process.ancestors.user":
+		return true, nil
+	case "process.ancestors.user_sid":
+		return true, nil
+	case "process.envp":
+		return true, nil
+	case "process.envs":
+		return true, nil
+	case "process.parent.envp":
+		return true, nil
+	case "process.parent.envs":
+		return true, nil
+	}
+	if _, _, err := ev.GetFieldMetadata(field); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// GetFieldIntKind returns the kind of the Go type holding the value of the given int field, like reflect.Uint16,
+// reflect.Invalid for the other fields
+func (ev *Event) GetFieldIntKind(field eval.Field) reflect.Kind {
+	switch field {
+	case "container.created_at":
+		return reflect.Uint64
+	case "create.file.device_path.length":
+		return reflect.Int
+	case "create.file.name.length":
+		return reflect.Int
+	case "create.file.path.length":
+		return reflect.Int
+	case "create.registry.key_name.length":
+		return reflect.Int
+	case "create.registry.key_path.length":
+		return reflect.Int
+	case "create_key.registry.key_name.length":
+		return reflect.Int
+	case "create_key.registry.key_path.length":
+		return reflect.Int
+	case "delete.file.device_path.length":
+		return reflect.Int
+	case "delete.file.name.length":
+		return reflect.Int
+	case "delete.file.path.length":
+		return reflect.Int
+	case "delete.registry.key_name.length":
+		return reflect.Int
+	case "delete.registry.key_path.length":
+		return reflect.Int
+	case "delete_key.registry.key_name.length":
+		return reflect.Int
+	case "delete_key.registry.key_path.length":
+		return reflect.Int
+	case "event.timestamp":
+		return reflect.Uint64
+	case "exec.created_at":
+		return reflect.Uint64
+	case "exec.file.name.length":
+		return reflect.Int
+	case "exec.file.path.length":
+		return reflect.Int
+	case "exec.pid":
+		return reflect.Uint32
+	case "exec.ppid":
+		return reflect.Uint32
+	case "exit.cause":
+		return reflect.Uint32
+	case "exit.code":
+		return reflect.Uint32
+	case "exit.created_at":
+		return reflect.Uint64
+	case "exit.file.name.length":
+		return reflect.Int
+	case "exit.file.path.length":
+		return reflect.Int
+	case "exit.pid":
+		return reflect.Uint32
+	case "exit.ppid":
+		return reflect.Uint32
+	case "open.registry.key_name.length":
+		return reflect.Int
+	case "open.registry.key_path.length":
+		return reflect.Int
+	case "open_key.registry.key_name.length":
+		return reflect.Int
+	case "open_key.registry.key_path.length":
+		return reflect.Int
+	case "process.ancestors.ancestors.exec_count":
+		return reflect.Int
+	case "process.ancestors.created_at":
+		return reflect.Uint64
+	case "process.ancestors.exec_count":
+		return reflect.Int
+	case "process.ancestors.file.name.length":
+		return reflect.Int
+	case "process.ancestors.file.path.length":
+		return reflect.Int
+	case "process.ancestors.file_events_count":
+		return reflect.Int
+	case "process.ancestors.length":
+		return reflect.Int
+	case "process.ancestors.metadata_change_count":
+		return reflect.Int
+	case "process.ancestors.pid":
+		return reflect.Uint32
+	case "process.ancestors.ppid":
+		return reflect.Uint32
+	case "process.created_at":
+		return reflect.Uint64
+	case "process.file.name.length":
+		return reflect.Int
+	case "process.file.path.length":
+		return reflect.Int
+	case "process.file_events_count":
+		return reflect.Int
+	case "process.metadata_change_count":
+		return reflect.Int
+	case "process.parent.created_at":
+		return reflect.Uint64
+	case "process.parent.file.name.length":
+		return reflect.Int
+	case "process.parent.file.path.length":
+		return reflect.Int
+	case "process.parent.pid":
+		return reflect.Uint32
+	case "process.parent.ppid":
+		return reflect.Uint32
+	case "process.pid":
+		return reflect.Uint32
+	case "process.ppid":
+		return reflect.Uint32
+	case "rename.file.destination.device_path.length":
+		return reflect.Int
+	case "rename.file.destination.name.length":
+		return reflect.Int
+	case "rename.file.destination.path.length":
+		return reflect.Int
+	case "rename.file.device_path.length":
+		return reflect.Int
+	case "rename.file.name.length":
+		return reflect.Int
+	case "rename.file.path.length":
+		return reflect.Int
+	case "set.registry.key_name.length":
+		return reflect.Int
+	case "set.registry.key_path.length":
+		return reflect.Int
+	case "set.registry.value_name.length":
+		return reflect.Int
+	case "set_key_value.registry.key_name.length":
+		return reflect.Int
+	case "set_key_value.registry.key_path.length":
+		return reflect.Int
+	case "set_key_value.registry.value_name.length":
+		return reflect.Int
+	case "write.file.device_path.length":
+		return reflect.Int
+	case "write.file.name.length":
+		return reflect.Int
+	case "write.file.path.length":
+		return reflect.Int
+	}
+	return reflect.Invalid
+}
+
+// SetFieldValue sets the value of the given field. The int values that don't fit in the type of the field, like the
+// negative values of the unsigned fields, are rejected with an ErrValueOutOfRange error rather than wrapped around.
+// A scalar value is appended to an array field while an array value replaces it, so that a value read with
+// GetFieldValue can be written back as is.
 func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 	switch field {
 	case "change_permission.new_sd":
@@ -2853,6 +3468,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "container.created_at"}
 		}
+		if rv < 0 {
+			return &eval.ErrValueOutOfRange{Field: "container.created_at"}
+		}
 		ev.BaseEvent.ContainerContext.CreatedAt = uint64(rv)
 		return nil
 	case "container.id":
@@ -2883,7 +3501,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.BaseEvent.ContainerContext.Tags = append(ev.BaseEvent.ContainerContext.Tags, rv)
 		case []string:
-			ev.BaseEvent.ContainerContext.Tags = append(ev.BaseEvent.ContainerContext.Tags, rv...)
+			ev.BaseEvent.ContainerContext.Tags = append(ev.BaseEvent.ContainerContext.Tags[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "container.tags"}
 		}
@@ -3021,6 +3639,13 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		}
 		ev.BaseEvent.Hostname = rv
 		return nil
+	case "event.is_metadata_only":
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "event.is_metadata_only"}
+		}
+		ev.BaseEvent.IsMetadataOnly = rv
+		return nil
 	case "event.origin":
 		rv, ok := value.(string)
 		if !ok {
@@ -3047,6 +3672,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "event.timestamp"}
 		}
+		if rv < 0 {
+			return &eval.ErrValueOutOfRange{Field: "event.timestamp"}
+		}
 		ev.BaseEvent.TimestampRaw = uint64(rv)
 		return nil
 	case "exec.cmdline":
@@ -3077,6 +3705,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exec.created_at"}
 		}
+		if rv < 0 {
+			return &eval.ErrValueOutOfRange{Field: "exec.created_at"}
+		}
 		ev.Exec.Process.CreatedAt = uint64(rv)
 		return nil
 	case "exec.envp":
@@ -3087,7 +3718,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.Exec.Process.Envp = append(ev.Exec.Process.Envp, rv)
 		case []string:
-			ev.Exec.Process.Envp = append(ev.Exec.Process.Envp, rv...)
+			ev.Exec.Process.Envp = append(ev.Exec.Process.Envp[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "exec.envp"}
 		}
@@ -3100,7 +3731,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.Exec.Process.Envs = append(ev.Exec.Process.Envs, rv)
 		case []string:
-			ev.Exec.Process.Envs = append(ev.Exec.Process.Envs, rv...)
+			ev.Exec.Process.Envs = append(ev.Exec.Process.Envs[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "exec.envs"}
 		}
@@ -3143,6 +3774,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exec.pid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "exec.pid"}
+		}
 		ev.Exec.Process.PIDContext.Pid = uint32(rv)
 		return nil
 	case "exec.ppid":
@@ -3153,6 +3787,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exec.ppid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "exec.ppid"}
+		}
 		ev.Exec.Process.PPid = uint32(rv)
 		return nil
 	case "exec.user":
@@ -3180,6 +3817,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exit.cause"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "exit.cause"}
+		}
 		ev.Exit.Cause = uint32(rv)
 		return nil
 	case "exit.cmdline":
@@ -3197,6 +3837,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exit.code"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "exit.code"}
+		}
 		ev.Exit.Code = uint32(rv)
 		return nil
 	case "exit.container.id":
@@ -3217,6 +3860,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exit.created_at"}
 		}
+		if rv < 0 {
+			return &eval.ErrValueOutOfRange{Field: "exit.created_at"}
+		}
 		ev.Exit.Process.CreatedAt = uint64(rv)
 		return nil
 	case "exit.envp":
@@ -3227,7 +3873,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.Exit.Process.Envp = append(ev.Exit.Process.Envp, rv)
 		case []string:
-			ev.Exit.Process.Envp = append(ev.Exit.Process.Envp, rv...)
+			ev.Exit.Process.Envp = append(ev.Exit.Process.Envp[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "exit.envp"}
 		}
@@ -3240,7 +3886,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.Exit.Process.Envs = append(ev.Exit.Process.Envs, rv)
 		case []string:
-			ev.Exit.Process.Envs = append(ev.Exit.Process.Envs, rv...)
+			ev.Exit.Process.Envs = append(ev.Exit.Process.Envs[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "exit.envs"}
 		}
@@ -3283,6 +3929,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exit.pid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "exit.pid"}
+		}
 		ev.Exit.Process.PIDContext.Pid = uint32(rv)
 		return nil
 	case "exit.ppid":
@@ -3293,6 +3942,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "exit.ppid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "exit.ppid"}
+		}
 		ev.Exit.Process.PPid = uint32(rv)
 		return nil
 	case "exit.user":
@@ -3351,6 +4003,19 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		return nil
 	case "open_key.registry.key_path.length":
 		return &eval.ErrFieldReadOnly{Field: "open_key.registry.key_path.length"}
+	case "process.ancestors.ancestors.exec_count":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.ancestors.exec_count"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.AncestorsExecCount = int(rv)
+		return nil
 	case "process.ancestors.cmdline":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -3388,6 +4053,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.created_at"}
 		}
+		if rv < 0 {
+			return &eval.ErrValueOutOfRange{Field: "process.ancestors.created_at"}
+		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.CreatedAt = uint64(rv)
 		return nil
 	case "process.ancestors.envp":
@@ -3401,7 +4069,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envp = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envp, rv)
 		case []string:
-			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envp = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envp, rv...)
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envp = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envp[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.envp"}
 		}
@@ -3417,11 +4085,21 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envs = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envs, rv)
 		case []string:
-			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envs = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envs, rv...)
+			ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envs = append(ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.Envs[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.envs"}
 		}
 		return nil
+	case "process.ancestors.exec_count":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.exec_count"}
+		}
+		ev.BaseEvent.ProcessContext.AncestorsExecCount = int(rv)
+		return nil
 	case "process.ancestors.file.name":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -3464,6 +4142,32 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
 		}
 		return &eval.ErrFieldReadOnly{Field: "process.ancestors.file.path.length"}
+	case "process.ancestors.file_events_count":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.file_events_count"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.FileEventsCount = int(rv)
+		return nil
+	case "process.ancestors.has_ancestors":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.has_ancestors"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.HasAncestors = rv
+		return nil
 	case "process.ancestors.length":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -3472,6 +4176,19 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
 		}
 		return &eval.ErrFieldReadOnly{Field: "process.ancestors.length"}
+	case "process.ancestors.metadata_change_count":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		if ev.BaseEvent.ProcessContext.Ancestor == nil {
+			ev.BaseEvent.ProcessContext.Ancestor = &ProcessCacheEntry{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.metadata_change_count"}
+		}
+		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.MetadataChangeCount = int(rv)
+		return nil
 	case "process.ancestors.pid":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -3483,6 +4200,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.pid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "process.ancestors.pid"}
+		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.PIDContext.Pid = uint32(rv)
 		return nil
 	case "process.ancestors.ppid":
@@ -3496,6 +4216,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.ancestors.ppid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "process.ancestors.ppid"}
+		}
 		ev.BaseEvent.ProcessContext.Ancestor.ProcessContext.Process.PPid = uint32(rv)
 		return nil
 	case "process.ancestors.user":
@@ -3552,6 +4275,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.created_at"}
 		}
+		if rv < 0 {
+			return &eval.ErrValueOutOfRange{Field: "process.created_at"}
+		}
 		ev.BaseEvent.ProcessContext.Process.CreatedAt = uint64(rv)
 		return nil
 	case "process.envp":
@@ -3562,7 +4288,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.BaseEvent.ProcessContext.Process.Envp = append(ev.BaseEvent.ProcessContext.Process.Envp, rv)
 		case []string:
-			ev.BaseEvent.ProcessContext.Process.Envp = append(ev.BaseEvent.ProcessContext.Process.Envp, rv...)
+			ev.BaseEvent.ProcessContext.Process.Envp = append(ev.BaseEvent.ProcessContext.Process.Envp[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "process.envp"}
 		}
@@ -3575,7 +4301,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.BaseEvent.ProcessContext.Process.Envs = append(ev.BaseEvent.ProcessContext.Process.Envs, rv)
 		case []string:
-			ev.BaseEvent.ProcessContext.Process.Envs = append(ev.BaseEvent.ProcessContext.Process.Envs, rv...)
+			ev.BaseEvent.ProcessContext.Process.Envs = append(ev.BaseEvent.ProcessContext.Process.Envs[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "process.envs"}
 		}
@@ -3610,6 +4336,36 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
 		}
 		return &eval.ErrFieldReadOnly{Field: "process.file.path.length"}
+	case "process.file_events_count":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.file_events_count"}
+		}
+		ev.BaseEvent.ProcessContext.FileEventsCount = int(rv)
+		return nil
+	case "process.has_ancestors":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(bool)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.has_ancestors"}
+		}
+		ev.BaseEvent.ProcessContext.HasAncestors = rv
+		return nil
+	case "process.metadata_change_count":
+		if ev.BaseEvent.ProcessContext == nil {
+			ev.BaseEvent.ProcessContext = &ProcessContext{}
+		}
+		rv, ok := value.(int)
+		if !ok {
+			return &eval.ErrValueTypeMismatch{Field: "process.metadata_change_count"}
+		}
+		ev.BaseEvent.ProcessContext.MetadataChangeCount = int(rv)
+		return nil
 	case "process.parent.cmdline":
 		if ev.BaseEvent.ProcessContext == nil {
 			ev.BaseEvent.ProcessContext = &ProcessContext{}
@@ -3647,6 +4403,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.parent.created_at"}
 		}
+		if rv < 0 {
+			return &eval.ErrValueOutOfRange{Field: "process.parent.created_at"}
+		}
 		ev.BaseEvent.ProcessContext.Parent.CreatedAt = uint64(rv)
 		return nil
 	case "process.parent.envp":
@@ -3660,7 +4419,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.BaseEvent.ProcessContext.Parent.Envp = append(ev.BaseEvent.ProcessContext.Parent.Envp, rv)
 		case []string:
-			ev.BaseEvent.ProcessContext.Parent.Envp = append(ev.BaseEvent.ProcessContext.Parent.Envp, rv...)
+			ev.BaseEvent.ProcessContext.Parent.Envp = append(ev.BaseEvent.ProcessContext.Parent.Envp[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "process.parent.envp"}
 		}
@@ -3676,7 +4435,7 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		case string:
 			ev.BaseEvent.ProcessContext.Parent.Envs = append(ev.BaseEvent.ProcessContext.Parent.Envs, rv)
 		case []string:
-			ev.BaseEvent.ProcessContext.Parent.Envs = append(ev.BaseEvent.ProcessContext.Parent.Envs, rv...)
+			ev.BaseEvent.ProcessContext.Parent.Envs = append(ev.BaseEvent.ProcessContext.Parent.Envs[:0:0], rv...)
 		default:
 			return &eval.ErrValueTypeMismatch{Field: "process.parent.envs"}
 		}
@@ -3734,6 +4493,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.parent.pid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "process.parent.pid"}
+		}
 		ev.BaseEvent.ProcessContext.Parent.PIDContext.Pid = uint32(rv)
 		return nil
 	case "process.parent.ppid":
@@ -3747,6 +4509,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.parent.ppid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "process.parent.ppid"}
+		}
 		ev.BaseEvent.ProcessContext.Parent.PPid = uint32(rv)
 		return nil
 	case "process.parent.user":
@@ -3783,6 +4548,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.pid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "process.pid"}
+		}
 		ev.BaseEvent.ProcessContext.Process.PIDContext.Pid = uint32(rv)
 		return nil
 	case "process.ppid":
@@ -3793,6 +4561,9 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 		if !ok {
 			return &eval.ErrValueTypeMismatch{Field: "process.ppid"}
 		}
+		if rv < 0 || int64(rv) > math.MaxUint32 {
+			return &eval.ErrValueOutOfRange{Field: "process.ppid"}
+		}
 		ev.BaseEvent.ProcessContext.Process.PPid = uint32(rv)
 		return nil
 	case "process.user":
@@ -3965,5 +4736,8 @@ func (ev *Event) SetFieldValue(field eval.Field, value interface{}) error {
 	case "write.file.path.length":
 		return &eval.ErrFieldReadOnly{Field: "write.file.path.length"}
 	}
+	if ancestorEvent, ancestorField, ok := ev.indexedAncestorField(field); ok {
+		return ancestorEvent.SetFieldValue(ancestorField, value)
+	}
 	return &eval.ErrFieldNotFound{Field: field}
 }
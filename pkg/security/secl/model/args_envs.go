@@ -9,6 +9,8 @@ package model
 import (
 	"slices"
 	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 )
 
 const (
@@ -29,6 +31,12 @@ type ArgsEnvs struct {
 type ArgsEntry struct {
 	Values    []string
 	Truncated bool
+
+	hasShellMetachars         bool
+	hasShellMetacharsResolved bool
+
+	distinctCount         int
+	distinctCountResolved bool
 }
 
 // Equals compares two ArgsEntry
@@ -42,6 +50,79 @@ func (p *ArgsEntry) Equals(o *ArgsEntry) bool {
 	return slices.Equal(p.Values, o.Values)
 }
 
+// HasShellMetachars returns whether one of the arguments, argv0 excluded, contains a shell metacharacter of the given
+// matcher, the arguments being scanned only once per entry
+func (p *ArgsEntry) HasShellMetachars(matcher *ShellMetacharsMatcher) bool {
+	if !p.hasShellMetacharsResolved {
+		args := p.Values
+		if len(args) > 0 {
+			args = args[1:]
+		}
+		p.hasShellMetachars = matcher.MatchesAny(args)
+		p.hasShellMetacharsResolved = true
+	}
+	return p.hasShellMetachars
+}
+
+// DistinctCount returns the number of distinct arguments, argv0 excluded, the arguments being deduplicated only once
+// per entry
+func (p *ArgsEntry) DistinctCount() int {
+	if !p.distinctCountResolved {
+		args := p.Values
+		if len(args) > 0 {
+			args = args[1:]
+		}
+		p.distinctCount = CountDistinctArgs(args)
+		p.distinctCountResolved = true
+	}
+	return p.distinctCount
+}
+
+// CountDistinctArgs returns the number of distinct values of the given arguments
+func CountDistinctArgs(args []string) int {
+	if len(args) < 2 {
+		return len(args)
+	}
+
+	distinct := make(map[string]struct{}, len(args))
+	for _, arg := range args {
+		distinct[arg] = struct{}{}
+	}
+	return len(distinct)
+}
+
+// ShellMetacharsMatcher matches arguments against shell metacharacters usually found in command injection payloads,
+// like backticks, `$(`, `;` or `|`
+type ShellMetacharsMatcher struct {
+	metachars []string
+}
+
+// NewShellMetacharsMatcher returns a new matcher for the given metacharacters, each one being a character or a
+// sequence of characters
+func NewShellMetacharsMatcher(metachars []string) *ShellMetacharsMatcher {
+	return &ShellMetacharsMatcher{
+		metachars: slices.DeleteFunc(slices.Clone(metachars), func(metachar string) bool {
+			return metachar == ""
+		}),
+	}
+}
+
+// MatchesAny returns whether one of the given arguments contains one of the metacharacters
+func (m *ShellMetacharsMatcher) MatchesAny(args []string) bool {
+	if m == nil {
+		return false
+	}
+
+	for _, arg := range args {
+		for _, metachar := range m.metachars {
+			if strings.Contains(arg, metachar) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // EnvsEntry defines a args cache entry
 type EnvsEntry struct {
 	Values    []string
@@ -49,6 +130,12 @@ type EnvsEntry struct {
 
 	filteredEnvs []string
 	kv           map[string]string
+
+	hasSecretLike         bool
+	hasSecretLikeResolved bool
+
+	distinctCount         int
+	distinctCountResolved bool
 }
 
 // FilterEnvs returns an array of envs, only the name of each variable is returned unless the variable name is part of the provided filter
@@ -118,6 +205,13 @@ func (p *EnvsEntry) Get(key string) string {
 	return p.kv[key]
 }
 
+// Has returns whether the given key is defined
+func (p *EnvsEntry) Has(key string) bool {
+	p.toMap()
+	_, exists := p.kv[key]
+	return exists
+}
+
 // Equals compares two EnvsEntry
 func (p *EnvsEntry) Equals(o *EnvsEntry) bool {
 	if p == o {
@@ -128,3 +222,75 @@ func (p *EnvsEntry) Equals(o *EnvsEntry) bool {
 
 	return slices.Equal(p.Values, o.Values)
 }
+
+// HasSecretLike returns whether one of the environment variable names matches the given matcher, the names being
+// matched only once per entry
+func (p *EnvsEntry) HasSecretLike(matcher *SecretLikeEnvMatcher) bool {
+	if !p.hasSecretLikeResolved {
+		p.hasSecretLike = matcher.MatchesAny(p.Values)
+		p.hasSecretLikeResolved = true
+	}
+	return p.hasSecretLike
+}
+
+// DistinctCount returns the number of distinct environment variable names, the names being deduplicated only once per
+// entry
+func (p *EnvsEntry) DistinctCount() int {
+	if !p.distinctCountResolved {
+		p.distinctCount = CountDistinctEnvs(p.Values)
+		p.distinctCountResolved = true
+	}
+	return p.distinctCount
+}
+
+// CountDistinctEnvs returns the number of distinct names of the given environment variables, with or without their
+// values
+func CountDistinctEnvs(envs []string) int {
+	if len(envs) < 2 {
+		return len(envs)
+	}
+
+	distinct := make(map[string]struct{}, len(envs))
+	for _, env := range envs {
+		name, _, _ := strings.Cut(env, "=")
+		distinct[name] = struct{}{}
+	}
+	return len(distinct)
+}
+
+// SecretLikeEnvMatcher matches environment variable names against patterns of names usually holding credentials,
+// like `*_TOKEN` or `*_SECRET`
+type SecretLikeEnvMatcher struct {
+	globs []*eval.Glob
+}
+
+// NewSecretLikeEnvMatcher returns a new matcher for the given name patterns, matched case insensitively
+func NewSecretLikeEnvMatcher(patterns []string) (*SecretLikeEnvMatcher, error) {
+	var matcher SecretLikeEnvMatcher
+	for _, pattern := range patterns {
+		glob, err := eval.NewGlob(pattern, true, false)
+		if err != nil {
+			return nil, err
+		}
+		matcher.globs = append(matcher.globs, glob)
+	}
+	return &matcher, nil
+}
+
+// MatchesAny returns whether one of the given environment variables matches, the variables being either names
+// or `name=value` pairs
+func (m *SecretLikeEnvMatcher) MatchesAny(envs []string) bool {
+	if m == nil {
+		return false
+	}
+
+	for _, env := range envs {
+		name, _, _ := strings.Cut(env, "=")
+		for _, glob := range m.globs {
+			if glob.Matches(name) {
+				return true
+			}
+		}
+	}
+	return false
+}
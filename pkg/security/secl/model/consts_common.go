@@ -24,6 +24,9 @@ const (
 	// see pkg/security/ebpf/c/dentry_resolver.h: DR_MAX_TAIL_CALL * DR_MAX_ITERATION_DEPTH
 	MaxPathDepth = 1363
 
+	// MaxCommLength defines the maximum length of a process comm, TASK_COMM_LEN minus the trailing NUL byte
+	MaxCommLength = 15
+
 	// MaxBpfObjName defines the maximum length of a Bpf object name
 	MaxBpfObjName = 16
 
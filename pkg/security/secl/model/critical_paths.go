@@ -0,0 +1,66 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"path"
+	"sync"
+)
+
+// DefaultCriticalPaths lists the paths matched by the `*.file.is_critical` fields by default
+var DefaultCriticalPaths = []string{
+	"/etc/passwd",
+	"/etc/shadow",
+	"/etc/group",
+	"/etc/gshadow",
+	"/etc/sudoers",
+	"/etc/ssh/sshd_config",
+	"/etc/pam.conf",
+	"/etc/ld.so.preload",
+	"/etc/crontab",
+}
+
+// CriticalPathSet holds a set of critical paths, matched with a single lookup per event
+type CriticalPathSet struct {
+	sync.RWMutex
+	paths map[string]struct{}
+}
+
+// NewCriticalPathSet returns a new set holding the given paths
+func NewCriticalPathSet(paths ...string) *CriticalPathSet {
+	s := &CriticalPathSet{
+		paths: make(map[string]struct{}, len(paths)),
+	}
+	s.Add(paths...)
+	return s
+}
+
+// Add registers the given paths as critical
+func (s *CriticalPathSet) Add(paths ...string) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, p := range paths {
+		if p == "" {
+			continue
+		}
+		s.paths[path.Clean(p)] = struct{}{}
+	}
+}
+
+// Contains returns whether the given path is critical
+func (s *CriticalPathSet) Contains(p string) bool {
+	if s == nil || p == "" {
+		return false
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	_, found := s.paths[p]
+	return found
+}
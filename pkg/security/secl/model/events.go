@@ -143,6 +143,15 @@ const (
 	MaxAllEventType
 )
 
+// IsMetadataOnly returns whether the events of this type only change the metadata of a file, without touching its data
+func (t EventType) IsMetadataOnly() bool {
+	switch t {
+	case FileChmodEventType, FileChownEventType, FileUtimesEventType, FileSetXAttrEventType, FileRemoveXAttrEventType, ChangePermissionEventType:
+		return true
+	}
+	return false
+}
+
 func (t EventType) String() string {
 	switch t {
 	case FileOpenEventType:
@@ -322,6 +322,11 @@ func (ev *Event) GetEventHostname() string {
 	return ev.FieldHandlers.ResolveHostname(ev, &ev.BaseEvent)
 }
 
+// GetEventIsMetadataOnly returns the value of the field, resolving if necessary
+func (ev *Event) GetEventIsMetadataOnly() bool {
+	return ev.FieldHandlers.ResolveEventIsMetadataOnly(ev, &ev.BaseEvent)
+}
+
 // GetEventOrigin returns the value of the field, resolving if necessary
 func (ev *Event) GetEventOrigin() string {
 	return ev.BaseEvent.Origin
@@ -774,6 +779,27 @@ func (ev *Event) GetOpenKeyRegistryKeyPathLength() int {
 	return len(ev.OpenRegistryKey.Registry.KeyPath)
 }
 
+// GetProcessAncestorsAncestorsExecCount returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsAncestorsExecCount() []int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []int{}
+	}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, &element.ProcessContext)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetProcessAncestorsCmdline returns the value of the field, resolving if necessary
 func (ev *Event) GetProcessAncestorsCmdline() []string {
 	if ev.BaseEvent.ProcessContext == nil {
@@ -900,6 +926,14 @@ func (ev *Event) GetProcessAncestorsEnvs() []string {
 	return values
 }
 
+// GetProcessAncestorsExecCount returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsExecCount() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, ev.BaseEvent.ProcessContext)
+}
+
 // GetProcessAncestorsFileName returns the value of the field, resolving if necessary
 func (ev *Event) GetProcessAncestorsFileName() []string {
 	if ev.BaseEvent.ProcessContext == nil {
@@ -984,6 +1018,48 @@ func (ev *Event) GetProcessAncestorsFilePathLength() []int {
 	return values
 }
 
+// GetProcessAncestorsFileEventsCount returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsFileEventsCount() []int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []int{}
+	}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessFileEventsCount(ev, &element.ProcessContext)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
+// GetProcessAncestorsHasAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsHasAncestors() []bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []bool{}
+	}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []bool{}
+	}
+	var values []bool
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessHasAncestors(ev, &element.ProcessContext)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetProcessAncestorsLength returns the value of the field, resolving if necessary
 func (ev *Event) GetProcessAncestorsLength() int {
 	if ev.BaseEvent.ProcessContext == nil {
@@ -997,6 +1073,27 @@ func (ev *Event) GetProcessAncestorsLength() int {
 	return iterator.Len(ctx)
 }
 
+// GetProcessAncestorsMetadataChangeCount returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessAncestorsMetadataChangeCount() []int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return []int{}
+	}
+	if ev.BaseEvent.ProcessContext.Ancestor == nil {
+		return []int{}
+	}
+	var values []int
+	ctx := eval.NewContext(ev)
+	iterator := &ProcessAncestorsIterator{}
+	ptr := iterator.Front(ctx)
+	for ptr != nil {
+		element := (*ProcessCacheEntry)(ptr)
+		result := ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, &element.ProcessContext)
+		values = append(values, result)
+		ptr = iterator.Next()
+	}
+	return values
+}
+
 // GetProcessAncestorsPid returns the value of the field, resolving if necessary
 func (ev *Event) GetProcessAncestorsPid() []uint32 {
 	if ev.BaseEvent.ProcessContext == nil {
@@ -1177,6 +1274,30 @@ func (ev *Event) GetProcessFilePathLength() int {
 	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent))
 }
 
+// GetProcessFileEventsCount returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessFileEventsCount() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessFileEventsCount(ev, ev.BaseEvent.ProcessContext)
+}
+
+// GetProcessHasAncestors returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessHasAncestors() bool {
+	if ev.BaseEvent.ProcessContext == nil {
+		return false
+	}
+	return ev.FieldHandlers.ResolveProcessHasAncestors(ev, ev.BaseEvent.ProcessContext)
+}
+
+// GetProcessMetadataChangeCount returns the value of the field, resolving if necessary
+func (ev *Event) GetProcessMetadataChangeCount() int {
+	if ev.BaseEvent.ProcessContext == nil {
+		return 0
+	}
+	return ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, ev.BaseEvent.ProcessContext)
+}
+
 // GetProcessParentCmdline returns the value of the field, resolving if necessary
 func (ev *Event) GetProcessParentCmdline() string {
 	if ev.BaseEvent.ProcessContext == nil {
@@ -1283,6 +1404,9 @@ func (ev *Event) GetProcessParentFileNameLength() int {
 	if ev.BaseEvent.ProcessContext.Parent == nil {
 		return 0
 	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
 	return len(ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
 }
 
@@ -1308,6 +1432,9 @@ func (ev *Event) GetProcessParentFilePathLength() int {
 	if ev.BaseEvent.ProcessContext.Parent == nil {
 		return 0
 	}
+	if !ev.BaseEvent.ProcessContext.HasParent() {
+		return 0
+	}
 	return len(ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent))
 }
 
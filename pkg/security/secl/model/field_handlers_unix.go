@@ -34,6 +34,7 @@ func (ev *Event) resolveFields(forADs bool) {
 	}
 	_ = ev.FieldHandlers.ResolveAsync(ev)
 	_ = ev.FieldHandlers.ResolveHostname(ev, &ev.BaseEvent)
+	_ = ev.FieldHandlers.ResolveEventIsMetadataOnly(ev, &ev.BaseEvent)
 	if !forADs {
 		_ = ev.FieldHandlers.ResolveService(ev, &ev.BaseEvent)
 	}
@@ -41,20 +42,36 @@ func (ev *Event) resolveFields(forADs bool) {
 	_ = ev.FieldHandlers.ResolveIsIPPublic(ev, &ev.NetworkContext.Destination)
 	_ = ev.FieldHandlers.ResolveNetworkDeviceIfName(ev, &ev.NetworkContext.Device)
 	_ = ev.FieldHandlers.ResolveIsIPPublic(ev, &ev.NetworkContext.Source)
+	_ = ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, ev.BaseEvent.ProcessContext)
 	if !forADs {
 		_ = ev.FieldHandlers.ResolveProcessArgs(ev, &ev.BaseEvent.ProcessContext.Process)
 	}
+	_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessArgsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessArgv(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessArgv0(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 	_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
 	_ = ev.FieldHandlers.ResolveCGroupVersion(ev, &ev.BaseEvent.ProcessContext.Process.CGroup)
+	if !forADs {
+		_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessCwd(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
 	_ = ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 	}
@@ -66,12 +83,51 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 		}
 	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
+	}
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 	}
@@ -81,12 +137,36 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, &ev.BaseEvent.ProcessContext.Process)
+	}
 	if ev.BaseEvent.ProcessContext.Process.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent.FileFields)
 	}
+	_ = ev.FieldHandlers.ResolveProcessFileEventsCount(ev, ev.BaseEvent.ProcessContext)
+	_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
+	_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
+	_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
+	_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
+	_ = ev.FieldHandlers.ResolveProcessHasAncestors(ev, ev.BaseEvent.ProcessContext)
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 	}
@@ -98,9 +178,21 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 		}
 	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
+	}
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 	}
@@ -113,18 +205,41 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.Process.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Process.LinuxBinprm.FileEvent.FileFields)
 	}
+	_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessIsInit(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, ev.BaseEvent.ProcessContext)
+	_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.BaseEvent.ProcessContext.Process.PIDContext)
+	_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.BaseEvent.ProcessContext.Process.PIDContext)
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveProcessArgs(ev, ev.BaseEvent.ProcessContext.Parent)
 		}
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessArgsTruncated(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
@@ -143,21 +258,50 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveCGroupVersion(ev, &ev.BaseEvent.ProcessContext.Parent.CGroup)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		if !forADs {
+			_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, ev.BaseEvent.ProcessContext.Parent)
+		}
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessCwd(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 	}
@@ -169,12 +313,51 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 		}
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 	}
@@ -184,12 +367,42 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
+		_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.IsNotKworker() {
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Parent.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
 	}
@@ -201,9 +414,21 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
 		}
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
 	}
@@ -216,15 +441,51 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() && ev.BaseEvent.ProcessContext.Parent.HasInterpreter() {
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.BaseEvent.ProcessContext.Parent.LinuxBinprm.FileEvent.FileFields)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessIsInit(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.BaseEvent.ProcessContext.Parent.PIDContext)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.BaseEvent.ProcessContext.Parent.PIDContext)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.BaseEvent.ProcessContext.Parent.PIDContext)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveProcessSessionType(ev, ev.BaseEvent.ProcessContext.Parent)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
+	if ev.BaseEvent.ProcessContext.HasParent() {
+		_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.BaseEvent.ProcessContext.Parent.Credentials)
+	}
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
 	}
@@ -234,22 +495,41 @@ func (ev *Event) resolveFields(forADs bool) {
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Parent.UserSession)
 	}
+	_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.BaseEvent.ProcessContext.Process.PIDContext)
+	_ = ev.FieldHandlers.ResolveProcessSessionType(ev, &ev.BaseEvent.ProcessContext.Process)
+	_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
+	_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.BaseEvent.ProcessContext.Process.Credentials)
 	_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
 	_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
 	_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.BaseEvent.ProcessContext.Process.UserSession)
 	// resolve event specific fields
 	switch ev.GetEventType().String() {
 	case "bind":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Bind.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Bind.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveIsIPPublic(ev, &ev.Bind.Addr)
 	case "bpf":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.BPF.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.BPF.SyscallEvent)
 	case "capset":
 	case "chdir":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Chdir.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Chdir.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Chdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Chdir.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Chdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Chdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Chdir.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Chdir.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Chdir.File)
@@ -260,12 +540,23 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Chdir.SyscallContext)
 		}
 	case "chmod":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Chmod.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Chmod.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Chmod.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Chmod.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Chmod.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Chmod.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Chmod.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Chmod.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Chmod.File)
@@ -279,12 +570,23 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsInt2(ev, &ev.Chmod.SyscallContext)
 		}
 	case "chown":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Chown.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Chown.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Chown.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Chown.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Chown.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Chown.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Chown.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Chown.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Chown.File)
@@ -293,6 +595,7 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveChownUID(ev, &ev.Chown)
 		_ = ev.FieldHandlers.ResolveChownGID(ev, &ev.Chown)
+		_ = ev.FieldHandlers.ResolveChownToRoot(ev, &ev.Chown)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Chown.SyscallContext)
 		}
@@ -303,15 +606,23 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsInt3(ev, &ev.Chown.SyscallContext)
 		}
 	case "connect":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Connect.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Connect.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveIsIPPublic(ev, &ev.Connect.Addr)
 	case "dns":
 	case "exec":
+		_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.Exec.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.Exec.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Exec.Process.PIDContext)
 		if ev.Exec.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Exec.Process.FileEvent.FileFields)
 		}
 		if ev.Exec.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Exec.Process.FileEvent.FileFields)
 		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Exec.Process.FileEvent.FileFields)
+		}
 		if ev.Exec.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Exec.Process.FileEvent.FileFields)
 		}
@@ -324,6 +635,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exec.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exec.Process.FileEvent)
 		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Exec.Process.FileEvent)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Exec.Process.FileEvent)
+		}
 		if ev.Exec.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exec.Process.FileEvent)
 		}
@@ -338,16 +673,54 @@ func (ev *Event) resolveFields(forADs bool) {
 				_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Exec.Process.FileEvent)
 			}
 		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, ev.Exec.Process)
+		}
+		if ev.Exec.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, ev.Exec.Process)
+		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.Exec.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exec.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupVersion(ev, &ev.Exec.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessSessionType(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessCwd(ev, ev.Exec.Process)
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Exec.Process.LinuxBinprm.FileEvent.FileFields)
 		}
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Exec.Process.LinuxBinprm.FileEvent.FileFields)
 		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Exec.Process.LinuxBinprm.FileEvent.FileFields)
+		}
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Exec.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -360,6 +733,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exec.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.Exec.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exec.Process.LinuxBinprm.FileEvent)
 		}
@@ -375,6 +772,14 @@ func (ev *Event) resolveFields(forADs bool) {
 			}
 		}
 		_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.Exec.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.Exec.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.Exec.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.Exec.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.Exec.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.Exec.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.Exec.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.Exec.Process.Credentials)
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Exec.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.Exec.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.Exec.Process.UserSession)
@@ -387,17 +792,31 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, ev.Exec.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, ev.Exec.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, ev.Exec.Process)
+		if !forADs {
+			_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, ev.Exec.Process)
+		}
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exec.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsInit(ev, ev.Exec.Process)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Exec.SyscallContext)
 		}
 	case "exit":
+		_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.Exit.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.Exit.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Exit.Process.PIDContext)
 		if ev.Exit.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Exit.Process.FileEvent.FileFields)
 		}
 		if ev.Exit.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Exit.Process.FileEvent.FileFields)
 		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Exit.Process.FileEvent.FileFields)
+		}
 		if ev.Exit.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Exit.Process.FileEvent.FileFields)
 		}
@@ -410,6 +829,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exit.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exit.Process.FileEvent)
 		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Exit.Process.FileEvent)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Exit.Process.FileEvent)
+		}
 		if ev.Exit.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exit.Process.FileEvent)
 		}
@@ -424,16 +867,54 @@ func (ev *Event) resolveFields(forADs bool) {
 				_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Exit.Process.FileEvent)
 			}
 		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, ev.Exit.Process)
+		}
+		if ev.Exit.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, ev.Exit.Process)
+		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.Exit.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Exit.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupVersion(ev, &ev.Exit.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessSessionType(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessCwd(ev, ev.Exit.Process)
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Exit.Process.LinuxBinprm.FileEvent.FileFields)
 		}
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Exit.Process.LinuxBinprm.FileEvent.FileFields)
 		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Exit.Process.LinuxBinprm.FileEvent.FileFields)
+		}
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Exit.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -446,6 +927,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Exit.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.Exit.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Exit.Process.LinuxBinprm.FileEvent)
 		}
@@ -461,6 +966,14 @@ func (ev *Event) resolveFields(forADs bool) {
 			}
 		}
 		_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.Exit.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.Exit.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.Exit.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.Exit.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.Exit.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.Exit.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.Exit.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.Exit.Process.Credentials)
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Exit.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.Exit.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.Exit.Process.UserSession)
@@ -473,15 +986,34 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, ev.Exit.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, ev.Exit.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, ev.Exit.Process)
+		if !forADs {
+			_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, ev.Exit.Process)
+		}
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Exit.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsInit(ev, ev.Exit.Process)
 	case "imds":
 	case "link":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Link.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Link.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Link.Source.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Link.Source.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Link.Source.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Link.Source.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Link.Source)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Link.Source)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Link.Source)
@@ -490,16 +1022,29 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Link.Target.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Link.Target.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Link.Target.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Link.Target.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Link.Target)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Link.Target)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Link.Target)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Link.Target)
 		}
+		_ = ev.FieldHandlers.ResolveLinkDestinationContainsDotDot(ev, &ev.Link)
+		_ = ev.FieldHandlers.ResolveLinkCrossMount(ev, &ev.Link)
+		_ = ev.FieldHandlers.ResolveLinkBasenameChanged(ev, &ev.Link)
+		_ = ev.FieldHandlers.ResolveLinkType(ev, &ev.Link)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Link.SyscallContext)
 		}
@@ -507,12 +1052,23 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr2(ev, &ev.Link.SyscallContext)
 		}
 	case "load_module":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.LoadModule.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.LoadModule.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.LoadModule.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.LoadModule.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.LoadModule.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.LoadModule.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.LoadModule.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.LoadModule.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.LoadModule.File)
@@ -522,12 +1078,23 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveModuleArgs(ev, &ev.LoadModule)
 		_ = ev.FieldHandlers.ResolveModuleArgv(ev, &ev.LoadModule)
 	case "mkdir":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Mkdir.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Mkdir.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Mkdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Mkdir.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Mkdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Mkdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Mkdir.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Mkdir.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Mkdir.File)
@@ -541,12 +1108,23 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsInt2(ev, &ev.Mkdir.SyscallContext)
 		}
 	case "mmap":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.MMap.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.MMap.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.MMap.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.MMap.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.MMap.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.MMap.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.MMap.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.MMap.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.MMap.File)
@@ -554,6 +1132,8 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.MMap.File)
 		}
 	case "mount":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Mount.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Mount.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveMountPointPath(ev, &ev.Mount)
 		_ = ev.FieldHandlers.ResolveMountSourcePath(ev, &ev.Mount)
 		_ = ev.FieldHandlers.ResolveMountRootPath(ev, &ev.Mount)
@@ -567,6 +1147,8 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr3(ev, &ev.Mount.SyscallContext)
 		}
 	case "mprotect":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.MProtect.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.MProtect.SyscallEvent)
 	case "ondemand":
 		_ = ev.FieldHandlers.ResolveOnDemandName(ev, &ev.OnDemand)
 		_ = ev.FieldHandlers.ResolveOnDemandArg1Str(ev, &ev.OnDemand)
@@ -578,18 +1160,31 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveOnDemandArg4Str(ev, &ev.OnDemand)
 		_ = ev.FieldHandlers.ResolveOnDemandArg4Uint(ev, &ev.OnDemand)
 	case "open":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Open.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Open.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Open.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Open.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Open.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Open.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Open.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Open.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Open.File)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Open.File)
 		}
+		_ = ev.FieldHandlers.ResolveOpenIsWrite(ev, &ev.Open)
+		_ = ev.FieldHandlers.ResolveOpenIsTruncating(ev, &ev.Open)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Open.SyscallContext)
 		}
@@ -604,12 +1199,20 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveIsIPPublic(ev, &ev.RawPacket.NetworkContext.Source)
 		_ = ev.FieldHandlers.ResolveIsIPPublic(ev, &ev.RawPacket.NetworkContext.Destination)
 	case "ptrace":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.PTrace.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.PTrace.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.PTrace.Tracee.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.PTrace.Tracee.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.PTrace.Tracee.Process.PIDContext)
 		if ev.PTrace.Tracee.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.PTrace.Tracee.Process.FileEvent.FileFields)
 		}
 		if ev.PTrace.Tracee.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.PTrace.Tracee.Process.FileEvent.FileFields)
 		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.PTrace.Tracee.Process.FileEvent.FileFields)
+		}
 		if ev.PTrace.Tracee.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.PTrace.Tracee.Process.FileEvent.FileFields)
 		}
@@ -622,6 +1225,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Process.FileEvent)
 		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.PTrace.Tracee.Process.FileEvent)
+		}
 		if ev.PTrace.Tracee.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Process.FileEvent)
 		}
@@ -636,16 +1263,54 @@ func (ev *Event) resolveFields(forADs bool) {
 				_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.PTrace.Tracee.Process.FileEvent)
 			}
 		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, &ev.PTrace.Tracee.Process)
+		}
+		if ev.PTrace.Tracee.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, &ev.PTrace.Tracee.Process)
+		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.PTrace.Tracee.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.PTrace.Tracee.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupVersion(ev, &ev.PTrace.Tracee.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessSessionType(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessCwd(ev, &ev.PTrace.Tracee.Process)
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields)
 		}
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields)
 		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields)
+		}
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -658,6 +1323,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.PTrace.Tracee.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Process.LinuxBinprm.FileEvent)
 		}
@@ -673,6 +1362,14 @@ func (ev *Event) resolveFields(forADs bool) {
 			}
 		}
 		_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.PTrace.Tracee.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.PTrace.Tracee.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.PTrace.Tracee.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.PTrace.Tracee.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.PTrace.Tracee.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.PTrace.Tracee.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.PTrace.Tracee.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.PTrace.Tracee.Process.Credentials)
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.PTrace.Tracee.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.PTrace.Tracee.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.PTrace.Tracee.Process.UserSession)
@@ -685,13 +1382,33 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.PTrace.Tracee.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.PTrace.Tracee.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, &ev.PTrace.Tracee.Process)
+		if !forADs {
+			_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, &ev.PTrace.Tracee.Process)
+		}
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.PTrace.Tracee.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsInit(ev, &ev.PTrace.Tracee.Process)
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.PTrace.Tracee.Parent.PIDContext)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.PTrace.Tracee.Parent.PIDContext)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.PTrace.Tracee.Parent.PIDContext)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.PTrace.Tracee.Parent.FileEvent.FileFields)
 		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.PTrace.Tracee.Parent.FileEvent.FileFields)
 		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.PTrace.Tracee.Parent.FileEvent.FileFields)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.PTrace.Tracee.Parent.FileEvent.FileFields)
 		}
@@ -704,6 +1421,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Parent.FileEvent)
 		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.PTrace.Tracee.Parent.FileEvent)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Parent.FileEvent)
 		}
@@ -718,6 +1459,36 @@ func (ev *Event) resolveFields(forADs bool) {
 				_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.PTrace.Tracee.Parent.FileEvent)
 			}
 		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, ev.PTrace.Tracee.Parent)
+		}
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.PTrace.Tracee.Parent.CGroup)
 		}
@@ -730,12 +1501,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.PTrace.Tracee.Parent)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessSessionType(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCwd(ev, ev.PTrace.Tracee.Parent)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields)
+		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -743,10 +1532,34 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
 		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
-			_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+			_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
 		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
-			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
 		}
 		if ev.PTrace.Tracee.HasParent() && ev.PTrace.Tracee.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.PTrace.Tracee.Parent.LinuxBinprm.FileEvent)
@@ -765,6 +1578,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.PTrace.Tracee.Parent)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.PTrace.Tracee.Parent.Credentials)
+		}
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.PTrace.Tracee.Parent.UserSession)
 		}
@@ -797,16 +1634,51 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.PTrace.Tracee.Parent)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, ev.PTrace.Tracee.Parent)
+		}
+		if ev.PTrace.Tracee.HasParent() {
+			if !forADs {
+				_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, ev.PTrace.Tracee.Parent)
+			}
+		}
 		if ev.PTrace.Tracee.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.PTrace.Tracee.Parent)
 		}
+		if ev.PTrace.Tracee.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessIsInit(ev, ev.PTrace.Tracee.Parent)
+		}
+		_ = ev.FieldHandlers.ResolveProcessHasAncestors(ev, ev.PTrace.Tracee)
+		_ = ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, ev.PTrace.Tracee)
+		_ = ev.FieldHandlers.ResolveProcessFileEventsCount(ev, ev.PTrace.Tracee)
+		_ = ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, ev.PTrace.Tracee)
 	case "removexattr":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.RemoveXAttr.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.RemoveXAttr.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.RemoveXAttr.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.RemoveXAttr.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.RemoveXAttr.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.RemoveXAttr.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.RemoveXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.RemoveXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.RemoveXAttr.File)
@@ -815,13 +1687,26 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveXAttrNamespace(ev, &ev.RemoveXAttr)
 		_ = ev.FieldHandlers.ResolveXAttrName(ev, &ev.RemoveXAttr)
+		_ = ev.FieldHandlers.ResolveXAttrIsSecurityLabel(ev, &ev.RemoveXAttr)
+		_ = ev.FieldHandlers.ResolveXAttrAffectsIntegrity(ev, &ev.RemoveXAttr)
 	case "rename":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Rename.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Rename.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Rename.Old.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Rename.Old.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Rename.Old.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Rename.Old.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Rename.Old)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Rename.Old)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Rename.Old)
@@ -830,16 +1715,28 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Rename.New.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Rename.New.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Rename.New.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Rename.New.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Rename.New)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Rename.New)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Rename.New)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Rename.New)
 		}
+		_ = ev.FieldHandlers.ResolveRenameDestinationContainsDotDot(ev, &ev.Rename)
+		_ = ev.FieldHandlers.ResolveRenameCrossMount(ev, &ev.Rename)
+		_ = ev.FieldHandlers.ResolveRenameBasenameChanged(ev, &ev.Rename)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr1(ev, &ev.Rename.SyscallContext)
 		}
@@ -847,12 +1744,23 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsStr2(ev, &ev.Rename.SyscallContext)
 		}
 	case "rmdir":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Rmdir.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Rmdir.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Rmdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Rmdir.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Rmdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Rmdir.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Rmdir.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Rmdir.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Rmdir.File)
@@ -868,17 +1776,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveSetgidGroup(ev, &ev.SetGID)
 		_ = ev.FieldHandlers.ResolveSetgidEGroup(ev, &ev.SetGID)
 		_ = ev.FieldHandlers.ResolveSetgidFSGroup(ev, &ev.SetGID)
+		_ = ev.FieldHandlers.ResolveSetgidChanged(ev, &ev.SetGID)
 	case "setuid":
 		_ = ev.FieldHandlers.ResolveSetuidUser(ev, &ev.SetUID)
 		_ = ev.FieldHandlers.ResolveSetuidEUser(ev, &ev.SetUID)
 		_ = ev.FieldHandlers.ResolveSetuidFSUser(ev, &ev.SetUID)
+		_ = ev.FieldHandlers.ResolveSetuidChanged(ev, &ev.SetUID)
 	case "setxattr":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.SetXAttr.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.SetXAttr.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.SetXAttr.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.SetXAttr.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.SetXAttr.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.SetXAttr.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.SetXAttr.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.SetXAttr.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.SetXAttr.File)
@@ -887,13 +1808,23 @@ func (ev *Event) resolveFields(forADs bool) {
 		}
 		_ = ev.FieldHandlers.ResolveXAttrNamespace(ev, &ev.SetXAttr)
 		_ = ev.FieldHandlers.ResolveXAttrName(ev, &ev.SetXAttr)
+		_ = ev.FieldHandlers.ResolveXAttrIsSecurityLabel(ev, &ev.SetXAttr)
+		_ = ev.FieldHandlers.ResolveXAttrAffectsIntegrity(ev, &ev.SetXAttr)
 	case "signal":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Signal.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Signal.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.Signal.Target.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.Signal.Target.Process.PIDContext)
+		_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Signal.Target.Process.PIDContext)
 		if ev.Signal.Target.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Signal.Target.Process.FileEvent.FileFields)
 		}
 		if ev.Signal.Target.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Signal.Target.Process.FileEvent.FileFields)
 		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Signal.Target.Process.FileEvent.FileFields)
+		}
 		if ev.Signal.Target.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Signal.Target.Process.FileEvent.FileFields)
 		}
@@ -906,6 +1837,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Process.FileEvent)
 		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Signal.Target.Process.FileEvent)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Signal.Target.Process.FileEvent)
+		}
 		if ev.Signal.Target.Process.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Process.FileEvent)
 		}
@@ -920,16 +1875,54 @@ func (ev *Event) resolveFields(forADs bool) {
 				_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Signal.Target.Process.FileEvent)
 			}
 		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, &ev.Signal.Target.Process)
+		}
+		if ev.Signal.Target.Process.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, &ev.Signal.Target.Process)
+		}
 		_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.Signal.Target.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupManager(ev, &ev.Signal.Target.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveCGroupVersion(ev, &ev.Signal.Target.Process.CGroup)
 		_ = ev.FieldHandlers.ResolveProcessContainerID(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessSessionType(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessCwd(ev, &ev.Signal.Target.Process)
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields)
 		}
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields)
 		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields)
+		}
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -942,6 +1935,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
 		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.Process.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
+		}
 		if ev.Signal.Target.Process.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Process.LinuxBinprm.FileEvent)
 		}
@@ -957,6 +1974,14 @@ func (ev *Event) resolveFields(forADs bool) {
 			}
 		}
 		_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.Signal.Target.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.Signal.Target.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.Signal.Target.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.Signal.Target.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.Signal.Target.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.Signal.Target.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.Signal.Target.Process.Credentials)
+		_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.Signal.Target.Process.Credentials)
 		_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Signal.Target.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SUID(ev, &ev.Signal.Target.Process.UserSession)
 		_ = ev.FieldHandlers.ResolveK8SGroups(ev, &ev.Signal.Target.Process.UserSession)
@@ -969,13 +1994,33 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.Signal.Target.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.Signal.Target.Process)
 		_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, &ev.Signal.Target.Process)
+		if !forADs {
+			_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, &ev.Signal.Target.Process)
+		}
 		_ = ev.FieldHandlers.ResolveProcessIsThread(ev, &ev.Signal.Target.Process)
+		_ = ev.FieldHandlers.ResolveProcessIsInit(ev, &ev.Signal.Target.Process)
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessNetNamespace(ev, &ev.Signal.Target.Parent.PIDContext)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessMntNamespace(ev, &ev.Signal.Target.Parent.PIDContext)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessPIDNamespace(ev, &ev.Signal.Target.Parent.PIDContext)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Signal.Target.Parent.FileEvent.FileFields)
 		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Signal.Target.Parent.FileEvent.FileFields)
 		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Signal.Target.Parent.FileEvent.FileFields)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Signal.Target.Parent.FileEvent.FileFields)
 		}
@@ -988,6 +2033,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Parent.FileEvent)
 		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Signal.Target.Parent.FileEvent)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Parent.FileEvent)
 		}
@@ -1002,6 +2071,36 @@ func (ev *Event) resolveFields(forADs bool) {
 				_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Signal.Target.Parent.FileEvent)
 			}
 		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileMD5(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileSHA256(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsMemfd(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsHostPath(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetuid(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsSetgid(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileIsDeleted(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileInterpreterClass(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileNameEntropy(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.IsNotKworker() {
+			_ = ev.FieldHandlers.ResolveProcessFileLayerID(ev, ev.Signal.Target.Parent)
+		}
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveCGroupID(ev, &ev.Signal.Target.Parent.CGroup)
 		}
@@ -1014,12 +2113,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessContainerID(ev, ev.Signal.Target.Parent)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessSessionType(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCommTruncated(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCommMatchesBinary(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessIsAgent(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessCwd(ev, ev.Signal.Target.Parent)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent.FileFields)
 		}
@@ -1032,6 +2149,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
 		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
+		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
+			_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
+		}
 		if ev.Signal.Target.HasParent() && ev.Signal.Target.Parent.HasInterpreter() {
 			_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Signal.Target.Parent.LinuxBinprm.FileEvent)
 		}
@@ -1049,6 +2190,30 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, ev.Signal.Target.Parent)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsUser(ev, &ev.Signal.Target.Parent.Credentials)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsGroup(ev, &ev.Signal.Target.Parent.Credentials)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsEUser(ev, &ev.Signal.Target.Parent.Credentials)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsEGroup(ev, &ev.Signal.Target.Parent.Credentials)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsUIDMismatch(ev, &ev.Signal.Target.Parent.Credentials)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsGIDMismatch(ev, &ev.Signal.Target.Parent.Credentials)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsFSUser(ev, &ev.Signal.Target.Parent.Credentials)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveCredentialsFSGroup(ev, &ev.Signal.Target.Parent.Credentials)
+		}
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveK8SUsername(ev, &ev.Signal.Target.Parent.UserSession)
 		}
@@ -1081,16 +2246,51 @@ func (ev *Event) resolveFields(forADs bool) {
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessEnvsTruncated(ev, ev.Signal.Target.Parent)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessEnvsHasSecretLike(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessArgsHasShellMetachars(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessArgsDistinctCount(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessEnvsDistinctCount(ev, ev.Signal.Target.Parent)
+		}
+		if ev.Signal.Target.HasParent() {
+			if !forADs {
+				_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, ev.Signal.Target.Parent)
+			}
+		}
 		if ev.Signal.Target.HasParent() {
 			_ = ev.FieldHandlers.ResolveProcessIsThread(ev, ev.Signal.Target.Parent)
 		}
+		if ev.Signal.Target.HasParent() {
+			_ = ev.FieldHandlers.ResolveProcessIsInit(ev, ev.Signal.Target.Parent)
+		}
+		_ = ev.FieldHandlers.ResolveProcessHasAncestors(ev, ev.Signal.Target)
+		_ = ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, ev.Signal.Target)
+		_ = ev.FieldHandlers.ResolveProcessFileEventsCount(ev, ev.Signal.Target)
+		_ = ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, ev.Signal.Target)
 	case "splice":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Splice.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Splice.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Splice.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Splice.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Splice.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Splice.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Splice.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Splice.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Splice.File)
@@ -1098,18 +2298,30 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Splice.File)
 		}
 	case "unlink":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Unlink.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Unlink.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Unlink.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Unlink.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Unlink.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Unlink.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Unlink.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Unlink.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Unlink.File)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveHashesFromEvent(ev, &ev.Unlink.File)
 		}
+		_ = ev.FieldHandlers.ResolveUnlinkIsSelfDelete(ev, &ev.Unlink)
 		if !forADs {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsInt1(ev, &ev.Unlink.SyscallContext)
 		}
@@ -1120,13 +2332,26 @@ func (ev *Event) resolveFields(forADs bool) {
 			_ = ev.FieldHandlers.ResolveSyscallCtxArgsInt3(ev, &ev.Unlink.SyscallContext)
 		}
 	case "unload_module":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.UnloadModule.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.UnloadModule.SyscallEvent)
 	case "utimes":
+		_ = ev.FieldHandlers.ResolveSyscallSucceeded(ev, &ev.Utimes.SyscallEvent)
+		_ = ev.FieldHandlers.ResolveSyscallFailed(ev, &ev.Utimes.SyscallEvent)
 		_ = ev.FieldHandlers.ResolveFileFieldsUser(ev, &ev.Utimes.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsGroup(ev, &ev.Utimes.File.FileFields)
+		_ = ev.FieldHandlers.ResolveFileFieldsID(ev, &ev.Utimes.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFileFieldsInUpperLayer(ev, &ev.Utimes.File.FileFields)
 		_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolveFileFilesystem(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileResolvedPath(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileParentPath(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileDepth(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileIsCritical(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileIsLog(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFilePathIsValidUTF8(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileSanitizedPath(ev, &ev.Utimes.File)
+		_ = ev.FieldHandlers.ResolveFileMountPath(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolvePackageName(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolvePackageVersion(ev, &ev.Utimes.File)
 		_ = ev.FieldHandlers.ResolvePackageSourceVersion(ev, &ev.Utimes.File)
@@ -1145,25 +2370,48 @@ type FieldHandlers interface {
 	ResolveCGroupManager(ev *Event, e *CGroupContext) string
 	ResolveCGroupVersion(ev *Event, e *CGroupContext) int
 	ResolveChownGID(ev *Event, e *ChownEvent) string
+	ResolveChownToRoot(ev *Event, e *ChownEvent) bool
 	ResolveChownUID(ev *Event, e *ChownEvent) string
 	ResolveContainerCreatedAt(ev *Event, e *ContainerContext) int
 	ResolveContainerID(ev *Event, e *ContainerContext) string
 	ResolveContainerRuntime(ev *Event, e *ContainerContext) string
 	ResolveContainerTags(ev *Event, e *ContainerContext) []string
+	ResolveCredentialsEGroup(ev *Event, e *Credentials) string
+	ResolveCredentialsEUser(ev *Event, e *Credentials) string
+	ResolveCredentialsFSGroup(ev *Event, e *Credentials) string
+	ResolveCredentialsFSUser(ev *Event, e *Credentials) string
+	ResolveCredentialsGIDMismatch(ev *Event, e *Credentials) bool
+	ResolveCredentialsGroup(ev *Event, e *Credentials) string
+	ResolveCredentialsUIDMismatch(ev *Event, e *Credentials) bool
+	ResolveCredentialsUser(ev *Event, e *Credentials) string
+	ResolveEventIsMetadataOnly(ev *Event, e *BaseEvent) bool
 	ResolveEventTime(ev *Event, e *BaseEvent) time.Time
 	ResolveEventTimestamp(ev *Event, e *BaseEvent) int
 	ResolveFileBasename(ev *Event, e *FileEvent) string
+	ResolveFileDepth(ev *Event, e *FileEvent) int
 	ResolveFileFieldsGroup(ev *Event, e *FileFields) string
+	ResolveFileFieldsID(ev *Event, e *FileFields) string
 	ResolveFileFieldsInUpperLayer(ev *Event, e *FileFields) bool
 	ResolveFileFieldsUser(ev *Event, e *FileFields) string
 	ResolveFileFilesystem(ev *Event, e *FileEvent) string
+	ResolveFileIsCritical(ev *Event, e *FileEvent) bool
+	ResolveFileIsLog(ev *Event, e *FileEvent) bool
+	ResolveFileMountPath(ev *Event, e *FileEvent) string
+	ResolveFileParentPath(ev *Event, e *FileEvent) string
 	ResolveFilePath(ev *Event, e *FileEvent) string
+	ResolveFilePathIsValidUTF8(ev *Event, e *FileEvent) bool
+	ResolveFileResolvedPath(ev *Event, e *FileEvent) string
+	ResolveFileSanitizedPath(ev *Event, e *FileEvent) string
 	ResolveHashesFromEvent(ev *Event, e *FileEvent) []string
 	ResolveHostname(ev *Event, e *BaseEvent) string
 	ResolveIsIPPublic(ev *Event, e *IPPortContext) bool
 	ResolveK8SGroups(ev *Event, e *UserSessionContext) []string
 	ResolveK8SUID(ev *Event, e *UserSessionContext) string
 	ResolveK8SUsername(ev *Event, e *UserSessionContext) string
+	ResolveLinkBasenameChanged(ev *Event, e *LinkEvent) bool
+	ResolveLinkCrossMount(ev *Event, e *LinkEvent) bool
+	ResolveLinkDestinationContainsDotDot(ev *Event, e *LinkEvent) bool
+	ResolveLinkType(ev *Event, e *LinkEvent) string
 	ResolveModuleArgs(ev *Event, e *LoadModuleEvent) string
 	ResolveModuleArgv(ev *Event, e *LoadModuleEvent) []string
 	ResolveMountPointPath(ev *Event, e *MountEvent) string
@@ -1179,11 +2427,16 @@ type FieldHandlers interface {
 	ResolveOnDemandArg4Str(ev *Event, e *OnDemandEvent) string
 	ResolveOnDemandArg4Uint(ev *Event, e *OnDemandEvent) int
 	ResolveOnDemandName(ev *Event, e *OnDemandEvent) string
+	ResolveOpenIsTruncating(ev *Event, e *OpenEvent) bool
+	ResolveOpenIsWrite(ev *Event, e *OpenEvent) bool
 	ResolvePackageName(ev *Event, e *FileEvent) string
 	ResolvePackageSourceVersion(ev *Event, e *FileEvent) string
 	ResolvePackageVersion(ev *Event, e *FileEvent) string
+	ResolveProcessAncestorsExecCount(ev *Event, e *ProcessContext) int
 	ResolveProcessArgs(ev *Event, e *Process) string
+	ResolveProcessArgsDistinctCount(ev *Event, e *Process) int
 	ResolveProcessArgsFlags(ev *Event, e *Process) []string
+	ResolveProcessArgsHasShellMetachars(ev *Event, e *Process) bool
 	ResolveProcessArgsOptions(ev *Event, e *Process) []string
 	ResolveProcessArgsScrubbed(ev *Event, e *Process) string
 	ResolveProcessArgsTruncated(ev *Event, e *Process) bool
@@ -1191,18 +2444,48 @@ type FieldHandlers interface {
 	ResolveProcessArgv0(ev *Event, e *Process) string
 	ResolveProcessArgvScrubbed(ev *Event, e *Process) []string
 	ResolveProcessCmdArgv(ev *Event, e *Process) []string
+	ResolveProcessCmdLine(ev *Event, e *Process) string
+	ResolveProcessCommMatchesBinary(ev *Event, e *Process) bool
+	ResolveProcessCommTruncated(ev *Event, e *Process) bool
 	ResolveProcessContainerID(ev *Event, e *Process) string
 	ResolveProcessCreatedAt(ev *Event, e *Process) int
+	ResolveProcessCwd(ev *Event, e *Process) string
 	ResolveProcessEnvp(ev *Event, e *Process) []string
 	ResolveProcessEnvs(ev *Event, e *Process) []string
+	ResolveProcessEnvsDistinctCount(ev *Event, e *Process) int
+	ResolveProcessEnvsHasSecretLike(ev *Event, e *Process) bool
 	ResolveProcessEnvsTruncated(ev *Event, e *Process) bool
+	ResolveProcessFileEventsCount(ev *Event, e *ProcessContext) int
+	ResolveProcessFileInterpreterClass(ev *Event, e *Process) string
+	ResolveProcessFileIsDeleted(ev *Event, e *Process) bool
+	ResolveProcessFileIsHostPath(ev *Event, e *Process) bool
+	ResolveProcessFileIsMemfd(ev *Event, e *Process) bool
+	ResolveProcessFileIsSetgid(ev *Event, e *Process) bool
+	ResolveProcessFileIsSetuid(ev *Event, e *Process) bool
+	ResolveProcessFileLayerID(ev *Event, e *Process) string
+	ResolveProcessFileMD5(ev *Event, e *Process) string
+	ResolveProcessFileNameEntropy(ev *Event, e *Process) float64
+	ResolveProcessFileSHA256(ev *Event, e *Process) string
+	ResolveProcessHasAncestors(ev *Event, e *ProcessContext) bool
+	ResolveProcessIsAgent(ev *Event, e *Process) bool
+	ResolveProcessIsInit(ev *Event, e *Process) bool
 	ResolveProcessIsThread(ev *Event, e *Process) bool
+	ResolveProcessMetadataChangeCount(ev *Event, e *ProcessContext) int
+	ResolveProcessMntNamespace(ev *Event, e *PIDContext) int
+	ResolveProcessNetNamespace(ev *Event, e *PIDContext) int
+	ResolveProcessPIDNamespace(ev *Event, e *PIDContext) int
+	ResolveProcessSessionType(ev *Event, e *Process) string
+	ResolveRenameBasenameChanged(ev *Event, e *RenameEvent) bool
+	ResolveRenameCrossMount(ev *Event, e *RenameEvent) bool
+	ResolveRenameDestinationContainsDotDot(ev *Event, e *RenameEvent) bool
 	ResolveRights(ev *Event, e *FileFields) int
 	ResolveSELinuxBoolName(ev *Event, e *SELinuxEvent) string
 	ResolveService(ev *Event, e *BaseEvent) string
+	ResolveSetgidChanged(ev *Event, e *SetgidEvent) bool
 	ResolveSetgidEGroup(ev *Event, e *SetgidEvent) string
 	ResolveSetgidFSGroup(ev *Event, e *SetgidEvent) string
 	ResolveSetgidGroup(ev *Event, e *SetgidEvent) string
+	ResolveSetuidChanged(ev *Event, e *SetuidEvent) bool
 	ResolveSetuidEUser(ev *Event, e *SetuidEvent) string
 	ResolveSetuidFSUser(ev *Event, e *SetuidEvent) string
 	ResolveSetuidUser(ev *Event, e *SetuidEvent) string
@@ -1212,6 +2495,11 @@ type FieldHandlers interface {
 	ResolveSyscallCtxArgsStr1(ev *Event, e *SyscallContext) string
 	ResolveSyscallCtxArgsStr2(ev *Event, e *SyscallContext) string
 	ResolveSyscallCtxArgsStr3(ev *Event, e *SyscallContext) string
+	ResolveSyscallFailed(ev *Event, e *SyscallEvent) bool
+	ResolveSyscallSucceeded(ev *Event, e *SyscallEvent) bool
+	ResolveUnlinkIsSelfDelete(ev *Event, e *UnlinkEvent) bool
+	ResolveXAttrAffectsIntegrity(ev *Event, e *SetXAttrEvent) bool
+	ResolveXAttrIsSecurityLabel(ev *Event, e *SetXAttrEvent) bool
 	ResolveXAttrName(ev *Event, e *SetXAttrEvent) string
 	ResolveXAttrNamespace(ev *Event, e *SetXAttrEvent) string
 	// custom handlers not tied to any fields
@@ -1232,6 +2520,9 @@ func (dfh *FakeFieldHandlers) ResolveCGroupVersion(ev *Event, e *CGroupContext)
 func (dfh *FakeFieldHandlers) ResolveChownGID(ev *Event, e *ChownEvent) string {
 	return string(e.Group)
 }
+func (dfh *FakeFieldHandlers) ResolveChownToRoot(ev *Event, e *ChownEvent) bool {
+	return bool(e.ToRoot)
+}
 func (dfh *FakeFieldHandlers) ResolveChownUID(ev *Event, e *ChownEvent) string { return string(e.User) }
 func (dfh *FakeFieldHandlers) ResolveContainerCreatedAt(ev *Event, e *ContainerContext) int {
 	return int(e.CreatedAt)
@@ -1245,6 +2536,33 @@ func (dfh *FakeFieldHandlers) ResolveContainerRuntime(ev *Event, e *ContainerCon
 func (dfh *FakeFieldHandlers) ResolveContainerTags(ev *Event, e *ContainerContext) []string {
 	return []string(e.Tags)
 }
+func (dfh *FakeFieldHandlers) ResolveCredentialsEGroup(ev *Event, e *Credentials) string {
+	return string(e.EGroup)
+}
+func (dfh *FakeFieldHandlers) ResolveCredentialsEUser(ev *Event, e *Credentials) string {
+	return string(e.EUser)
+}
+func (dfh *FakeFieldHandlers) ResolveCredentialsFSGroup(ev *Event, e *Credentials) string {
+	return string(e.FSGroup)
+}
+func (dfh *FakeFieldHandlers) ResolveCredentialsFSUser(ev *Event, e *Credentials) string {
+	return string(e.FSUser)
+}
+func (dfh *FakeFieldHandlers) ResolveCredentialsGIDMismatch(ev *Event, e *Credentials) bool {
+	return bool(e.GIDMismatch)
+}
+func (dfh *FakeFieldHandlers) ResolveCredentialsGroup(ev *Event, e *Credentials) string {
+	return string(e.Group)
+}
+func (dfh *FakeFieldHandlers) ResolveCredentialsUIDMismatch(ev *Event, e *Credentials) bool {
+	return bool(e.UIDMismatch)
+}
+func (dfh *FakeFieldHandlers) ResolveCredentialsUser(ev *Event, e *Credentials) string {
+	return string(e.User)
+}
+func (dfh *FakeFieldHandlers) ResolveEventIsMetadataOnly(ev *Event, e *BaseEvent) bool {
+	return bool(e.IsMetadataOnly)
+}
 func (dfh *FakeFieldHandlers) ResolveEventTime(ev *Event, e *BaseEvent) time.Time {
 	return time.Time(e.Timestamp)
 }
@@ -1254,9 +2572,13 @@ func (dfh *FakeFieldHandlers) ResolveEventTimestamp(ev *Event, e *BaseEvent) int
 func (dfh *FakeFieldHandlers) ResolveFileBasename(ev *Event, e *FileEvent) string {
 	return string(e.BasenameStr)
 }
+func (dfh *FakeFieldHandlers) ResolveFileDepth(ev *Event, e *FileEvent) int { return int(e.Depth) }
 func (dfh *FakeFieldHandlers) ResolveFileFieldsGroup(ev *Event, e *FileFields) string {
 	return string(e.Group)
 }
+func (dfh *FakeFieldHandlers) ResolveFileFieldsID(ev *Event, e *FileFields) string {
+	return string(e.ID)
+}
 func (dfh *FakeFieldHandlers) ResolveFileFieldsInUpperLayer(ev *Event, e *FileFields) bool {
 	return bool(e.InUpperLayer)
 }
@@ -1266,9 +2588,28 @@ func (dfh *FakeFieldHandlers) ResolveFileFieldsUser(ev *Event, e *FileFields) st
 func (dfh *FakeFieldHandlers) ResolveFileFilesystem(ev *Event, e *FileEvent) string {
 	return string(e.Filesystem)
 }
+func (dfh *FakeFieldHandlers) ResolveFileIsCritical(ev *Event, e *FileEvent) bool {
+	return bool(e.IsCritical)
+}
+func (dfh *FakeFieldHandlers) ResolveFileIsLog(ev *Event, e *FileEvent) bool { return bool(e.IsLog) }
+func (dfh *FakeFieldHandlers) ResolveFileMountPath(ev *Event, e *FileEvent) string {
+	return string(e.MountPath)
+}
+func (dfh *FakeFieldHandlers) ResolveFileParentPath(ev *Event, e *FileEvent) string {
+	return string(e.ParentPathStr)
+}
 func (dfh *FakeFieldHandlers) ResolveFilePath(ev *Event, e *FileEvent) string {
 	return string(e.PathnameStr)
 }
+func (dfh *FakeFieldHandlers) ResolveFilePathIsValidUTF8(ev *Event, e *FileEvent) bool {
+	return bool(e.PathIsValidUTF8)
+}
+func (dfh *FakeFieldHandlers) ResolveFileResolvedPath(ev *Event, e *FileEvent) string {
+	return string(e.ResolvedPathnameStr)
+}
+func (dfh *FakeFieldHandlers) ResolveFileSanitizedPath(ev *Event, e *FileEvent) string {
+	return string(e.SanitizedPathStr)
+}
 func (dfh *FakeFieldHandlers) ResolveHashesFromEvent(ev *Event, e *FileEvent) []string {
 	return []string(e.Hashes)
 }
@@ -1287,6 +2628,16 @@ func (dfh *FakeFieldHandlers) ResolveK8SUID(ev *Event, e *UserSessionContext) st
 func (dfh *FakeFieldHandlers) ResolveK8SUsername(ev *Event, e *UserSessionContext) string {
 	return string(e.K8SUsername)
 }
+func (dfh *FakeFieldHandlers) ResolveLinkBasenameChanged(ev *Event, e *LinkEvent) bool {
+	return bool(e.BasenameChanged)
+}
+func (dfh *FakeFieldHandlers) ResolveLinkCrossMount(ev *Event, e *LinkEvent) bool {
+	return bool(e.CrossMount)
+}
+func (dfh *FakeFieldHandlers) ResolveLinkDestinationContainsDotDot(ev *Event, e *LinkEvent) bool {
+	return bool(e.DestinationContainsDotDot)
+}
+func (dfh *FakeFieldHandlers) ResolveLinkType(ev *Event, e *LinkEvent) string { return string(e.Type) }
 func (dfh *FakeFieldHandlers) ResolveModuleArgs(ev *Event, e *LoadModuleEvent) string {
 	return string(e.Args)
 }
@@ -1332,6 +2683,12 @@ func (dfh *FakeFieldHandlers) ResolveOnDemandArg4Uint(ev *Event, e *OnDemandEven
 func (dfh *FakeFieldHandlers) ResolveOnDemandName(ev *Event, e *OnDemandEvent) string {
 	return string(e.Name)
 }
+func (dfh *FakeFieldHandlers) ResolveOpenIsTruncating(ev *Event, e *OpenEvent) bool {
+	return bool(e.Truncating)
+}
+func (dfh *FakeFieldHandlers) ResolveOpenIsWrite(ev *Event, e *OpenEvent) bool {
+	return bool(e.ForWriting)
+}
 func (dfh *FakeFieldHandlers) ResolvePackageName(ev *Event, e *FileEvent) string {
 	return string(e.PkgName)
 }
@@ -1341,10 +2698,19 @@ func (dfh *FakeFieldHandlers) ResolvePackageSourceVersion(ev *Event, e *FileEven
 func (dfh *FakeFieldHandlers) ResolvePackageVersion(ev *Event, e *FileEvent) string {
 	return string(e.PkgVersion)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessAncestorsExecCount(ev *Event, e *ProcessContext) int {
+	return int(e.AncestorsExecCount)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessArgs(ev *Event, e *Process) string { return string(e.Args) }
+func (dfh *FakeFieldHandlers) ResolveProcessArgsDistinctCount(ev *Event, e *Process) int {
+	return int(e.ArgsDistinctCount)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessArgsFlags(ev *Event, e *Process) []string {
 	return []string(e.Argv)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessArgsHasShellMetachars(ev *Event, e *Process) bool {
+	return bool(e.ArgsHasShellMetachars)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessArgsOptions(ev *Event, e *Process) []string {
 	return []string(e.Argv)
 }
@@ -1366,24 +2732,104 @@ func (dfh *FakeFieldHandlers) ResolveProcessArgvScrubbed(ev *Event, e *Process)
 func (dfh *FakeFieldHandlers) ResolveProcessCmdArgv(ev *Event, e *Process) []string {
 	return []string(e.Argv)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessCmdLine(ev *Event, e *Process) string {
+	return string(e.CmdLine)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessCommMatchesBinary(ev *Event, e *Process) bool {
+	return bool(e.CommMatchesBinary)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessCommTruncated(ev *Event, e *Process) bool {
+	return bool(e.CommTruncated)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessContainerID(ev *Event, e *Process) string {
 	return string(e.ContainerID)
 }
 func (dfh *FakeFieldHandlers) ResolveProcessCreatedAt(ev *Event, e *Process) int {
 	return int(e.CreatedAt)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessCwd(ev *Event, e *Process) string { return string(e.Cwd) }
 func (dfh *FakeFieldHandlers) ResolveProcessEnvp(ev *Event, e *Process) []string {
 	return []string(e.Envp)
 }
 func (dfh *FakeFieldHandlers) ResolveProcessEnvs(ev *Event, e *Process) []string {
 	return []string(e.Envs)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessEnvsDistinctCount(ev *Event, e *Process) int {
+	return int(e.EnvsDistinctCount)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessEnvsHasSecretLike(ev *Event, e *Process) bool {
+	return bool(e.EnvsHasSecretLike)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessEnvsTruncated(ev *Event, e *Process) bool {
 	return bool(e.EnvsTruncated)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessFileEventsCount(ev *Event, e *ProcessContext) int {
+	return int(e.FileEventsCount)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileInterpreterClass(ev *Event, e *Process) string {
+	return string(e.FileInterpreterClass)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileIsDeleted(ev *Event, e *Process) bool {
+	return bool(e.FileDeleted)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileIsHostPath(ev *Event, e *Process) bool {
+	return bool(e.FileHostPath)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileIsMemfd(ev *Event, e *Process) bool {
+	return bool(e.FileMemfd)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileIsSetgid(ev *Event, e *Process) bool {
+	return bool(e.FileSetgid)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileIsSetuid(ev *Event, e *Process) bool {
+	return bool(e.FileSetuid)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileLayerID(ev *Event, e *Process) string {
+	return string(e.FileLayerID)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileMD5(ev *Event, e *Process) string {
+	return string(e.FileMD5)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileNameEntropy(ev *Event, e *Process) float64 {
+	return float64(e.FileNameEntropy)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessFileSHA256(ev *Event, e *Process) string {
+	return string(e.FileSHA256)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessHasAncestors(ev *Event, e *ProcessContext) bool {
+	return bool(e.HasAncestors)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessIsAgent(ev *Event, e *Process) bool {
+	return bool(e.IsAgent)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessIsInit(ev *Event, e *Process) bool { return bool(e.IsInit) }
 func (dfh *FakeFieldHandlers) ResolveProcessIsThread(ev *Event, e *Process) bool {
 	return bool(e.IsThread)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessMetadataChangeCount(ev *Event, e *ProcessContext) int {
+	return int(e.MetadataChangeCount)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessMntNamespace(ev *Event, e *PIDContext) int {
+	return int(e.MntNS)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessNetNamespace(ev *Event, e *PIDContext) int {
+	return int(e.NetNS)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessPIDNamespace(ev *Event, e *PIDContext) int {
+	return int(e.NSID)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessSessionType(ev *Event, e *Process) string {
+	return string(e.TTYSessionType)
+}
+func (dfh *FakeFieldHandlers) ResolveRenameBasenameChanged(ev *Event, e *RenameEvent) bool {
+	return bool(e.BasenameChanged)
+}
+func (dfh *FakeFieldHandlers) ResolveRenameCrossMount(ev *Event, e *RenameEvent) bool {
+	return bool(e.CrossMount)
+}
+func (dfh *FakeFieldHandlers) ResolveRenameDestinationContainsDotDot(ev *Event, e *RenameEvent) bool {
+	return bool(e.DestinationContainsDotDot)
+}
 func (dfh *FakeFieldHandlers) ResolveRights(ev *Event, e *FileFields) int { return int(e.Mode) }
 func (dfh *FakeFieldHandlers) ResolveSELinuxBoolName(ev *Event, e *SELinuxEvent) string {
 	return string(e.BoolName)
@@ -1391,6 +2837,9 @@ func (dfh *FakeFieldHandlers) ResolveSELinuxBoolName(ev *Event, e *SELinuxEvent)
 func (dfh *FakeFieldHandlers) ResolveService(ev *Event, e *BaseEvent) string {
 	return string(e.Service)
 }
+func (dfh *FakeFieldHandlers) ResolveSetgidChanged(ev *Event, e *SetgidEvent) bool {
+	return bool(e.Changed)
+}
 func (dfh *FakeFieldHandlers) ResolveSetgidEGroup(ev *Event, e *SetgidEvent) string {
 	return string(e.EGroup)
 }
@@ -1400,6 +2849,9 @@ func (dfh *FakeFieldHandlers) ResolveSetgidFSGroup(ev *Event, e *SetgidEvent) st
 func (dfh *FakeFieldHandlers) ResolveSetgidGroup(ev *Event, e *SetgidEvent) string {
 	return string(e.Group)
 }
+func (dfh *FakeFieldHandlers) ResolveSetuidChanged(ev *Event, e *SetuidEvent) bool {
+	return bool(e.Changed)
+}
 func (dfh *FakeFieldHandlers) ResolveSetuidEUser(ev *Event, e *SetuidEvent) string {
 	return string(e.EUser)
 }
@@ -1427,6 +2879,21 @@ func (dfh *FakeFieldHandlers) ResolveSyscallCtxArgsStr2(ev *Event, e *SyscallCon
 func (dfh *FakeFieldHandlers) ResolveSyscallCtxArgsStr3(ev *Event, e *SyscallContext) string {
 	return string(e.StrArg3)
 }
+func (dfh *FakeFieldHandlers) ResolveSyscallFailed(ev *Event, e *SyscallEvent) bool {
+	return bool(e.Failed)
+}
+func (dfh *FakeFieldHandlers) ResolveSyscallSucceeded(ev *Event, e *SyscallEvent) bool {
+	return bool(e.Succeeded)
+}
+func (dfh *FakeFieldHandlers) ResolveUnlinkIsSelfDelete(ev *Event, e *UnlinkEvent) bool {
+	return bool(e.SelfDelete)
+}
+func (dfh *FakeFieldHandlers) ResolveXAttrAffectsIntegrity(ev *Event, e *SetXAttrEvent) bool {
+	return bool(e.AffectsIntegrity)
+}
+func (dfh *FakeFieldHandlers) ResolveXAttrIsSecurityLabel(ev *Event, e *SetXAttrEvent) bool {
+	return bool(e.IsSecurityLabel)
+}
 func (dfh *FakeFieldHandlers) ResolveXAttrName(ev *Event, e *SetXAttrEvent) string {
 	return string(e.Name)
 }
@@ -30,16 +30,21 @@ func (ev *Event) resolveFields(forADs bool) {
 		_ = ev.FieldHandlers.ResolveContainerTags(ev, ev.BaseEvent.ContainerContext)
 	}
 	_ = ev.FieldHandlers.ResolveHostname(ev, &ev.BaseEvent)
+	_ = ev.FieldHandlers.ResolveEventIsMetadataOnly(ev, &ev.BaseEvent)
 	if !forADs {
 		_ = ev.FieldHandlers.ResolveService(ev, &ev.BaseEvent)
 	}
 	_ = ev.FieldHandlers.ResolveEventTimestamp(ev, &ev.BaseEvent)
+	_ = ev.FieldHandlers.ResolveProcessAncestorsExecCount(ev, ev.BaseEvent.ProcessContext)
 	_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessCreatedAt(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessEnvp(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveProcessEnvs(ev, &ev.BaseEvent.ProcessContext.Process)
 	_ = ev.FieldHandlers.ResolveFileBasename(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
 	_ = ev.FieldHandlers.ResolveFilePath(ev, &ev.BaseEvent.ProcessContext.Process.FileEvent)
+	_ = ev.FieldHandlers.ResolveProcessFileEventsCount(ev, ev.BaseEvent.ProcessContext)
+	_ = ev.FieldHandlers.ResolveProcessHasAncestors(ev, ev.BaseEvent.ProcessContext)
+	_ = ev.FieldHandlers.ResolveProcessMetadataChangeCount(ev, ev.BaseEvent.ProcessContext)
 	if ev.BaseEvent.ProcessContext.HasParent() {
 		_ = ev.FieldHandlers.ResolveProcessCmdLine(ev, ev.BaseEvent.ProcessContext.Parent)
 	}
@@ -114,6 +119,7 @@ type FieldHandlers interface {
 	ResolveContainerID(ev *Event, e *ContainerContext) string
 	ResolveContainerRuntime(ev *Event, e *ContainerContext) string
 	ResolveContainerTags(ev *Event, e *ContainerContext) []string
+	ResolveEventIsMetadataOnly(ev *Event, e *BaseEvent) bool
 	ResolveEventTime(ev *Event, e *BaseEvent) time.Time
 	ResolveEventTimestamp(ev *Event, e *BaseEvent) int
 	ResolveFileBasename(ev *Event, e *FileEvent) string
@@ -124,11 +130,15 @@ type FieldHandlers interface {
 	ResolveHostname(ev *Event, e *BaseEvent) string
 	ResolveNewSecurityDescriptor(ev *Event, e *ChangePermissionEvent) string
 	ResolveOldSecurityDescriptor(ev *Event, e *ChangePermissionEvent) string
+	ResolveProcessAncestorsExecCount(ev *Event, e *ProcessContext) int
 	ResolveProcessCmdLine(ev *Event, e *Process) string
 	ResolveProcessCmdLineScrubbed(ev *Event, e *Process) string
 	ResolveProcessCreatedAt(ev *Event, e *Process) int
 	ResolveProcessEnvp(ev *Event, e *Process) []string
 	ResolveProcessEnvs(ev *Event, e *Process) []string
+	ResolveProcessFileEventsCount(ev *Event, e *ProcessContext) int
+	ResolveProcessHasAncestors(ev *Event, e *ProcessContext) bool
+	ResolveProcessMetadataChangeCount(ev *Event, e *ProcessContext) int
 	ResolveService(ev *Event, e *BaseEvent) string
 	ResolveUser(ev *Event, e *Process) string
 	// custom handlers not tied to any fields
@@ -148,6 +158,9 @@ func (dfh *FakeFieldHandlers) ResolveContainerRuntime(ev *Event, e *ContainerCon
 func (dfh *FakeFieldHandlers) ResolveContainerTags(ev *Event, e *ContainerContext) []string {
 	return []string(e.Tags)
 }
+func (dfh *FakeFieldHandlers) ResolveEventIsMetadataOnly(ev *Event, e *BaseEvent) bool {
+	return bool(e.IsMetadataOnly)
+}
 func (dfh *FakeFieldHandlers) ResolveEventTime(ev *Event, e *BaseEvent) time.Time {
 	return time.Time(e.Timestamp)
 }
@@ -178,6 +191,9 @@ func (dfh *FakeFieldHandlers) ResolveNewSecurityDescriptor(ev *Event, e *ChangeP
 func (dfh *FakeFieldHandlers) ResolveOldSecurityDescriptor(ev *Event, e *ChangePermissionEvent) string {
 	return string(e.OldSd)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessAncestorsExecCount(ev *Event, e *ProcessContext) int {
+	return int(e.AncestorsExecCount)
+}
 func (dfh *FakeFieldHandlers) ResolveProcessCmdLine(ev *Event, e *Process) string {
 	return string(e.CmdLine)
 }
@@ -193,6 +209,15 @@ func (dfh *FakeFieldHandlers) ResolveProcessEnvp(ev *Event, e *Process) []string
 func (dfh *FakeFieldHandlers) ResolveProcessEnvs(ev *Event, e *Process) []string {
 	return []string(e.Envs)
 }
+func (dfh *FakeFieldHandlers) ResolveProcessFileEventsCount(ev *Event, e *ProcessContext) int {
+	return int(e.FileEventsCount)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessHasAncestors(ev *Event, e *ProcessContext) bool {
+	return bool(e.HasAncestors)
+}
+func (dfh *FakeFieldHandlers) ResolveProcessMetadataChangeCount(ev *Event, e *ProcessContext) int {
+	return int(e.MetadataChangeCount)
+}
 func (dfh *FakeFieldHandlers) ResolveService(ev *Event, e *BaseEvent) string {
 	return string(e.Service)
 }
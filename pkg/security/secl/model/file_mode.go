@@ -0,0 +1,78 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// symbolicModeLength is the length of a symbolic permission string, ex: `rwxr-xr-x`
+const symbolicModeLength = 9
+
+// ParseSymbolicMode returns the permission bits described by a symbolic permission string, as printed by `ls -l`,
+// ex: `rwxr-xr-x` is 0755. The setuid, setgid and sticky bits are described by the `s`, `S`, `t` and `T` letters.
+func ParseSymbolicMode(value string) (int, error) {
+	if len(value) != symbolicModeLength {
+		return 0, fmt.Errorf("symbolic mode must be %d characters long", symbolicModeLength)
+	}
+
+	// special bits set by the `s` and `t` letters of the execute permission of the user, group and others
+	specialBits := [3]int{0o4000, 0o2000, 0o1000}
+	specialLetters := [3]byte{'s', 's', 't'}
+
+	var mode int
+	for class := 0; class < 3; class++ {
+		shift := uint(6 - 3*class)
+		read, write, exec := value[3*class], value[3*class+1], value[3*class+2]
+
+		switch read {
+		case 'r':
+			mode |= 0o4 << shift
+		case '-':
+		default:
+			return 0, fmt.Errorf("unexpected character `%c` at position %d", read, 3*class)
+		}
+
+		switch write {
+		case 'w':
+			mode |= 0o2 << shift
+		case '-':
+		default:
+			return 0, fmt.Errorf("unexpected character `%c` at position %d", write, 3*class+1)
+		}
+
+		switch exec {
+		case 'x':
+			mode |= 0o1 << shift
+		case specialLetters[class]:
+			mode |= 0o1<<shift | specialBits[class]
+		case specialLetters[class] - 'a' + 'A':
+			mode |= specialBits[class]
+		case '-':
+		default:
+			return 0, fmt.Errorf("unexpected character `%c` at position %d", exec, 3*class+2)
+		}
+	}
+
+	return mode, nil
+}
+
+// ParseIntField converts the symbolic permission strings compared against the mode fields to their int value
+func (m *Model) ParseIntField(field eval.Field, value string) (int, bool, error) {
+	if !strings.HasSuffix(field, ".mode") {
+		return 0, false, nil
+	}
+
+	mode, err := ParseSymbolicMode(value)
+	if err != nil {
+		return 0, false, err
+	}
+	return mode, true, nil
+}
@@ -0,0 +1,117 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+)
+
+// FileOwnerResolverFnc resolves the name of a user or a group from its id, in the context of the given container
+type FileOwnerResolverFnc func(id uint32, containerID containerutils.ContainerID) string
+
+// FileOwnerResolver resolves the names of the owner user and group of the files. Only the ids are stored on the
+// events, the names being resolved on the first access to the `*.user` and `*.group` fields.
+type FileOwnerResolver struct {
+	sync.RWMutex
+	resolveUser     FileOwnerResolverFnc
+	resolveGroup    FileOwnerResolverFnc
+	numericFallback bool
+}
+
+// NewFileOwnerResolver returns a new resolver calling the given callbacks
+func NewFileOwnerResolver(resolveUser, resolveGroup FileOwnerResolverFnc) *FileOwnerResolver {
+	r := &FileOwnerResolver{}
+	r.SetCallbacks(resolveUser, resolveGroup)
+	return r
+}
+
+// SetCallbacks sets the callbacks used to resolve the user and group names, a nil callback leaving the name empty
+func (r *FileOwnerResolver) SetCallbacks(resolveUser, resolveGroup FileOwnerResolverFnc) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.resolveUser = resolveUser
+	r.resolveGroup = resolveGroup
+}
+
+// SetNumericFallback sets whether the ids that can't be resolved to a name are returned as numeric strings instead
+// of empty strings
+func (r *FileOwnerResolver) SetNumericFallback(enabled bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.numericFallback = enabled
+}
+
+// ResolveUser returns the name of the user with the given uid
+func (r *FileOwnerResolver) ResolveUser(uid uint32, containerID containerutils.ContainerID) string {
+	if name, resolved := r.lookupUser(uid, containerID); resolved {
+		return name
+	}
+	return r.fallback(uid)
+}
+
+// ResolveGroup returns the name of the group with the given gid
+func (r *FileOwnerResolver) ResolveGroup(gid uint32, containerID containerutils.ContainerID) string {
+	if name, resolved := r.lookupGroup(gid, containerID); resolved {
+		return name
+	}
+	return r.fallback(gid)
+}
+
+// lookupUser returns the name of the user with the given uid, and whether it was resolved
+func (r *FileOwnerResolver) lookupUser(uid uint32, containerID containerutils.ContainerID) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+
+	r.RLock()
+	resolve := r.resolveUser
+	r.RUnlock()
+
+	return lookupOwner(resolve, uid, containerID)
+}
+
+// lookupGroup returns the name of the group with the given gid, and whether it was resolved
+func (r *FileOwnerResolver) lookupGroup(gid uint32, containerID containerutils.ContainerID) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+
+	r.RLock()
+	resolve := r.resolveGroup
+	r.RUnlock()
+
+	return lookupOwner(resolve, gid, containerID)
+}
+
+func lookupOwner(resolve FileOwnerResolverFnc, id uint32, containerID containerutils.ContainerID) (string, bool) {
+	if resolve == nil {
+		return "", false
+	}
+	name := resolve(id, containerID)
+	return name, len(name) != 0
+}
+
+// fallback returns the name of an id that couldn't be resolved
+func (r *FileOwnerResolver) fallback(id uint32) string {
+	if r == nil {
+		return ""
+	}
+
+	r.RLock()
+	enabled := r.numericFallback
+	r.RUnlock()
+
+	if enabled {
+		return strconv.FormatUint(uint64(id), 10)
+	}
+	return ""
+}
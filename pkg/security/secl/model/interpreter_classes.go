@@ -0,0 +1,81 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"sync"
+)
+
+const (
+	// InterpreterClassShell classifies the command line shells
+	InterpreterClassShell = "shell"
+	// InterpreterClassScripting classifies the interpreters of scripting languages
+	InterpreterClassScripting = "scripting"
+	// InterpreterClassPackageManager classifies the package managers
+	InterpreterClassPackageManager = "package_manager"
+	// InterpreterClassNone classifies the binaries that aren't well-known interpreters
+	InterpreterClassNone = "none"
+)
+
+// DefaultInterpreterClasses lists the basenames of the well-known interpreters classified by the
+// `*.file.interpreter_class` fields by default
+var DefaultInterpreterClasses = map[string][]string{
+	InterpreterClassShell: {
+		"sh", "bash", "dash", "zsh", "ksh", "mksh", "csh", "tcsh", "fish", "ash",
+	},
+	InterpreterClassScripting: {
+		"python", "python2", "python3", "perl", "ruby", "php", "node", "nodejs", "lua", "tclsh", "pwsh",
+	},
+	InterpreterClassPackageManager: {
+		"apt", "apt-get", "dpkg", "yum", "dnf", "rpm", "apk", "zypper", "pacman", "pip", "pip3", "npm", "gem",
+	},
+}
+
+// InterpreterClassifier classifies the executed binaries from their basename, with a single lookup per event
+type InterpreterClassifier struct {
+	sync.RWMutex
+	classes map[string]string
+}
+
+// NewInterpreterClassifier returns a new classifier holding the given classes, indexed by class name
+func NewInterpreterClassifier(classes map[string][]string) *InterpreterClassifier {
+	c := &InterpreterClassifier{
+		classes: make(map[string]string),
+	}
+	for class, basenames := range classes {
+		c.Register(class, basenames...)
+	}
+	return c
+}
+
+// Register classifies the given basenames in the given class, replacing their previous class if any
+func (c *InterpreterClassifier) Register(class string, basenames ...string) {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, basename := range basenames {
+		if basename == "" {
+			continue
+		}
+		c.classes[basename] = class
+	}
+}
+
+// Classify returns the class of the given basename, InterpreterClassNone if it isn't a well-known interpreter
+func (c *InterpreterClassifier) Classify(basename string) string {
+	if c == nil || basename == "" {
+		return InterpreterClassNone
+	}
+
+	c.RLock()
+	defer c.RUnlock()
+
+	if class, found := c.classes[basename]; found {
+		return class
+	}
+	return InterpreterClassNone
+}
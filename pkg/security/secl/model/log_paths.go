@@ -0,0 +1,59 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"sync"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// DefaultLogDirectories lists the directories whose files are matched by the `*.file.is_log` fields by default
+var DefaultLogDirectories = []string{
+	"/var/log",
+	"/run/log/journal",
+}
+
+// LogPathSet holds a set of log directories, matched in a time proportional to the length of the path
+type LogPathSet struct {
+	sync.RWMutex
+	directories *eval.PathPrefixSet
+}
+
+// NewLogPathSet returns a new set holding the given directories
+func NewLogPathSet(directories ...string) *LogPathSet {
+	s := &LogPathSet{
+		directories: eval.NewPathPrefixSet(),
+	}
+	s.Add(directories...)
+	return s
+}
+
+// Add registers the given directories as log directories
+func (s *LogPathSet) Add(directories ...string) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, directory := range directories {
+		if directory == "" {
+			continue
+		}
+		s.directories.Add(directory)
+	}
+}
+
+// Contains returns whether the given path is located below one of the log directories
+func (s *LogPathSet) Contains(p string) bool {
+	if s == nil || p == "" {
+		return false
+	}
+
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.directories.Matches(p)
+}
@@ -116,7 +116,7 @@ func marshalTime(data []byte, t time.Duration) {
 
 // MarshalBinary marshalls a binary representation of itself
 func (e *Credentials) MarshalBinary(data []byte) (int, error) {
-	if len(data) < 48 {
+	if len(data) < 56 {
 		return 0, ErrNotEnoughSpace
 	}
 
@@ -130,20 +130,19 @@ func (e *Credentials) MarshalBinary(data []byte) (int, error) {
 	binary.NativeEndian.PutUint32(data[28:32], 1)
 	binary.NativeEndian.PutUint64(data[32:40], e.CapEffective)
 	binary.NativeEndian.PutUint64(data[40:48], e.CapPermitted)
-	return 48, nil
+	binary.NativeEndian.PutUint64(data[48:56], e.CapAmbient)
+	return 56, nil
 }
 
 // MarshalPidCache marshals a binary representation of itself
 func (e *Process) MarshalPidCache(data []byte, bootTime time.Time) (int, error) {
 	// Marshal pid_cache_t
-	if len(data) < 88 {
+	if len(data) < 104 {
 		return 0, ErrNotEnoughSpace
 	}
 	binary.NativeEndian.PutUint64(data[0:8], e.Cookie)
 	binary.NativeEndian.PutUint32(data[8:12], e.PPid)
-
-	// padding
-
+	binary.NativeEndian.PutUint32(data[12:16], e.NSPid)
 	marshalTime(data[16:24], e.ForkTime.Sub(bootTime))
 	marshalTime(data[24:32], e.ExitTime.Sub(bootTime))
 	binary.NativeEndian.PutUint64(data[32:40], e.UserSession.ID)
@@ -155,6 +154,10 @@ func (e *Process) MarshalPidCache(data []byte, bootTime time.Time) (int, error)
 	}
 	written += n
 
+	binary.NativeEndian.PutUint32(data[written:written+4], uint32(e.MntNS))
+	binary.NativeEndian.PutUint32(data[written+4:written+8], uint32(e.NSID))
+	written += 8
+
 	return written, nil
 }
 
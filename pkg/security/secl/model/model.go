@@ -9,9 +9,14 @@
 package model
 
 import (
+	"math"
 	"net"
 	"reflect"
 	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"modernc.org/mathutil"
@@ -24,6 +29,178 @@ import (
 // Model describes the data model for the runtime security agent events
 type Model struct {
 	ExtraValidateFieldFnc func(field eval.Field, fieldValue eval.FieldValue) error
+	// CaseInsensitivePaths makes the comparisons of the file paths case insensitive, for case insensitive filesystems
+	CaseInsensitivePaths bool
+
+	disabledEventTypes map[eval.EventType]bool
+	criticalPaths      *CriticalPathSet
+	logPaths           *LogPathSet
+	fileOwnerResolver  *FileOwnerResolver
+	interpreterClasses *InterpreterClassifier
+}
+
+// filePathStringCmpOpts returns the string comparison options of the file path fields
+func (m *Model) filePathStringCmpOpts() eval.StringCmpOpts {
+	return eval.StringCmpOpts{CaseInsensitive: m.CaseInsensitivePaths}
+}
+
+// SetCriticalPaths sets the set of critical paths matched by the `*.file.is_critical` fields
+func (m *Model) SetCriticalPaths(set *CriticalPathSet) {
+	m.criticalPaths = set
+}
+
+// RegisterCriticalPaths registers additional critical paths, on top of the default ones
+func (m *Model) RegisterCriticalPaths(paths ...string) {
+	m.CriticalPaths().Add(paths...)
+}
+
+// CriticalPaths returns the set of critical paths, holding the default critical paths if none was set
+func (m *Model) CriticalPaths() *CriticalPathSet {
+	if m.criticalPaths == nil {
+		m.criticalPaths = NewCriticalPathSet(DefaultCriticalPaths...)
+	}
+	return m.criticalPaths
+}
+
+// SetLogPaths sets the set of log directories matched by the `*.file.is_log` fields
+func (m *Model) SetLogPaths(set *LogPathSet) {
+	m.logPaths = set
+}
+
+// RegisterLogDirectories registers additional log directories, on top of the default ones
+func (m *Model) RegisterLogDirectories(directories ...string) {
+	m.LogPaths().Add(directories...)
+}
+
+// LogPaths returns the set of log directories, holding the default log directories if none was set
+func (m *Model) LogPaths() *LogPathSet {
+	if m.logPaths == nil {
+		m.logPaths = NewLogPathSet(DefaultLogDirectories...)
+	}
+	return m.logPaths
+}
+
+// SetInterpreterClassifier sets the classifier of the interpreters matched by the `*.file.interpreter_class` fields
+func (m *Model) SetInterpreterClassifier(classifier *InterpreterClassifier) {
+	m.interpreterClasses = classifier
+}
+
+// RegisterInterpreters registers additional basenames in the given interpreter class, on top of the default ones
+func (m *Model) RegisterInterpreters(class string, basenames ...string) {
+	m.InterpreterClassifier().Register(class, basenames...)
+}
+
+// InterpreterClassifier returns the classifier of the interpreters, holding the default classes if none was set
+func (m *Model) InterpreterClassifier() *InterpreterClassifier {
+	if m.interpreterClasses == nil {
+		m.interpreterClasses = NewInterpreterClassifier(DefaultInterpreterClasses)
+	}
+	return m.interpreterClasses
+}
+
+// SetFileOwnerResolver sets the resolver of the names of the owner user and group of the files
+func (m *Model) SetFileOwnerResolver(resolver *FileOwnerResolver) {
+	m.fileOwnerResolver = resolver
+}
+
+// SetFileOwnerCallbacks sets the callbacks resolving lazily the `*.file.user` and `*.file.group` fields from the
+// ids of the owner user and group of the files
+func (m *Model) SetFileOwnerCallbacks(resolveUser, resolveGroup FileOwnerResolverFnc) {
+	m.FileOwnerResolver().SetCallbacks(resolveUser, resolveGroup)
+}
+
+// SetOwnerNumericFallback sets whether the `*.user` and `*.group` fields return the numeric uid or gid when it
+// can't be resolved to a name, instead of an empty string
+func (m *Model) SetOwnerNumericFallback(enabled bool) {
+	m.FileOwnerResolver().SetNumericFallback(enabled)
+}
+
+// FileOwnerResolver returns the resolver of the names of the owner user and group of the files
+func (m *Model) FileOwnerResolver() *FileOwnerResolver {
+	if m.fileOwnerResolver == nil {
+		m.fileOwnerResolver = NewFileOwnerResolver(nil, nil)
+	}
+	return m.fileOwnerResolver
+}
+
+// IsFieldOptional returns whether the field may be empty for the given event type, like the process context fields of
+// the events that can be sent without being attributed to a process
+func (m *Model) IsFieldOptional(field eval.Field, eventType eval.EventType) bool {
+	return slices.Contains(m.GetFieldOptionalEventTypes(field), eventType)
+}
+
+// IteratorFields returns the fields evaluated through an iterator, like the `process.ancestors.*` fields, their
+// evaluators returning one value per iteration. The fields of the disabled event types are skipped.
+func (m *Model) IteratorFields() []eval.Field {
+	var fields []eval.Field
+	for _, field := range eventZero.GetFields() {
+		if evaluator, err := m.GetEvaluator(field, ""); err == nil && isIteratorEvaluator(evaluator) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// isIteratorEvaluator returns whether the evaluator is generated for an iterator, the only array evaluators weighted
+// by eval.IteratorWeight. The lengths of the iterators are scalar and then excluded.
+func isIteratorEvaluator(evaluator eval.Evaluator) bool {
+	var weight int
+	switch e := evaluator.(type) {
+	case *eval.IntArrayEvaluator:
+		weight = e.Weight
+	case *eval.StringArrayEvaluator:
+		weight = e.Weight
+	case *eval.BoolArrayEvaluator:
+		weight = e.Weight
+	case *eval.CIDRArrayEvaluator:
+		weight = e.Weight
+	case *eval.FloatArrayEvaluator:
+		weight = e.Weight
+	default:
+		return false
+	}
+	return weight > 0 && weight%eval.IteratorWeight == 0
+}
+
+// DisableEventTypes disables the given event types, their fields being then handled as unknown fields
+func (m *Model) DisableEventTypes(eventTypes ...eval.EventType) {
+	if m.disabledEventTypes == nil {
+		m.disabledEventTypes = make(map[eval.EventType]bool)
+	}
+	for _, eventType := range eventTypes {
+		m.disabledEventTypes[eventType] = true
+	}
+}
+
+// EnableEventTypes enables back the given event types, all the event types being enabled by default
+func (m *Model) EnableEventTypes(eventTypes ...eval.EventType) {
+	for _, eventType := range eventTypes {
+		delete(m.disabledEventTypes, eventType)
+	}
+}
+
+// IsEventTypeEnabled returns whether the given event type is enabled
+func (m *Model) IsEventTypeEnabled(eventType eval.EventType) bool {
+	return !m.disabledEventTypes[eventType]
+}
+
+// filterEventTypes removes the disabled event types from the given list
+func (m *Model) filterEventTypes(eventTypes []eval.EventType) []eval.EventType {
+	if len(m.disabledEventTypes) == 0 {
+		return eventTypes
+	}
+	return slices.DeleteFunc(eventTypes, func(eventType eval.EventType) bool {
+		return m.disabledEventTypes[eventType]
+	})
+}
+
+// isFieldDisabled returns whether the given field belongs to a disabled event type
+func (m *Model) isFieldDisabled(field eval.Field) bool {
+	if len(m.disabledEventTypes) == 0 {
+		return false
+	}
+	eventType, _, err := eventZero.GetFieldMetadata(field)
+	return err == nil && eventType != "" && m.disabledEventTypes[eventType]
 }
 
 var eventZero = Event{BaseEvent: BaseEvent{ContainerContext: &ContainerContext{}, Os: runtime.GOOS}}
@@ -132,9 +309,11 @@ type BaseEvent struct {
 	Service       string         `field:"event.service,handler:ResolveService,opts:skip_ad"` // SECLDoc[event.service] Definition:`Service associated with the event`
 	Hostname      string         `field:"event.hostname,handler:ResolveHostname"`            // SECLDoc[event.hostname] Definition:`Hostname associated with the event`
 
+	IsMetadataOnly bool `field:"event.is_metadata_only,handler:ResolveEventIsMetadataOnly"` // SECLDoc[event.is_metadata_only] Definition:`Indicates whether the event only changes the metadata of a file, like its permissions, owner, timestamps or extended attributes, without touching its data` Example:`event.is_metadata_only && chmod.file.path == "/etc/shadow"` Description:`Matches the permission changes of /etc/shadow.`
+
 	// context shared with all events
-	ProcessContext         *ProcessContext        `field:"process"`
-	ContainerContext       *ContainerContext      `field:"container"`
+	ProcessContext         *ProcessContext        `field:"process" optional_for:"dns,imds,raw_packet,load_module,unload_module"`
+	ContainerContext       *ContainerContext      `field:"container" optional_for:"dns,imds,raw_packet,load_module,unload_module"`
 	SecurityProfileContext SecurityProfileContext `field:"-"`
 
 	// internal usage
@@ -242,6 +421,31 @@ func (e *Event) RemoveFromFlags(flag uint32) {
 	e.Flags ^= flag
 }
 
+// SetFieldNativeValue sets the value of the given field like SetFieldValue, also accepting the integer fields as their
+// native Go type, for example an uint32 for an uid, instead of only as an int. The value is converted to an int
+// before being checked against the range of the field.
+func (e *Event) SetFieldNativeValue(field eval.Field, value interface{}) error {
+	switch v := value.(type) {
+	case int32:
+		value = int(v)
+	case int64:
+		if v < math.MinInt || v > math.MaxInt {
+			return &eval.ErrValueOutOfRange{Field: field}
+		}
+		value = int(v)
+	case uint16:
+		value = int(v)
+	case uint32:
+		value = int(v)
+	case uint64:
+		if v > math.MaxInt {
+			return &eval.ErrValueOutOfRange{Field: field}
+		}
+		value = int(v)
+	}
+	return e.SetFieldValue(field, value)
+}
+
 // GetType returns the event type
 func (e *Event) GetType() string {
 	return EventType(e.Type).String()
@@ -252,6 +456,36 @@ func (e *Event) GetEventType() EventType {
 	return EventType(e.Type)
 }
 
+// FieldsForEventType returns the fields available for the given event type, including the fields common to
+// all the event types
+func (e *Event) FieldsForEventType(eventType eval.EventType) []eval.Field {
+	var (
+		m      Model
+		fields []eval.Field
+	)
+
+	for _, field := range e.GetFields() {
+		evt, _, err := e.GetFieldMetadata(field)
+		if err != nil {
+			continue
+		}
+
+		switch evt {
+		case eventType:
+		case "":
+			if restrictions := m.GetFieldRestrictions(field); len(restrictions) > 0 && !slices.Contains(restrictions, eventType) {
+				continue
+			}
+		default:
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
 // GetTags returns the list of tags specific to this event
 func (e *Event) GetTags() []string {
 	tags := []string{"type:" + e.GetType()}
@@ -492,15 +726,30 @@ func NewProcessCacheEntry(coreRelease func(_ *ProcessCacheEntry)) *ProcessCacheE
 	return &ProcessCacheEntry{coreRelease: coreRelease}
 }
 
+// maxAncestorsDepth is the maximum number of ancestors walked by the ancestors iterator. A process cache holding a
+// cycle would otherwise make the iteration loop forever.
+const maxAncestorsDepth = 1024
+
+// ancestorsLoopCount counts the ancestor walks that were interrupted because they reached maxAncestorsDepth
+var ancestorsLoopCount atomic.Uint64
+
+// GetAndResetAncestorsLoopCount returns the number of ancestor walks interrupted because of a loop in the ancestors
+// chain since the last call
+func GetAndResetAncestorsLoopCount() uint64 {
+	return ancestorsLoopCount.Swap(0)
+}
+
 // ProcessAncestorsIterator defines an iterator of ancestors
 type ProcessAncestorsIterator struct {
-	prev *ProcessCacheEntry
+	prev  *ProcessCacheEntry
+	depth int
 }
 
 // Front returns the first element
 func (it *ProcessAncestorsIterator) Front(ctx *eval.Context) *ProcessCacheEntry {
 	if front := ctx.Event.(*Event).ProcessContext.Ancestor; front != nil {
 		it.prev = front
+		it.depth = 1
 		return front
 	}
 
@@ -510,7 +759,12 @@ func (it *ProcessAncestorsIterator) Front(ctx *eval.Context) *ProcessCacheEntry
 // Next returns the next element
 func (it *ProcessAncestorsIterator) Next() *ProcessCacheEntry {
 	if next := it.prev.Ancestor; next != nil {
+		if it.depth >= maxAncestorsDepth {
+			ancestorsLoopCount.Add(1)
+			return nil
+		}
 		it.prev = next
+		it.depth++
 		return next
 	}
 
@@ -523,6 +777,10 @@ func (it *ProcessAncestorsIterator) At(ctx *eval.Context, regID eval.RegisterID,
 		return entry.Value.(*ProcessCacheEntry)
 	}
 
+	if pos >= maxAncestorsDepth {
+		return nil
+	}
+
 	var i int
 
 	ancestor := ctx.Event.(*Event).ProcessContext.Ancestor
@@ -547,6 +805,10 @@ func (it *ProcessAncestorsIterator) Len(ctx *eval.Context) int {
 
 	ancestor := ctx.Event.(*Event).ProcessContext.Ancestor
 	for ancestor != nil {
+		if size >= maxAncestorsDepth {
+			ancestorsLoopCount.Add(1)
+			break
+		}
 		size++
 		ancestor = ancestor.Ancestor
 	}
@@ -559,12 +821,120 @@ func (p *ProcessContext) HasParent() bool {
 	return p.Parent != nil
 }
 
+// HasAncestor returns whether the process has at least one ancestor, without walking the ancestor chain
+func (p *ProcessContext) HasAncestor() bool {
+	return p.Ancestor != nil
+}
+
+// CountAncestorsExecs returns the number of re-exec links in the ancestor chain, that is the number of ancestors
+// sharing the pid of their child
+func (p *ProcessContext) CountAncestorsExecs() int {
+	var count int
+	child, ancestor := &p.Process, p.Ancestor
+	for depth := 0; ancestor != nil && depth < maxAncestorsDepth; depth++ {
+		if ancestor.Pid == child.Pid {
+			count++
+		}
+		child, ancestor = &ancestor.Process, ancestor.Ancestor
+	}
+	return count
+}
+
+// indexedAncestorsFieldPrefix is the prefix of the `process.ancestors[N].*` fields, addressing a given generation
+// of the ancestors when setting field values
+const indexedAncestorsFieldPrefix = "process.ancestors["
+
+// indexedAncestorField resolves a `process.ancestors[N].*` field into an event whose first ancestor is the
+// generation N of the ancestors of the event, and the matching `process.ancestors.*` field. The intermediate
+// ancestors are allocated as needed.
+func (e *Event) indexedAncestorField(field eval.Field) (*Event, eval.Field, bool) {
+	rest, found := strings.CutPrefix(field, indexedAncestorsFieldPrefix)
+	if !found {
+		return nil, "", false
+	}
+	index, subField, found := strings.Cut(rest, "].")
+	if !found {
+		return nil, "", false
+	}
+	generation, err := strconv.Atoi(index)
+	if err != nil || generation < 0 || generation >= maxAncestorsDepth {
+		return nil, "", false
+	}
+	ancestorField := "process.ancestors." + subField
+	if _, _, err := e.GetFieldMetadata(ancestorField); err != nil {
+		return nil, "", false
+	}
+
+	if e.BaseEvent.ProcessContext == nil {
+		e.BaseEvent.ProcessContext = &ProcessContext{}
+	}
+	pc := e.BaseEvent.ProcessContext
+	for i := 0; i < generation; i++ {
+		if pc.Ancestor == nil {
+			pc.Ancestor = &ProcessCacheEntry{}
+		}
+		pc = &pc.Ancestor.ProcessContext
+	}
+
+	return &Event{BaseEvent: BaseEvent{ProcessContext: pc}}, ancestorField, true
+}
+
+// FieldValueOpts defines the options used to retrieve the value of a field
+type FieldValueOpts struct {
+	// SortArrays returns a sorted copy of the array values, like the environment variables, the arguments or the
+	// values collected over the ancestors, so that the values of two events can be compared regardless of the order
+	// of their source
+	SortArrays bool
+}
+
+// GetFieldValueWithOpts returns the value of a field, like GetFieldValue, according to the given options
+func (e *Event) GetFieldValueWithOpts(field eval.Field, opts FieldValueOpts) (interface{}, error) {
+	value, err := e.GetFieldValue(field)
+	if err != nil || !opts.SortArrays {
+		return value, err
+	}
+
+	switch values := value.(type) {
+	case []string:
+		values = slices.Clone(values)
+		slices.Sort(values)
+		return values, nil
+	case []int:
+		values = slices.Clone(values)
+		slices.Sort(values)
+		return values, nil
+	case []float64:
+		values = slices.Clone(values)
+		slices.Sort(values)
+		return values, nil
+	case []bool:
+		values = slices.Clone(values)
+		slices.SortFunc(values, func(a, b bool) int {
+			if a == b {
+				return 0
+			}
+			if !a {
+				return -1
+			}
+			return 1
+		})
+		return values, nil
+	}
+	return value, nil
+}
+
 // ProcessContext holds the process context of an event
 type ProcessContext struct {
 	Process
 
+	// Parent is the process of the first ancestor only, set along with it, unlike the ancestors iterator
 	Parent   *Process           `field:"parent,opts:exposed_at_event_root_only,check:HasParent"`
 	Ancestor *ProcessCacheEntry `field:"ancestors,iterator:ProcessAncestorsIterator,check:IsNotKworker"`
+
+	HasAncestors        bool `field:"has_ancestors,handler:ResolveProcessHasAncestors,opts:exposed_at_event_root_only"`                // SECLDoc[has_ancestors] Definition:`Indicates whether the process has a known ancestor chain`
+	AncestorsExecCount  int  `field:"ancestors.exec_count,handler:ResolveProcessAncestorsExecCount,opts:exposed_at_event_root_only"`   // SECLDoc[ancestors.exec_count] Definition:`Number of re-exec links in the ancestor chain, that is the number of ancestors sharing the pid of their child` Example:`process.ancestors.exec_count > 3` Description:`Matches the processes resulting of a chain of more than 3 executions by the same process.`
+	FileEventsCount     int  `field:"file_events_count,handler:ResolveProcessFileEventsCount,opts:exposed_at_event_root_only"`         // SECLDoc[file_events_count] Definition:`Number of open, rename and unlink events of the process since its last execution` Example:`process.file_events_count > 1000` Description:`Matches the processes that opened, renamed or unlinked more than 1000 files, as done by ransomware.`
+	MetadataChangeCount int  `field:"metadata_change_count,handler:ResolveProcessMetadataChangeCount,opts:exposed_at_event_root_only"` // SECLDoc[metadata_change_count] Definition:`Number of chmod, chown, utimes, setxattr and removexattr events of the process since its last execution` Example:`process.metadata_change_count > 500` Description:`Matches the processes that changed the metadata of more than 500 files, as done when tampering with a system.`
 }
 
 // ExitEvent represents a process exit event
@@ -11,13 +11,18 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 	"syscall"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
 	"modernc.org/mathutil"
 )
 
@@ -26,6 +31,10 @@ const (
 	TmpFS     = "tmpfs"   // TmpFS tmpfs
 	UnknownFS = "unknown" // UnknownFS unknown filesystem
 
+	MemfdPrefix = "memfd:" // MemfdPrefix prefix of the names of the memfd files
+
+	DeletedPathSuffix = " (deleted)" // DeletedPathSuffix suffix added by the kernel to the path of an unlinked file
+
 	ErrPathMustBeAbsolute = "all the path have to be absolute"            // ErrPathMustBeAbsolute tells when a path is not absolute
 	ErrPathDepthLimit     = "path depths have to be shorter than"         // ErrPathDepthLimit tells when a path is too long
 	ErrPathSegmentLimit   = "each segment of a path must be shorter than" // ErrPathSegmentLimit tells when a patch reached the segment limit
@@ -130,7 +139,66 @@ func (c *Credentials) Equals(o *Credentials) bool {
 		c.FSUID == o.FSUID &&
 		c.FSGID == o.FSGID &&
 		c.CapEffective == o.CapEffective &&
-		c.CapPermitted == o.CapPermitted
+		c.CapPermitted == o.CapPermitted &&
+		c.CapAmbient == o.CapAmbient
+}
+
+// IsUIDMismatch returns whether the effective UID differs from the real UID
+func (c *Credentials) IsUIDMismatch() bool {
+	return c.UID != c.EUID
+}
+
+// IsGIDMismatch returns whether the effective GID differs from the real GID
+func (c *Credentials) IsGIDMismatch() bool {
+	return c.GID != c.EGID
+}
+
+// GetUser returns the user of the process, resolved from its uid when its name is unknown
+func (c *Credentials) GetUser(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if len(c.User) == 0 {
+		return resolver.ResolveUser(c.UID, containerID)
+	}
+	return c.User
+}
+
+// GetGroup returns the group of the process, resolved from its gid when its name is unknown
+func (c *Credentials) GetGroup(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if len(c.Group) == 0 {
+		return resolver.ResolveGroup(c.GID, containerID)
+	}
+	return c.Group
+}
+
+// GetEUser returns the effective user of the process, resolved from its euid when its name is unknown
+func (c *Credentials) GetEUser(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if len(c.EUser) == 0 {
+		return resolver.ResolveUser(c.EUID, containerID)
+	}
+	return c.EUser
+}
+
+// GetEGroup returns the effective group of the process, resolved from its egid when its name is unknown
+func (c *Credentials) GetEGroup(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if len(c.EGroup) == 0 {
+		return resolver.ResolveGroup(c.EGID, containerID)
+	}
+	return c.EGroup
+}
+
+// GetFSUser returns the file-system user of the process, resolved from its fsuid when its name is unknown
+func (c *Credentials) GetFSUser(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if len(c.FSUser) == 0 {
+		return resolver.ResolveUser(c.FSUID, containerID)
+	}
+	return c.FSUser
+}
+
+// GetFSGroup returns the file-system group of the process, resolved from its fsgid when its name is unknown
+func (c *Credentials) GetFSGroup(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if len(c.FSGroup) == 0 {
+		return resolver.ResolveGroup(c.FSGID, containerID)
+	}
+	return c.FSGroup
 }
 
 // SetSpan sets the span
@@ -154,6 +222,130 @@ func (p *Process) IsNotKworker() bool {
 	return !p.IsKworker
 }
 
+// IsCommTruncated returns whether the comm of the process may have been truncated by the kernel
+func (p *Process) IsCommTruncated() bool {
+	return len(p.Comm) >= MaxCommLength
+}
+
+// HasSecretLikeEnv returns whether one of the given environment variables of the process matches the matcher
+func (p *Process) HasSecretLikeEnv(envs []string, matcher *SecretLikeEnvMatcher) bool {
+	if p.EnvsEntry != nil {
+		return p.EnvsEntry.HasSecretLike(matcher)
+	}
+	return matcher.MatchesAny(envs)
+}
+
+// HasShellMetacharsArgs returns whether one of the given arguments of the process contains a metacharacter of the
+// matcher
+func (p *Process) HasShellMetacharsArgs(argv []string, matcher *ShellMetacharsMatcher) bool {
+	if p.ArgsEntry != nil {
+		return p.ArgsEntry.HasShellMetachars(matcher)
+	}
+	return matcher.MatchesAny(argv)
+}
+
+// GetDistinctArgsCount returns the number of distinct values among the given arguments of the process
+func (p *Process) GetDistinctArgsCount(argv []string) int {
+	if p.ArgsEntry != nil {
+		return p.ArgsEntry.DistinctCount()
+	}
+	return CountDistinctArgs(argv)
+}
+
+// GetDistinctEnvsCount returns the number of distinct names among the given environment variables of the process
+func (p *Process) GetDistinctEnvsCount(envs []string) int {
+	if p.EnvsEntry != nil {
+		return p.EnvsEntry.DistinctCount()
+	}
+	return CountDistinctEnvs(envs)
+}
+
+// TTY session types
+const (
+	TTYSessionTypeConsole = "console"
+	TTYSessionTypePTS     = "pts"
+	TTYSessionTypeSSH     = "ssh"
+	TTYSessionTypeNone    = "none"
+)
+
+// sshSessionEnvs are the environment variables set by sshd for the processes of an SSH session
+var sshSessionEnvs = []string{"SSH_CONNECTION", "SSH_CLIENT", "SSH_TTY"}
+
+// GetTTYSessionType classifies the session of the process from the name of its tty, a pseudo terminal being an
+// SSH session when the given environment variables of the process contain the ones set by sshd
+func (p *Process) GetTTYSessionType(envs func() []string) string {
+	name := strings.TrimPrefix(p.TTYName, "/dev/")
+	switch {
+	case name == "":
+		return TTYSessionTypeNone
+	case strings.HasPrefix(name, "pts"):
+		if envs != nil && slices.ContainsFunc(envs(), func(env string) bool {
+			return slices.Contains(sshSessionEnvs, env)
+		}) {
+			return TTYSessionTypeSSH
+		}
+		return TTYSessionTypePTS
+	case strings.HasPrefix(name, "tty"), name == "console":
+		return TTYSessionTypeConsole
+	}
+	return TTYSessionTypeNone
+}
+
+// ResolveTTYSessionType returns the session type of the process, classified once with GetTTYSessionType
+func (p *Process) ResolveTTYSessionType(envs func() []string) string {
+	if p.TTYSessionType == "" {
+		p.TTYSessionType = p.GetTTYSessionType(envs)
+	}
+	return p.TTYSessionType
+}
+
+// IsCommMatchingBasename returns whether the comm of the process matches the given binary basename, a comm
+// truncated by the kernel matching when it is a prefix of the basename. An unknown basename is considered matching.
+func (p *Process) IsCommMatchingBasename(basename string) bool {
+	if basename == "" || p.Comm == basename {
+		return true
+	}
+	return p.IsCommTruncated() && strings.HasPrefix(basename, p.Comm)
+}
+
+// NameEntropy returns the Shannon entropy of the bytes of the given name, in bits per character. Randomly generated
+// names, like the ones of dropped binaries, score higher than words.
+func NameEntropy(name string) float64 {
+	if len(name) == 0 {
+		return 0
+	}
+
+	var counts [256]int
+	for i := 0; i < len(name); i++ {
+		counts[name[i]]++
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		if count > 0 {
+			p := float64(count) / float64(len(name))
+			entropy -= p * math.Log2(p)
+		}
+	}
+	return entropy
+}
+
+// IsSuccess returns whether the syscall succeeded, errors being returned as negative errno values
+func (e *SyscallEvent) IsSuccess() bool {
+	return e.Retval >= 0
+}
+
+// GetHash returns the already resolved hash of the file for the given algorithm, or an empty string
+func (e *FileEvent) GetHash(algorithm HashAlgorithm) string {
+	prefix := algorithm.String() + ":"
+	for _, hash := range e.Hashes {
+		if value, found := strings.CutPrefix(hash, prefix); found {
+			return value
+		}
+	}
+	return ""
+}
+
 // GetProcessArgv returns the unscrubbed args of the event as an array. Use with caution.
 func (p *Process) GetProcessArgv() ([]string, bool) {
 	if p.ArgsEntry == nil {
@@ -183,6 +375,158 @@ func (p *Process) GetProcessArgv0() (string, bool) {
 	return p.Argv0, p.ArgsTruncated
 }
 
+const (
+	// LinkTypeHard is the type of a hard link
+	LinkTypeHard = "hard"
+	// LinkTypeSymbolic is the type of a symbolic link
+	LinkTypeSymbolic = "symbolic"
+)
+
+// GetLinkType returns the type of the link
+func (e *LinkEvent) GetLinkType() string {
+	if e.IsSymbolic {
+		return LinkTypeSymbolic
+	}
+	return LinkTypeHard
+}
+
+// IsToRoot returns whether the file is chown-ed to the root user or the root group, -1 meaning that the owner
+// or the group is left unchanged
+func (e *ChownEvent) IsToRoot() bool {
+	return e.UID == 0 || e.GID == 0
+}
+
+// IsSelfDelete returns whether the unlinked file is the executable of the process, identified by its inode and
+// mount id, false when the executable of the process isn't resolved
+func (e *UnlinkEvent) IsSelfDelete(pc *ProcessContext) bool {
+	if pc == nil || pc.FileEvent.Inode == 0 {
+		return false
+	}
+	return e.File.Inode == pc.FileEvent.Inode && e.File.MountID == pc.FileEvent.MountID
+}
+
+// IsNamespaceInit returns whether the process is the init process of its pid namespace
+func (p *Process) IsNamespaceInit() bool {
+	return p.Pid == 1 || p.NSPid == 1
+}
+
+// IsCrossMount returns whether the source and the destination files are on different mount points
+func IsCrossMount(source *FileEvent, destination *FileEvent) bool {
+	if source.MountID == 0 || destination.MountID == 0 {
+		return false
+	}
+	return source.MountID != destination.MountID
+}
+
+// IsWrite returns whether the file is opened for writing
+func (e *OpenEvent) IsWrite() bool {
+	return e.Flags&(syscall.O_WRONLY|syscall.O_RDWR|syscall.O_APPEND|syscall.O_CREAT) != 0
+}
+
+// IsTruncating returns whether the file is truncated when opened
+func (e *OpenEvent) IsTruncating() bool {
+	return e.Flags&syscall.O_TRUNC != 0
+}
+
+// ContainsDotDotSegment returns whether the given path contains ".." segments, i.e. whether its lexical
+// normalization would resolve parent directory references. Names merely containing ".." aren't considered.
+func ContainsDotDotSegment(path string) bool {
+	for path != "" {
+		var segment string
+		segment, path, _ = strings.Cut(path, "/")
+		if segment == ".." {
+			return true
+		}
+	}
+	return false
+}
+
+// PathDepth returns the number of segments of the given path, empty segments being ignored so that the depth of
+// "/" is 0 and the depth of "/a/b/c" is 3. Relative paths are counted the same way.
+func PathDepth(path string) int {
+	var depth int
+	for path != "" {
+		var segment string
+		segment, path, _ = strings.Cut(path, "/")
+		if segment != "" {
+			depth++
+		}
+	}
+	return depth
+}
+
+// ParentPath returns the directory component of the given path, so that the parent of "/a/b/c" is "/a/b" and the
+// parent of "/a" is "/". The parent of a relative path without any directory component is empty.
+func ParentPath(path string) string {
+	switch i := strings.LastIndexByte(path, '/'); i {
+	case -1:
+		return ""
+	case 0:
+		return "/"
+	default:
+		return path[:i]
+	}
+}
+
+// IsValidPath returns whether the given path is valid UTF-8 and free of control characters
+func IsValidPath(path string) bool {
+	if !utf8.ValidString(path) {
+		return false
+	}
+	for _, r := range path {
+		if unicode.IsControl(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// SanitizePath returns the given path with its invalid UTF-8 bytes and control characters escaped as \xNN or \uNNNN
+func SanitizePath(path string) string {
+	if IsValidPath(path) {
+		return path
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(path); {
+		r, size := utf8.DecodeRuneInString(path[i:])
+		switch {
+		case r == utf8.RuneError && size <= 1:
+			fmt.Fprintf(&sb, "\\x%02x", path[i])
+		case unicode.IsControl(r):
+			if r < utf8.RuneSelf {
+				fmt.Fprintf(&sb, "\\x%02x", r)
+			} else {
+				fmt.Fprintf(&sb, "\\u%04x", r)
+			}
+		default:
+			sb.WriteString(path[i : i+size])
+		}
+		i += size
+	}
+	return sb.String()
+}
+
+// IsSecurityLabelXAttr returns whether the extended attribute is a security label, labels being the names of the
+// label attributes of the security namespace
+func IsSecurityLabelXAttr(namespace string, name string, labels []string) bool {
+	if namespace != "security" {
+		return false
+	}
+	return slices.Contains(labels, strings.TrimPrefix(name, namespace+"."))
+}
+
+// IntegrityXAttrs lists the extended attributes holding the integrity measurements or signatures of the files
+var IntegrityXAttrs = []string{"security.ima", "security.evm"}
+
+// IsIntegrityXAttr returns whether the extended attribute is one of the integrity attributes
+func IsIntegrityXAttr(namespace string, name string) bool {
+	if namespace == "" {
+		return false
+	}
+	return slices.Contains(IntegrityXAttrs, namespace+"."+strings.TrimPrefix(name, namespace+"."))
+}
+
 // Equals compares two FileFields
 func (f *FileFields) Equals(o *FileFields) bool {
 	return f.Inode == o.Inode && f.MountID == o.MountID && f.MTime == o.MTime && f.UID == o.UID && f.GID == o.GID && f.Mode == o.Mode
@@ -194,6 +538,75 @@ func (f *FileFields) IsFileless() bool {
 	return f.Inode != 0 && f.MountID == 0
 }
 
+// ResolveMountPath returns the path of the mount point of the mount the file resides in, resolved once with the given
+// function from the mount ID and device of the file. A fileless file has no mount point.
+func (f *FileEvent) ResolveMountPath(resolve func(mountID uint32, device uint32) (string, MountSource, MountOrigin, error)) string {
+	if f.MountPath == "" && !f.IsFileless() {
+		mountPath, source, origin, err := resolve(f.MountID, f.Device)
+		if err != nil {
+			return ""
+		}
+		f.MountPath = mountPath
+		f.MountSource = source
+		f.MountOrigin = origin
+	}
+	return f.MountPath
+}
+
+// IsMemfd returns whether the file is a memfd or an anonymous file, given its resolved path and basename
+func (f *FileEvent) IsMemfd(path string, basename string) bool {
+	if f.IsFileless() || strings.HasPrefix(path, MemfdPrefix) {
+		return true
+	}
+	return path == "" && strings.HasPrefix(basename, MemfdPrefix)
+}
+
+// IsDeleted returns whether the file was deleted from the disk, given its resolved path
+func (f *FileEvent) IsDeleted(path string) bool {
+	return f.Deleted || strings.HasSuffix(path, DeletedPathSuffix)
+}
+
+// MaxCmdLineLength is the maximum length of the command line of a process, longer command lines being truncated
+const MaxCmdLineLength = 4096
+
+// BuildCmdLine returns the command line of a process, argv0 followed by the arguments, truncated and suffixed with
+// "..." when longer than MaxCmdLineLength
+func BuildCmdLine(argv0 string, argv []string) string {
+	var builder strings.Builder
+	builder.WriteString(argv0)
+	for _, arg := range argv {
+		if builder.Len() > 0 {
+			builder.WriteByte(' ')
+		}
+		builder.WriteString(arg)
+	}
+
+	cmdline := builder.String()
+	if len(cmdline) > MaxCmdLineLength {
+		cmdline = cmdline[:MaxCmdLineLength] + "..."
+	}
+	return cmdline
+}
+
+// IsHostPath returns whether the file resides on a host filesystem and not in an overlay layer, given the
+// filesystem it resides in
+func (f *FileEvent) IsHostPath(filesystem string) bool {
+	if f.IsFileless() || f.GetInUpperLayer() || f.GetInLowerLayer() {
+		return false
+	}
+	return filesystem != "" && filesystem != OverlayFS
+}
+
+// IsSetuid returns whether the file has the setuid bit set
+func (f *FileFields) IsSetuid() bool {
+	return f.Mode&syscall.S_ISUID != 0
+}
+
+// IsSetgid returns whether the file has the setgid bit set
+func (f *FileFields) IsSetgid() bool {
+	return f.Mode&syscall.S_ISGID != 0
+}
+
 // HasHardLinks returns whether the file has hardlink
 func (f *FileFields) HasHardLinks() bool {
 	return f.NLink > 1
@@ -209,6 +622,80 @@ func (f *FileFields) GetInUpperLayer() bool {
 	return f.Flags&UpperLayer != 0
 }
 
+// GetID returns an identifier of the file unique across mounts, inodes being only unique within a filesystem
+func (f *FileFields) GetID() string {
+	if f.Inode == 0 {
+		return ""
+	}
+	return f.PathKey.String()
+}
+
+// GetUser returns the name of the owner user of the file, resolved from its uid on the first successful call. The
+// uid that couldn't be resolved is looked up again on the next call.
+func (f *FileFields) GetUser(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if !f.IsUserResolved {
+		if len(f.User) == 0 {
+			user, resolved := resolver.lookupUser(f.UID, containerID)
+			if !resolved {
+				return resolver.fallback(f.UID)
+			}
+			f.User = user
+		}
+		f.IsUserResolved = true
+	}
+	return f.User
+}
+
+// GetGroup returns the name of the owner group of the file, resolved from its gid on the first successful call. The
+// gid that couldn't be resolved is looked up again on the next call.
+func (f *FileFields) GetGroup(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	if !f.IsGroupResolved {
+		if len(f.Group) == 0 {
+			group, resolved := resolver.lookupGroup(f.GID, containerID)
+			if !resolved {
+				return resolver.fallback(f.GID)
+			}
+			f.Group = group
+		}
+		f.IsGroupResolved = true
+	}
+	return f.Group
+}
+
+// GetUser returns the name of the new owner user of the file, resolved from its uid on the first successful call
+func (e *ChownEvent) GetUser(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	// a negative uid leaves the owner user unchanged
+	if len(e.User) == 0 && e.UID >= 0 {
+		user, resolved := resolver.lookupUser(uint32(e.UID), containerID)
+		if !resolved {
+			return resolver.fallback(uint32(e.UID))
+		}
+		e.User = user
+	}
+	return e.User
+}
+
+// GetGroup returns the name of the new owner group of the file, resolved from its gid on the first successful call
+func (e *ChownEvent) GetGroup(resolver *FileOwnerResolver, containerID containerutils.ContainerID) string {
+	// a negative gid leaves the owner group unchanged
+	if len(e.Group) == 0 && e.GID >= 0 {
+		group, resolved := resolver.lookupGroup(uint32(e.GID), containerID)
+		if !resolved {
+			return resolver.fallback(uint32(e.GID))
+		}
+		e.Group = group
+	}
+	return e.Group
+}
+
+// GetResolvedPath returns the path of the file with its symlinks resolved, or the requested path if it wasn't resolved
+func (e *FileEvent) GetResolvedPath(requested string) string {
+	if len(e.ResolvedPathnameStr) == 0 {
+		return requested
+	}
+	return e.ResolvedPathnameStr
+}
+
 // Equals compare two FileEvent
 func (e *FileEvent) Equals(o *FileEvent) bool {
 	return e.FileFields.Equals(&o.FileFields)
@@ -429,3 +916,13 @@ type ExtraFieldHandlers interface {
 	ResolveAWSSecurityCredentials(event *Event) []AWSSecurityCredentials
 	ResolveSyscallCtxArgs(ev *Event, e *SyscallContext)
 }
+
+// IsUIDChanged returns whether the setuid call changed the UID of the process
+func (e *SetuidEvent) IsUIDChanged() bool {
+	return e.UID != e.PreviousUID
+}
+
+// IsGIDChanged returns whether the setgid call changed the GID of the process
+func (e *SetgidEvent) IsGIDChanged() bool {
+	return e.GID != e.PreviousGID
+}
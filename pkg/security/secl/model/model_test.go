@@ -10,12 +10,20 @@ package model
 
 import (
 	"errors"
+	"fmt"
+	"math"
 	"net"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"syscall"
 	"testing"
 
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/containerutils"
+	"golang.org/x/sys/unix"
 )
 
 func TestPathValidation(t *testing.T) {
@@ -117,6 +125,112 @@ func TestPathValidation(t *testing.T) {
 	}
 }
 
+func TestIsArrayField(t *testing.T) {
+	event := NewFakeEvent()
+
+	for field, expected := range map[string]bool{
+		"exec.argv":                          true,
+		"exec.envs":                          true,
+		"process.ancestors.comm":             true,
+		"process.ancestors.file.path":        true,
+		"process.ancestors.length":           false,
+		"exec.comm":                          false,
+		"exec.args":                          false, // the arguments joined as a single string
+		"process.file.path.length":           false,
+		"process.ancestors.file.name.length": true,
+	} {
+		isArray, err := event.IsArrayField(field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if isArray != expected {
+			t.Errorf("expected `%s` array flag to be %v, got %v", field, expected, isArray)
+		}
+	}
+
+	var fieldNotFoundError *eval.ErrFieldNotFound
+	if _, err := event.IsArrayField("exec.unknown"); !errors.As(err, &fieldNotFoundError) {
+		t.Errorf("expected a field not found error, got %v", err)
+	}
+}
+
+func TestGetFieldValueSortedArrays(t *testing.T) {
+	newEvent := func(envs []string, argv []string, ancestorPids []uint32) *Event {
+		event := NewFakeEvent()
+		event.Type = uint32(ExecEventType)
+		event.Exec.Process = &Process{Envs: envs, Argv: argv}
+
+		pc := &ProcessContext{}
+		event.ProcessContext = pc
+		for _, pid := range ancestorPids {
+			pc.Ancestor = &ProcessCacheEntry{ProcessContext: ProcessContext{Process: Process{PIDContext: PIDContext{Pid: pid}, FileNameEntropy: float64(pid) / 10}}}
+			pc = &pc.Ancestor.ProcessContext
+		}
+		return event
+	}
+
+	event1 := newEvent([]string{"PATH", "HOME", "LANG"}, []string{"-v", "--output", "file"}, []uint32{42, 7, 1})
+	event2 := newEvent([]string{"LANG", "PATH", "HOME"}, []string{"file", "-v", "--output"}, []uint32{7, 1, 42})
+
+	t.Run("sorted", func(t *testing.T) {
+		for _, field := range []string{"exec.envs", "exec.argv", "process.ancestors.pid", "process.ancestors.file.name_entropy"} {
+			value1, err := event1.GetFieldValueWithOpts(field, FieldValueOpts{SortArrays: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			value2, err := event2.GetFieldValueWithOpts(field, FieldValueOpts{SortArrays: true})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(value1, value2) {
+				t.Errorf("expected the sorted values of `%s` to be equal, got %v and %v", field, value1, value2)
+			}
+		}
+
+		value, err := event1.GetFieldValueWithOpts("exec.envs", FieldValueOpts{SortArrays: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []string{"HOME", "LANG", "PATH"}; !reflect.DeepEqual(value, expected) {
+			t.Errorf("expected %v, got %v", expected, value)
+		}
+		if expected := []string{"PATH", "HOME", "LANG"}; !reflect.DeepEqual(event1.Exec.Process.Envs, expected) {
+			t.Errorf("expected the event values to be left untouched, got %v", event1.Exec.Process.Envs)
+		}
+
+		value, err = event1.GetFieldValueWithOpts("process.ancestors.file.name_entropy", FieldValueOpts{SortArrays: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []float64{0.1, 0.7, 4.2}; !reflect.DeepEqual(value, expected) {
+			t.Errorf("expected %v, got %v", expected, value)
+		}
+	})
+
+	t.Run("default-order", func(t *testing.T) {
+		for _, value := range []func() (interface{}, error){
+			func() (interface{}, error) { return event2.GetFieldValue("exec.envs") },
+			func() (interface{}, error) { return event2.GetFieldValueWithOpts("exec.envs", FieldValueOpts{}) },
+		} {
+			envs, err := value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if expected := []string{"LANG", "PATH", "HOME"}; !reflect.DeepEqual(envs, expected) {
+				t.Errorf("expected the insertion order %v, got %v", expected, envs)
+			}
+		}
+
+		pids, err := event2.GetFieldValue("process.ancestors.pid")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := []int{7, 1, 42}; !reflect.DeepEqual(pids, expected) {
+			t.Errorf("expected the ancestors order %v, got %v", expected, pids)
+		}
+	})
+}
+
 func TestSetFieldValue(t *testing.T) {
 	var readOnlyError *eval.ErrFieldReadOnly
 	var fieldNotSupportedError *eval.ErrNotSupported
@@ -183,6 +297,33 @@ func TestSetFieldValue(t *testing.T) {
 			default:
 				t.Errorf("unable to get the expected `%s` value: %v", field, v)
 			}
+		case reflect.Float64:
+			err = event.SetFieldValue(field, 1.5)
+			if err != nil {
+				if errors.As(err, &readOnlyError) {
+					continue
+				}
+				t.Error(err)
+			}
+			value, err := event.GetFieldValue(field)
+			if err != nil {
+				if errors.As(err, &fieldNotSupportedError) {
+					continue
+				}
+				t.Errorf("unable to get the expected `%s` value: %v", field, err)
+			}
+			switch v := value.(type) {
+			case float64:
+				if v != 1.5 {
+					t.Errorf("unable to get the expected `%s` value: %v", field, v)
+				}
+			case []float64:
+				if v[0] != 1.5 {
+					t.Errorf("unable to get the expected `%s` value: %v", field, v)
+				}
+			default:
+				t.Errorf("unable to get the expected `%s` value: %v", field, v)
+			}
 		case reflect.Bool:
 			err = event.SetFieldValue(field, true)
 			if err != nil {
@@ -230,3 +371,4089 @@ func TestSetFieldValue(t *testing.T) {
 		}
 	}
 }
+
+func TestExecEnvs(t *testing.T) {
+	event := NewFakeEvent()
+	event.Type = uint32(ExecEventType)
+	event.Exec.Process = &Process{
+		Envs: []string{"PATH=/usr/bin", "LD_PRELOAD=/tmp/libhook.so", "HOME=/root"},
+	}
+
+	ctx := eval.NewContext(event)
+
+	t.Run("length", func(t *testing.T) {
+		evaluator, err := (&Model{}).GetEvaluator("exec.envs.length", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if length := evaluator.Eval(ctx).(int); length != len(event.Exec.Process.Envs) {
+			t.Errorf("expected %d envs, got %d", len(event.Exec.Process.Envs), length)
+		}
+
+		value, err := event.GetFieldValue("exec.envs.length")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value.(int) != len(event.Exec.Process.Envs) {
+			t.Errorf("expected %d envs, got %v", len(event.Exec.Process.Envs), value)
+		}
+	})
+
+	hasEnv := func(t *testing.T, expr string) bool {
+		rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := rule.GenEvaluator(&Model{}); err != nil {
+			t.Fatal(err)
+		}
+		return rule.Eval(ctx)
+	}
+
+	t.Run("present", func(t *testing.T) {
+		if !hasEnv(t, `has_env("LD_PRELOAD")`) {
+			t.Error("LD_PRELOAD should be present")
+		}
+	})
+
+	t.Run("absent", func(t *testing.T) {
+		if hasEnv(t, `has_env("LD_LIBRARY_PATH")`) {
+			t.Error("LD_LIBRARY_PATH shouldn't be present")
+		}
+		if hasEnv(t, `has_env("LD_PRE")`) {
+			t.Error("LD_PRE shouldn't match as a prefix of LD_PRELOAD")
+		}
+	})
+
+	t.Run("envs-entry", func(t *testing.T) {
+		event.Exec.Process.EnvsEntry = &EnvsEntry{
+			Values: []string{"LD_PRELOAD=/tmp/libhook.so"},
+		}
+		defer func() {
+			event.Exec.Process.EnvsEntry = nil
+		}()
+
+		if !hasEnv(t, `has_env("LD_PRELOAD")`) {
+			t.Error("LD_PRELOAD should be present")
+		}
+		if hasEnv(t, `has_env("PATH")`) {
+			t.Error("PATH shouldn't be present")
+		}
+	})
+}
+
+// newTestEvent returns a fake event of the given type, its derived fields being resolved by the given test handlers
+func newTestEvent(eventType EventType, fh *testFieldHandlers) *Event {
+	event := NewFakeEvent()
+	event.FieldHandlers = fh
+	event.Type = uint32(eventType)
+	return event
+}
+
+// testFieldHandlers overrides the fake handlers of the derived fields with their actual computation
+type testFieldHandlers struct {
+	FakeFieldHandlers
+
+	securityLabelXAttrs []string
+	agentPid            uint32
+	mountPaths          map[uint32]string
+	secretLikeEnvs      *SecretLikeEnvMatcher
+	shellMetachars      *ShellMetacharsMatcher
+	criticalPaths       *CriticalPathSet
+	logPaths            *LogPathSet
+	fileOwners          *FileOwnerResolver
+	interpreterClasses  *InterpreterClassifier
+}
+
+func (fh *testFieldHandlers) ResolveFileFieldsUser(ev *Event, e *FileFields) string {
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveFileFieldsGroup(ev *Event, e *FileFields) string {
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveChownUID(ev *Event, e *ChownEvent) string {
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveChownGID(ev *Event, e *ChownEvent) string {
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveProcessCommTruncated(_ *Event, process *Process) bool {
+	return process.IsCommTruncated()
+}
+
+func (fh *testFieldHandlers) ResolveProcessCommMatchesBinary(ev *Event, process *Process) bool {
+	return process.IsCommMatchingBasename(fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+func (fh *testFieldHandlers) ResolveProcessArgsHasShellMetachars(ev *Event, process *Process) bool {
+	return process.HasShellMetacharsArgs(fh.ResolveProcessArgv(ev, process), fh.shellMetachars)
+}
+
+func (fh *testFieldHandlers) ResolveProcessArgsDistinctCount(ev *Event, process *Process) int {
+	return process.GetDistinctArgsCount(fh.ResolveProcessArgv(ev, process))
+}
+
+func (fh *testFieldHandlers) ResolveProcessEnvsDistinctCount(ev *Event, process *Process) int {
+	return process.GetDistinctEnvsCount(fh.ResolveProcessEnvs(ev, process))
+}
+
+func (fh *testFieldHandlers) ResolveProcessEnvsHasSecretLike(ev *Event, process *Process) bool {
+	return process.HasSecretLikeEnv(fh.ResolveProcessEnvs(ev, process), fh.secretLikeEnvs)
+}
+
+func (fh *testFieldHandlers) ResolveLinkType(_ *Event, e *LinkEvent) string {
+	return e.GetLinkType()
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileEventsCount(_ *Event, pc *ProcessContext) int {
+	return pc.FileEventsCount
+}
+
+func (fh *testFieldHandlers) ResolveProcessMetadataChangeCount(_ *Event, pc *ProcessContext) int {
+	return pc.MetadataChangeCount
+}
+
+func (fh *testFieldHandlers) ResolveProcessHasAncestors(_ *Event, pc *ProcessContext) bool {
+	return pc.HasAncestor()
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileMD5(_ *Event, process *Process) string {
+	return process.FileEvent.GetHash(MD5)
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileSHA256(_ *Event, process *Process) string {
+	return process.FileEvent.GetHash(SHA256)
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileIsMemfd(ev *Event, process *Process) bool {
+	return process.FileEvent.IsMemfd(fh.ResolveFilePath(ev, &process.FileEvent), fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+func (fh *testFieldHandlers) ResolveProcessSessionType(ev *Event, process *Process) string {
+	return process.ResolveTTYSessionType(func() []string {
+		return fh.ResolveProcessEnvs(ev, process)
+	})
+}
+
+func (fh *testFieldHandlers) ResolveProcessIsInit(_ *Event, process *Process) bool {
+	return process.IsNamespaceInit()
+}
+
+func (fh *testFieldHandlers) ResolveEventIsMetadataOnly(_ *Event, e *BaseEvent) bool {
+	return EventType(e.Type).IsMetadataOnly()
+}
+
+func (fh *testFieldHandlers) ResolveProcessAncestorsExecCount(_ *Event, pc *ProcessContext) int {
+	return pc.CountAncestorsExecs()
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileIsSetuid(_ *Event, process *Process) bool {
+	return process.FileEvent.IsSetuid()
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileIsSetgid(_ *Event, process *Process) bool {
+	return process.FileEvent.IsSetgid()
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileIsDeleted(ev *Event, process *Process) bool {
+	return process.FileEvent.IsDeleted(fh.ResolveFilePath(ev, &process.FileEvent))
+}
+
+func (fh *testFieldHandlers) ResolveSetuidChanged(_ *Event, e *SetuidEvent) bool {
+	return e.IsUIDChanged()
+}
+
+func (fh *testFieldHandlers) ResolveSetgidChanged(_ *Event, e *SetgidEvent) bool {
+	return e.IsGIDChanged()
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileInterpreterClass(ev *Event, process *Process) string {
+	return fh.interpreterClasses.Classify(fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileNameEntropy(ev *Event, process *Process) float64 {
+	return NameEntropy(fh.ResolveFileBasename(ev, &process.FileEvent))
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileLayerID(_ *Event, process *Process) string {
+	return process.FileEvent.LayerID
+}
+
+func (fh *testFieldHandlers) ResolveProcessFileIsHostPath(ev *Event, process *Process) bool {
+	return process.FileEvent.IsHostPath(fh.ResolveFileFilesystem(ev, &process.FileEvent))
+}
+
+func (fh *testFieldHandlers) ResolveSyscallSucceeded(_ *Event, e *SyscallEvent) bool {
+	return e.IsSuccess()
+}
+
+func (fh *testFieldHandlers) ResolveSyscallFailed(_ *Event, e *SyscallEvent) bool {
+	return !e.IsSuccess()
+}
+
+func (fh *testFieldHandlers) ResolveProcessIsAgent(_ *Event, process *Process) bool {
+	return process.Pid == fh.agentPid
+}
+
+func (fh *testFieldHandlers) ResolveRenameDestinationContainsDotDot(_ *Event, e *RenameEvent) bool {
+	return ContainsDotDotSegment(e.SyscallContext.StrArg2)
+}
+
+func (fh *testFieldHandlers) ResolveLinkDestinationContainsDotDot(_ *Event, e *LinkEvent) bool {
+	return ContainsDotDotSegment(e.SyscallContext.StrArg2)
+}
+
+func (fh *testFieldHandlers) ResolveRenameCrossMount(_ *Event, e *RenameEvent) bool {
+	return IsCrossMount(&e.Old, &e.New)
+}
+
+func (fh *testFieldHandlers) ResolveLinkCrossMount(_ *Event, e *LinkEvent) bool {
+	return IsCrossMount(&e.Source, &e.Target)
+}
+
+func (fh *testFieldHandlers) ResolveRenameBasenameChanged(ev *Event, e *RenameEvent) bool {
+	return fh.ResolveFileBasename(ev, &e.Old) != fh.ResolveFileBasename(ev, &e.New)
+}
+
+func (fh *testFieldHandlers) ResolveLinkBasenameChanged(ev *Event, e *LinkEvent) bool {
+	return fh.ResolveFileBasename(ev, &e.Source) != fh.ResolveFileBasename(ev, &e.Target)
+}
+
+func (fh *testFieldHandlers) ResolveUnlinkIsSelfDelete(ev *Event, e *UnlinkEvent) bool {
+	return e.IsSelfDelete(ev.ProcessContext)
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsUIDMismatch(_ *Event, e *Credentials) bool {
+	return e.IsUIDMismatch()
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsGIDMismatch(_ *Event, e *Credentials) bool {
+	return e.IsGIDMismatch()
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsUser(ev *Event, e *Credentials) string {
+	return e.GetUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsGroup(ev *Event, e *Credentials) string {
+	return e.GetGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsEUser(ev *Event, e *Credentials) string {
+	return e.GetEUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsEGroup(ev *Event, e *Credentials) string {
+	return e.GetEGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsFSUser(ev *Event, e *Credentials) string {
+	return e.GetFSUser(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveCredentialsFSGroup(ev *Event, e *Credentials) string {
+	return e.GetFSGroup(fh.fileOwners, ev.ContainerContext.ContainerID)
+}
+
+func (fh *testFieldHandlers) ResolveChownToRoot(_ *Event, e *ChownEvent) bool {
+	return e.IsToRoot()
+}
+
+func (fh *testFieldHandlers) ResolveOpenIsWrite(_ *Event, e *OpenEvent) bool {
+	return e.IsWrite()
+}
+
+func (fh *testFieldHandlers) ResolveOpenIsTruncating(_ *Event, e *OpenEvent) bool {
+	return e.IsTruncating()
+}
+
+func (fh *testFieldHandlers) ResolveProcessCmdLine(ev *Event, process *Process) string {
+	return BuildCmdLine(fh.ResolveProcessArgv0(ev, process), fh.ResolveProcessArgv(ev, process))
+}
+
+func (fh *testFieldHandlers) ResolveFileMountPath(_ *Event, f *FileEvent) string {
+	return f.ResolveMountPath(func(mountID uint32, _ uint32) (string, MountSource, MountOrigin, error) {
+		mountPath, exists := fh.mountPaths[mountID]
+		if !exists {
+			return "", MountSourceUnknown, MountOriginUnknown, fmt.Errorf("mount %d not found", mountID)
+		}
+		return mountPath, MountSourceMountID, MountOriginEvent, nil
+	})
+}
+
+func (fh *testFieldHandlers) ResolveFileFieldsID(_ *Event, f *FileFields) string {
+	return f.GetID()
+}
+
+func (fh *testFieldHandlers) ResolveFileResolvedPath(ev *Event, f *FileEvent) string {
+	return f.GetResolvedPath(fh.ResolveFilePath(ev, f))
+}
+
+func (fh *testFieldHandlers) ResolveFileParentPath(ev *Event, f *FileEvent) string {
+	return ParentPath(fh.ResolveFilePath(ev, f))
+}
+
+func (fh *testFieldHandlers) ResolveFileDepth(ev *Event, f *FileEvent) int {
+	return PathDepth(fh.ResolveFilePath(ev, f))
+}
+
+func (fh *testFieldHandlers) ResolveFileIsCritical(ev *Event, f *FileEvent) bool {
+	return fh.criticalPaths.Contains(fh.ResolveFilePath(ev, f))
+}
+
+func (fh *testFieldHandlers) ResolveFileIsLog(ev *Event, f *FileEvent) bool {
+	return fh.logPaths.Contains(fh.ResolveFilePath(ev, f))
+}
+
+func (fh *testFieldHandlers) ResolveFilePathIsValidUTF8(ev *Event, f *FileEvent) bool {
+	return IsValidPath(fh.ResolveFilePath(ev, f))
+}
+
+func (fh *testFieldHandlers) ResolveFileSanitizedPath(ev *Event, f *FileEvent) string {
+	return SanitizePath(fh.ResolveFilePath(ev, f))
+}
+
+func (fh *testFieldHandlers) ResolveXAttrAffectsIntegrity(_ *Event, e *SetXAttrEvent) bool {
+	return IsIntegrityXAttr(e.Namespace, e.Name)
+}
+
+func (fh *testFieldHandlers) ResolveXAttrIsSecurityLabel(_ *Event, e *SetXAttrEvent) bool {
+	return IsSecurityLabelXAttr(e.Namespace, e.Name, fh.securityLabelXAttrs)
+}
+
+func TestProcessCommTruncated(t *testing.T) {
+	tests := []struct {
+		name      string
+		comm      string
+		truncated bool
+	}{
+		{
+			name: "short",
+			comm: "bash",
+		},
+		{
+			name: "one below the limit",
+			comm: "kube-controlle",
+		},
+		{
+			name:      "max length",
+			comm:      "kube-controller-manager"[:MaxCommLength],
+			truncated: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			process := Process{Comm: test.comm}
+			if process.IsCommTruncated() != test.truncated {
+				t.Errorf("expected comm `%s` truncated to be %v", test.comm, test.truncated)
+			}
+
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = &ProcessContext{
+				Process: process,
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: process},
+				},
+			}
+			event.Exec.Process = &event.ProcessContext.Process
+
+			ctx := eval.NewContext(event)
+			for _, field := range []string{"process.comm_truncated", "exec.comm_truncated"} {
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if truncated := evaluator.Eval(ctx).(bool); truncated != test.truncated {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.truncated, truncated)
+				}
+			}
+
+			evaluator, err := (&Model{}).GetEvaluator("process.ancestors.comm_truncated", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if values := evaluator.Eval(ctx).([]bool); len(values) != 1 || values[0] != test.truncated {
+				t.Errorf("expected `process.ancestors.comm_truncated` to be [%v], got %v", test.truncated, values)
+			}
+		})
+	}
+}
+
+func TestProcessCommMatchesBinary(t *testing.T) {
+	tests := []struct {
+		name     string
+		comm     string
+		basename string
+		expected bool
+	}{
+		{
+			name:     "matching",
+			comm:     "bash",
+			basename: "bash",
+			expected: true,
+		},
+		{
+			name:     "mismatch",
+			comm:     "kworker/0:1",
+			basename: "xmrig",
+			expected: false,
+		},
+		{
+			name:     "truncated matching",
+			comm:     "kube-controller-manager"[:MaxCommLength],
+			basename: "kube-controller-manager",
+			expected: true,
+		},
+		{
+			name:     "prefix not truncated",
+			comm:     "kube",
+			basename: "kube-controller-manager",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = &ProcessContext{
+				Process: Process{
+					Comm: test.comm,
+					FileEvent: FileEvent{
+						BasenameStr: test.basename,
+					},
+				},
+			}
+			event.Exec.Process = &event.ProcessContext.Process
+
+			for _, field := range []eval.Field{"exec.comm_matches_binary", "process.comm_matches_binary"} {
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				if value := evaluator.Eval(eval.NewContext(event)).(bool); value != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessArgsHasShellMetachars(t *testing.T) {
+	defaultMetachars := []string{"`", "$(", ";", "|"}
+
+	tests := []struct {
+		name      string
+		metachars []string
+		argv      []string
+		entry     *ArgsEntry
+		expected  bool
+	}{
+		{
+			name:      "command-substitution",
+			metachars: defaultMetachars,
+			argv:      []string{"-c", "echo $(whoami)"},
+			expected:  true,
+		},
+		{
+			name:      "benign",
+			metachars: defaultMetachars,
+			argv:      []string{"-la", "/tmp", "--color=auto"},
+			expected:  false,
+		},
+		{
+			name:      "args-entry",
+			metachars: defaultMetachars,
+			entry:     &ArgsEntry{Values: []string{"curl", "http://example.com/;id"}},
+			expected:  true,
+		},
+		{
+			name:      "args-entry-argv0",
+			metachars: defaultMetachars,
+			entry:     &ArgsEntry{Values: []string{"a|b", "-v"}},
+			expected:  false,
+		},
+		{
+			name:      "custom-metachars",
+			metachars: []string{">"},
+			argv:      []string{"-c", "id > /tmp/out"},
+			expected:  true,
+		},
+		{
+			name:      "custom-metachars-only",
+			metachars: []string{">"},
+			argv:      []string{"-c", "echo $(whoami)"},
+			expected:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{shellMetachars: NewShellMetacharsMatcher(test.metachars)})
+			event.Exec.Process = &Process{Argv: test.argv, ArgsEntry: test.entry}
+			event.ProcessContext = &ProcessContext{Process: *event.Exec.Process}
+
+			for _, field := range []eval.Field{"exec.args.has_shell_metachars", "process.args.has_shell_metachars"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %v for %v, got %v", field, test.expected, test.argv, value)
+				}
+			}
+		})
+	}
+
+	// the arguments are scanned once per entry
+	entry := &ArgsEntry{Values: []string{"sh", "-c", "`id`"}}
+	process := &Process{ArgsEntry: entry}
+	if !process.HasShellMetacharsArgs(nil, NewShellMetacharsMatcher(defaultMetachars)) {
+		t.Fatal("expected the backtick to be found")
+	}
+	entry.Values = []string{"sh", "-c", "id"}
+	if !process.HasShellMetacharsArgs(nil, NewShellMetacharsMatcher(defaultMetachars)) {
+		t.Error("expected the first scan of the entry to be reused")
+	}
+
+	// the documented example
+	rule, err := eval.NewRule("id", `exec.args.has_shell_metachars && exec.file.name == "curl"`, ast.NewParsingContext(false), &eval.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rule.GenEvaluator(&Model{}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestProcessEnvsHasSecretLike(t *testing.T) {
+	defaultPatterns := []string{"*_TOKEN", "*_SECRET", "*PASSWORD*"}
+
+	tests := []struct {
+		name     string
+		patterns []string
+		envs     []string
+		entry    *EnvsEntry
+		expected bool
+	}{
+		{
+			name:     "token",
+			patterns: defaultPatterns,
+			envs:     []string{"PATH", "API_TOKEN", "HOME"},
+			expected: true,
+		},
+		{
+			name:     "lowercase-password",
+			patterns: defaultPatterns,
+			envs:     []string{"db_password"},
+			expected: true,
+		},
+		{
+			name:     "plain",
+			patterns: defaultPatterns,
+			envs:     []string{"PATH", "HOME", "TOKEN_FILE_PATH", "LD_PRELOAD=/usr/lib/libfoo.so"},
+			expected: false,
+		},
+		{
+			name:     "env-entry",
+			patterns: defaultPatterns,
+			entry:    &EnvsEntry{Values: []string{"PATH=/usr/bin", "GITHUB_TOKEN=ghp_xxx"}},
+			expected: true,
+		},
+		{
+			name:     "custom-pattern",
+			patterns: append([]string{"VAULT_*"}, defaultPatterns...),
+			envs:     []string{"PATH", "VAULT_ADDR"},
+			expected: true,
+		},
+		{
+			name:     "custom-pattern-only",
+			patterns: []string{"VAULT_*"},
+			envs:     []string{"PATH", "API_TOKEN"},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			matcher, err := NewSecretLikeEnvMatcher(test.patterns)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			event := newTestEvent(ExecEventType, &testFieldHandlers{secretLikeEnvs: matcher})
+			event.Exec.Process = &Process{Envs: test.envs, EnvsEntry: test.entry}
+
+			value, err := event.GetFieldValue("exec.envs.has_secret_like")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != test.expected {
+				t.Errorf("expected `exec.envs.has_secret_like` to be %v for %v, got %v", test.expected, test.envs, value)
+			}
+		})
+	}
+}
+
+func TestProcessArgsEnvsDistinctCount(t *testing.T) {
+	tests := []struct {
+		name         string
+		argv         []string
+		envs         []string
+		argsEntry    *ArgsEntry
+		envsEntry    *EnvsEntry
+		expectedArgs int
+		expectedEnvs int
+	}{
+		{
+			name:         "duplicates",
+			argv:         []string{"-v", "-v", "-v", "/tmp"},
+			envs:         []string{"PATH", "LD_PRELOAD", "LD_PRELOAD=/tmp/libfoo.so", "HOME"},
+			expectedArgs: 2,
+			expectedEnvs: 3,
+		},
+		{
+			name:         "unique",
+			argv:         []string{"-la", "/tmp", "--color=auto"},
+			envs:         []string{"PATH", "HOME"},
+			expectedArgs: 3,
+			expectedEnvs: 2,
+		},
+		{
+			name: "empty",
+		},
+		{
+			name:         "entries",
+			argsEntry:    &ArgsEntry{Values: []string{"curl", "-H", "x: y", "-H", "x: y"}},
+			envsEntry:    &EnvsEntry{Values: []string{"PATH=/usr/bin", "PATH=/tmp", "HOME=/root"}},
+			expectedArgs: 2,
+			expectedEnvs: 2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{Argv: test.argv, Envs: test.envs, ArgsEntry: test.argsEntry, EnvsEntry: test.envsEntry}
+			event.ProcessContext = &ProcessContext{Process: *event.Exec.Process}
+
+			expected := map[eval.Field]int{
+				"exec.args.distinct_count":    test.expectedArgs,
+				"process.args.distinct_count": test.expectedArgs,
+				"exec.envs.distinct_count":    test.expectedEnvs,
+				"process.envs.distinct_count": test.expectedEnvs,
+			}
+			for field, count := range expected {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != count {
+					t.Errorf("expected `%s` to be %d, got %v", field, count, value)
+				}
+			}
+		})
+	}
+
+	// the values are deduplicated once per entry
+	entry := &ArgsEntry{Values: []string{"sh", "-c", "-c"}}
+	process := &Process{ArgsEntry: entry}
+	if count := process.GetDistinctArgsCount(nil); count != 1 {
+		t.Fatalf("expected 1 distinct argument, got %d", count)
+	}
+	entry.Values = []string{"sh", "-c", "id"}
+	if count := process.GetDistinctArgsCount(nil); count != 1 {
+		t.Errorf("expected the first count of the entry to be reused, got %d", count)
+	}
+}
+
+func TestLinkType(t *testing.T) {
+	tests := []struct {
+		name       string
+		isSymbolic bool
+		targetPath string
+		linkType   string
+	}{
+		{
+			name:     "hardlink",
+			linkType: LinkTypeHard,
+		},
+		{
+			name:       "symlink",
+			isSymbolic: true,
+			targetPath: "../lib/libc.so.6",
+			linkType:   LinkTypeSymbolic,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileLinkEventType, &testFieldHandlers{})
+			event.Link.IsSymbolic = test.isSymbolic
+			event.Link.TargetPath = test.targetPath
+
+			ctx := eval.NewContext(event)
+
+			evaluator, err := (&Model{}).GetEvaluator("link.type", "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if linkType := evaluator.Eval(ctx).(string); linkType != test.linkType {
+				t.Errorf("expected link type `%s`, got `%s`", test.linkType, linkType)
+			}
+
+			value, err := event.GetFieldValue("link.target_path")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value.(string) != test.targetPath {
+				t.Errorf("expected target path `%s`, got `%v`", test.targetPath, value)
+			}
+		})
+	}
+}
+
+func TestXAttrAffectsIntegrity(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		xattr     string
+		expected  bool
+	}{
+		{
+			name:      "ima",
+			namespace: "security",
+			xattr:     "security.ima",
+			expected:  true,
+		},
+		{
+			name:      "evm",
+			namespace: "security",
+			xattr:     "security.evm",
+			expected:  true,
+		},
+		{
+			name:      "selinux",
+			namespace: "security",
+			xattr:     "security.selinux",
+		},
+		{
+			name:      "user comment",
+			namespace: "user",
+			xattr:     "user.comment",
+		},
+		{
+			name:      "user ima",
+			namespace: "user",
+			xattr:     "user.ima",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, eventType := range []EventType{FileSetXAttrEventType, FileRemoveXAttrEventType} {
+				event := newTestEvent(eventType, &testFieldHandlers{})
+				event.SetXAttr.Namespace, event.SetXAttr.Name = test.namespace, test.xattr
+				event.RemoveXAttr.Namespace, event.RemoveXAttr.Name = test.namespace, test.xattr
+
+				field := eventType.String() + ".file.destination.affects_integrity"
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestXAttrIsSecurityLabel(t *testing.T) {
+	defaultLabels := []string{"selinux", "apparmor"}
+
+	tests := []struct {
+		name      string
+		namespace string
+		xattr     string
+		labels    []string
+		expected  bool
+	}{
+		{
+			name:      "selinux",
+			namespace: "security",
+			xattr:     "security.selinux",
+			labels:    defaultLabels,
+			expected:  true,
+		},
+		{
+			name:      "user namespace",
+			namespace: "user",
+			xattr:     "user.foo",
+			labels:    defaultLabels,
+		},
+		{
+			name:      "capability not configured",
+			namespace: "security",
+			xattr:     "security.capability",
+			labels:    defaultLabels,
+		},
+		{
+			name:      "capability configured",
+			namespace: "security",
+			xattr:     "security.capability",
+			labels:    append(defaultLabels, "capability"),
+			expected:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, eventType := range []EventType{FileSetXAttrEventType, FileRemoveXAttrEventType} {
+				event := newTestEvent(eventType, &testFieldHandlers{securityLabelXAttrs: test.labels})
+				event.SetXAttr.Namespace, event.SetXAttr.Name = test.namespace, test.xattr
+				event.RemoveXAttr.Namespace, event.RemoveXAttr.Name = test.namespace, test.xattr
+
+				field := eventType.String() + ".file.destination.is_security_label"
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value := evaluator.Eval(eval.NewContext(event)).(bool); value != test.expected {
+					t.Errorf("expected `%s` to be %v for `%s`, got %v", field, test.expected, test.xattr, value)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessHasAncestors(t *testing.T) {
+	// the ancestor of the looping entry is itself, walking the chain would never end
+	looping := &ProcessCacheEntry{}
+	looping.Ancestor = looping
+
+	tests := []struct {
+		name     string
+		ancestor *ProcessCacheEntry
+		expected bool
+	}{
+		{
+			name:     "orphan",
+			expected: false,
+		},
+		{
+			name: "with-ancestors",
+			ancestor: &ProcessCacheEntry{
+				ProcessContext: ProcessContext{
+					Ancestor: &ProcessCacheEntry{},
+				},
+			},
+			expected: true,
+		},
+		{
+			name:     "chain-not-walked",
+			ancestor: looping,
+			expected: true,
+		},
+	}
+
+	evaluator, err := (&Model{}).GetEvaluator("process.has_ancestors", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = &ProcessContext{
+				Ancestor: test.ancestor,
+			}
+
+			ctx := eval.NewContext(event)
+			if value := evaluator.Eval(ctx).(bool); value != test.expected {
+				t.Errorf("expected `process.has_ancestors` to be %v, got %v", test.expected, value)
+			}
+			if len(ctx.RegisterCache) != 0 {
+				t.Errorf("expected the ancestors not to be materialized, got %v", ctx.RegisterCache)
+			}
+		})
+	}
+}
+
+func TestProcessFileEventsCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int
+		expected bool
+	}{
+		{
+			name:     "zero",
+			count:    0,
+			expected: false,
+		},
+		{
+			name:     "below-threshold",
+			count:    1000,
+			expected: false,
+		},
+		{
+			name:     "above-threshold",
+			count:    1001,
+			expected: true,
+		},
+	}
+
+	rule, err := eval.NewRule("id", `open.file.path != "" && process.file_events_count > 1000`, ast.NewParsingContext(false), &eval.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rule.GenEvaluator(&Model{}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileOpenEventType, &testFieldHandlers{})
+			event.Open.File.PathnameStr = "/tmp/secret.txt"
+			event.ProcessContext = &ProcessContext{
+				FileEventsCount: test.count,
+			}
+
+			if value := rule.Eval(eval.NewContext(event)); value != test.expected {
+				t.Errorf("expected `process.file_events_count > 1000` to be %v with a count of %d, got %v", test.expected, test.count, value)
+			}
+		})
+	}
+}
+
+func TestProcessMetadataChangeCount(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int
+		expr     string
+		expected bool
+	}{
+		{
+			name:     "zero",
+			expr:     `process.metadata_change_count > 500`,
+			expected: false,
+		},
+		{
+			name:     "above-threshold",
+			count:    501,
+			expr:     `process.metadata_change_count > 500`,
+			expected: true,
+		},
+		{
+			name:     "at-threshold",
+			count:    500,
+			expr:     `process.metadata_change_count >= 500`,
+			expected: true,
+		},
+		{
+			name:     "below-threshold",
+			count:    10,
+			expr:     `process.metadata_change_count >= 500`,
+			expected: false,
+		},
+		{
+			name:     "lesser-than",
+			count:    10,
+			expr:     `process.metadata_change_count < 500`,
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := eval.NewRule("id", `chmod.file.path != "" && `+test.expr, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+
+			event := newTestEvent(FileChmodEventType, &testFieldHandlers{})
+			event.Chmod.File.PathnameStr = "/etc/shadow"
+			event.ProcessContext = &ProcessContext{
+				MetadataChangeCount: test.count,
+			}
+
+			if value := rule.Eval(eval.NewContext(event)); value != test.expected {
+				t.Errorf("expected `%s` to be %v with a count of %d, got %v", test.expr, test.expected, test.count, value)
+			}
+		})
+	}
+}
+
+func TestProcessNamespaceTransition(t *testing.T) {
+	newEntry := func(pid uint32, mntNS uint64) *ProcessCacheEntry {
+		return &ProcessCacheEntry{
+			ProcessContext: ProcessContext{
+				Process: Process{
+					PIDContext: PIDContext{Pid: pid, NSID: 4026531836, MntNS: mntNS, NetNS: 4026531840},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		parentMntNS uint64
+		childMntNS  uint64
+		expected    bool
+	}{
+		{
+			name:        "same-namespace",
+			parentMntNS: 4026531841,
+			childMntNS:  4026531841,
+			expected:    false,
+		},
+		{
+			name:        "unshared-namespace",
+			parentMntNS: 4026531841,
+			childMntNS:  4026532294,
+			expected:    true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			parent := newEntry(42, test.parentMntNS)
+			child := newEntry(4242, test.childMntNS)
+			child.SetAncestor(parent)
+
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = &child.ProcessContext
+			event.Exec.Process = &child.Process
+
+			for field, expected := range map[string]interface{}{
+				"process.mnt_ns":        int(test.childMntNS),
+				"process.parent.mnt_ns": int(test.parentMntNS),
+				"process.pid_ns":        4026531836,
+				"process.net_ns":        4026531840,
+			} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, expected, value)
+				}
+			}
+
+			for expr, expected := range map[string]bool{
+				`exec.mnt_ns != process.parent.mnt_ns`:                     test.expected,
+				`process.ancestors.mnt_ns != process.mnt_ns`:               test.expected,
+				`exec.pid_ns == process.parent.pid_ns`:                     true,
+				`exec.net_ns == process.parent.net_ns && exec.net_ns != 0`: true,
+			} {
+				rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := rule.GenEvaluator(&Model{}); err != nil {
+					t.Fatal(err)
+				}
+				if result := rule.Eval(eval.NewContext(event)); result != expected {
+					t.Errorf("expected `%s` to be %v, got %v", expr, expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessAncestorsLoop(t *testing.T) {
+	// two entries listing each other as ancestor
+	first, second := &ProcessCacheEntry{}, &ProcessCacheEntry{}
+	first.Ancestor, second.Ancestor = second, first
+
+	event := newTestEvent(ExecEventType, &testFieldHandlers{})
+	event.ProcessContext = &ProcessContext{
+		Ancestor: first,
+	}
+	ctx := eval.NewContext(event)
+
+	GetAndResetAncestorsLoopCount()
+
+	var (
+		it    ProcessAncestorsIterator
+		count int
+	)
+	for pce := it.Front(ctx); pce != nil; pce = it.Next() {
+		count++
+	}
+	if count != maxAncestorsDepth {
+		t.Errorf("expected the iteration to stop after %d ancestors, got %d", maxAncestorsDepth, count)
+	}
+
+	if size := it.Len(ctx); size != maxAncestorsDepth {
+		t.Errorf("expected the length to be capped to %d, got %d", maxAncestorsDepth, size)
+	}
+
+	if pce := it.At(ctx, "test", maxAncestorsDepth); pce != nil {
+		t.Errorf("expected no ancestor past the maximum depth, got %v", pce)
+	}
+
+	if loops := GetAndResetAncestorsLoopCount(); loops != 2 {
+		t.Errorf("expected 2 loops to be reported, got %d", loops)
+	}
+
+	rule, err := eval.NewRule("id", `process.ancestors.file.name == "unknown"`, ast.NewParsingContext(false), &eval.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rule.GenEvaluator(&Model{}); err != nil {
+		t.Fatal(err)
+	}
+	if rule.Eval(ctx) {
+		t.Error("expected the rule not to match")
+	}
+
+	if loops := GetAndResetAncestorsLoopCount(); loops == 0 {
+		t.Error("expected the rule evaluation to report a loop")
+	}
+}
+
+func TestProcessAncestorsIndex(t *testing.T) {
+	event := newTestEvent(ExecEventType, &testFieldHandlers{})
+	event.ProcessContext = &ProcessContext{
+		Process: Process{Comm: "ls"},
+		Ancestor: &ProcessCacheEntry{
+			ProcessContext: ProcessContext{
+				Process: Process{
+					Comm:       "bash",
+					PIDContext: PIDContext{Pid: 2},
+					FileEvent:  FileEvent{PathnameStr: "/usr/bin/bash"},
+				},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{
+						Process: Process{
+							Comm:       "sshd",
+							PIDContext: PIDContext{Pid: 1},
+							FileEvent:  FileEvent{PathnameStr: "/usr/sbin/sshd"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{expr: `process.ancestors[0].comm == "bash"`, expected: true},
+		{expr: `process.ancestors[0].comm == "sshd"`, expected: false},
+		{expr: `process.ancestors[1].comm == "sshd"`, expected: true},
+		{expr: `process.ancestors[1].comm == "bash"`, expected: false},
+		{expr: `process.ancestors[1].pid == 1`, expected: true},
+		{expr: `process.ancestors[2].comm == "sshd"`, expected: false},
+		{expr: `process.ancestors[2].comm == ""`, expected: false},
+		{expr: `process.ancestors[0].comm == "bash" && process.ancestors[1].comm == "sshd"`, expected: true},
+		{expr: `process.ancestors.comm == "bash" && process.ancestors[1].comm == "bash"`, expected: false},
+		{expr: `process.ancestors[0].file.path == "/usr/sbin/sshd"`, expected: false},
+		{expr: `process.ancestors[1].file.path == "/usr/sbin/sshd"`, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			rule, err := eval.NewRule("id", test.expr, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCapsetBitmaskOperators(t *testing.T) {
+	event := newTestEvent(CapsetEventType, &testFieldHandlers{})
+	event.ProcessContext = &ProcessContext{
+		Process: Process{
+			Credentials: Credentials{
+				CapPermitted: 1<<unix.CAP_SYS_ADMIN | 1<<unix.CAP_NET_RAW | 1<<unix.CAP_CHOWN,
+			},
+		},
+	}
+	event.Capset.CapEffective = 1<<unix.CAP_SYS_ADMIN | 1<<unix.CAP_NET_RAW
+	event.Capset.CapPermitted = event.ProcessContext.Process.CapPermitted
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{expr: `capset.cap_effective subset process.cap_permitted`, expected: true},
+		{expr: `capset.cap_effective subset [ CAP_SYS_ADMIN, CAP_NET_RAW, CAP_SETUID ]`, expected: true},
+		{expr: `capset.cap_effective subset [ CAP_NET_RAW, CAP_SETUID ]`, expected: false},
+		{expr: `process.cap_permitted subset capset.cap_effective`, expected: false},
+		{expr: `capset.cap_effective superset CAP_SYS_ADMIN`, expected: true},
+		{expr: `capset.cap_effective superset [ CAP_SYS_ADMIN, CAP_NET_RAW ]`, expected: true},
+		{expr: `capset.cap_effective superset [ CAP_SYS_ADMIN, CAP_CHOWN ]`, expected: false},
+		{expr: `capset.cap_effective intersects [ CAP_SYS_ADMIN, CAP_SYS_PTRACE ]`, expected: true},
+		{expr: `capset.cap_effective intersects [ CAP_CHOWN, CAP_SYS_PTRACE ]`, expected: false},
+		{expr: `process.cap_permitted intersects CAP_CHOWN`, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			rule, err := eval.NewRule("id", test.expr, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestCapAmbient(t *testing.T) {
+	newEvent := func(capAmbient uint64) *Event {
+		event := newTestEvent(ExecEventType, &testFieldHandlers{})
+		event.ProcessContext = &ProcessContext{
+			Process: Process{
+				Credentials: Credentials{
+					CapPermitted: 1<<unix.CAP_NET_BIND_SERVICE | 1<<unix.CAP_NET_RAW,
+					CapAmbient:   capAmbient,
+				},
+			},
+			Ancestor: &ProcessCacheEntry{
+				ProcessContext: ProcessContext{
+					Process: Process{
+						Credentials: Credentials{CapAmbient: capAmbient},
+					},
+				},
+			},
+		}
+		event.Exec.Process = &event.ProcessContext.Process
+		return event
+	}
+
+	tests := []struct {
+		name       string
+		capAmbient uint64
+		expr       string
+		expected   bool
+	}{
+		{name: "set", capAmbient: 1 << unix.CAP_NET_BIND_SERVICE, expr: `exec.cap_ambient & CAP_NET_BIND_SERVICE > 0`, expected: true},
+		{name: "set", capAmbient: 1 << unix.CAP_NET_BIND_SERVICE, expr: `process.cap_ambient intersects [ CAP_NET_RAW, CAP_NET_BIND_SERVICE ]`, expected: true},
+		{name: "set", capAmbient: 1 << unix.CAP_NET_BIND_SERVICE, expr: `process.cap_ambient subset process.cap_permitted`, expected: true},
+		{name: "set", capAmbient: 1 << unix.CAP_NET_BIND_SERVICE, expr: `process.ancestors.cap_ambient == CAP_NET_BIND_SERVICE`, expected: true},
+		{name: "set", capAmbient: 1 << unix.CAP_NET_BIND_SERVICE, expr: `exec.cap_ambient & CAP_NET_RAW > 0`, expected: false},
+		{name: "none", capAmbient: 0, expr: `exec.cap_ambient & CAP_NET_BIND_SERVICE > 0`, expected: false},
+		{name: "none", capAmbient: 0, expr: `process.ancestors.cap_ambient != 0`, expected: false},
+		{name: "none", capAmbient: 0, expr: `process.cap_ambient == 0`, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+"/"+test.expr, func(t *testing.T) {
+			rule, err := eval.NewRule("id", test.expr, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if result := rule.Eval(eval.NewContext(newEvent(test.capAmbient))); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestSymbolicFileMode(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     uint32
+		expr     string
+		expected bool
+	}{
+		{name: "rwxr-xr-x", mode: 0o755, expr: `chmod.file.destination.mode == "rwxr-xr-x"`, expected: true},
+		{name: "rwxr-xr-x", mode: 0o644, expr: `chmod.file.destination.mode == "rwxr-xr-x"`, expected: false},
+		{name: "rw-r--r--", mode: 0o644, expr: `chmod.file.destination.mode == "rw-r--r--"`, expected: true},
+		{name: "rw-r--r--", mode: 0o644, expr: `"rw-r--r--" != chmod.file.destination.mode`, expected: false},
+		{name: "setuid", mode: 0o4755, expr: `chmod.file.destination.mode == "rwsr-xr-x"`, expected: true},
+		{name: "sticky", mode: 0o1777, expr: `chmod.file.destination.mode == "rwxrwxrwt"`, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name+"/"+test.expr, func(t *testing.T) {
+			rule, err := eval.NewRule("id", test.expr, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+
+			event := NewFakeEvent()
+			event.Type = uint32(FileChmodEventType)
+			event.Chmod.Mode = test.mode
+
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, expr := range []string{
+			`chmod.file.destination.mode == "rwxr-xr-z"`,
+			`chmod.file.destination.mode == "rwx"`,
+		} {
+			rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err == nil {
+				t.Errorf("expected `%s` to fail to compile", expr)
+			}
+		}
+	})
+}
+
+func TestFileID(t *testing.T) {
+	newFile := func(mountID uint32, inode uint64) FileEvent {
+		return FileEvent{FileFields: FileFields{PathKey: PathKey{MountID: mountID, Inode: inode}}}
+	}
+
+	event := newTestEvent(FileOpenEventType, &testFieldHandlers{})
+	event.Open.File = newFile(27, 1234)
+	event.ProcessContext = &ProcessContext{
+		Process: Process{FileEvent: newFile(27, 5678)},
+		Ancestor: &ProcessCacheEntry{
+			ProcessContext: ProcessContext{
+				Process: Process{FileEvent: newFile(31, 1234)},
+			},
+		},
+	}
+
+	openID, err := event.GetFieldValue("open.file.id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ancestorID, err := event.GetFieldValue("process.ancestors.file.id")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if openID != "1b/4d2" {
+		t.Errorf("unexpected `open.file.id`: %v", openID)
+	}
+	if ids, ok := ancestorID.([]string); !ok || !slices.Equal(ids, []string{"1f/4d2"}) {
+		t.Errorf("unexpected `process.ancestors.file.id`: %v", ancestorID)
+	}
+
+	// same inode, different mount
+	if event.Open.File.Inode != event.ProcessContext.Ancestor.FileEvent.Inode || openID == ancestorID.([]string)[0] {
+		t.Errorf("expected different ids for the same inode on different mounts")
+	}
+
+	tests := []struct {
+		expr     string
+		expected bool
+	}{
+		{expr: `open.file.id == "1b/4d2"`, expected: true},
+		{expr: `open.file.id in process.ancestors.file.id`, expected: false},
+		{expr: `open.file.inode in process.ancestors.file.inode`, expected: true},
+		{expr: `process.ancestors.file.id == "1f/4d2"`, expected: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.expr, func(t *testing.T) {
+			rule, err := eval.NewRule("id", test.expr, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+		})
+	}
+
+	if id := (&FileFields{}).GetID(); id != "" {
+		t.Errorf("expected an empty id without inode, got `%s`", id)
+	}
+}
+
+func TestFileResolvedPath(t *testing.T) {
+	tests := []struct {
+		name         string
+		path         string
+		resolvedPath string
+		expected     string
+	}{
+		{
+			name:         "symlink",
+			path:         "/tmp/passwd-link",
+			resolvedPath: "/etc/passwd",
+			expected:     "/etc/passwd",
+		},
+		{
+			name:         "symlinked-directory",
+			path:         "/var/run/agent.sock",
+			resolvedPath: "/run/agent.sock",
+			expected:     "/run/agent.sock",
+		},
+		{
+			name:     "direct",
+			path:     "/etc/passwd",
+			expected: "/etc/passwd",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileOpenEventType, &testFieldHandlers{})
+			event.Open.File.PathnameStr = test.path
+			event.Open.File.ResolvedPathnameStr = test.resolvedPath
+			event.Chmod.File.PathnameStr = test.path
+			event.Chmod.File.ResolvedPathnameStr = test.resolvedPath
+
+			for _, field := range []string{"open.file.resolved_path", "chmod.file.resolved_path"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be `%s`, got `%v`", field, test.expected, value)
+				}
+			}
+
+			value, err := event.GetFieldValue("open.file.path")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != test.path {
+				t.Errorf("expected `open.file.path` to be the requested path `%s`, got `%v`", test.path, value)
+			}
+		})
+	}
+}
+
+func TestFileDepth(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected int
+	}{
+		{path: "/a/b/c", expected: 3},
+		{path: "/", expected: 0},
+		{path: "", expected: 0},
+		{path: "a/b", expected: 2},
+		{path: "./a/b/", expected: 3},
+		{path: "/tmp//x/", expected: 2},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			event := newTestEvent(UnknownEventType, &testFieldHandlers{})
+			event.Mkdir.File.PathnameStr = test.path
+			event.Rmdir.File.PathnameStr = test.path
+			event.Open.File.PathnameStr = test.path
+
+			for _, field := range []string{"mkdir.file.depth", "rmdir.file.depth", "open.file.depth"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %d for `%s`, got %v", field, test.expected, test.path, value)
+				}
+			}
+		})
+	}
+}
+
+func TestFileParentPath(t *testing.T) {
+	tests := []struct {
+		path     string
+		expected string
+	}{
+		{path: "/a/b/c", expected: "/a/b"},
+		{path: "/passwd", expected: "/"},
+		{path: "/", expected: "/"},
+		{path: "a/b", expected: "a"},
+		{path: "b", expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.path, func(t *testing.T) {
+			event := newTestEvent(UnknownEventType, &testFieldHandlers{})
+			event.Link.Source.PathnameStr = test.path
+			event.Link.Target.PathnameStr = test.path
+			event.Rename.New.PathnameStr = test.path
+			event.Mkdir.File.PathnameStr = test.path
+
+			for _, field := range []string{"link.file.parent_path", "link.file.destination.parent_path", "rename.file.destination.parent_path", "mkdir.file.parent_path"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be `%s` for `%s`, got `%v`", field, test.expected, test.path, value)
+				}
+			}
+		})
+	}
+}
+
+func TestFilePathIsValidUTF8(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		valid     bool
+		sanitized string
+	}{
+		{name: "valid", path: "/etc/passwd", valid: true, sanitized: "/etc/passwd"},
+		{name: "valid-non-ascii", path: "/home/user/café", valid: true, sanitized: "/home/user/café"},
+		{name: "invalid-utf8", path: "/tmp/a\xff\xfeb", valid: false, sanitized: `/tmp/a\xff\xfeb`},
+		{name: "control-characters", path: "/tmp/a\nb\x1b[31m", valid: false, sanitized: `/tmp/a\x0ab\x1b[31m`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(UnknownEventType, &testFieldHandlers{})
+			event.Open.File.PathnameStr = test.path
+			event.Exec.Process = &Process{FileEvent: FileEvent{PathnameStr: test.path}}
+
+			for _, prefix := range []string{"open.file", "exec.file"} {
+				value, err := event.GetFieldValue(prefix + ".path_is_valid_utf8")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.valid {
+					t.Errorf("expected `%s.path_is_valid_utf8` to be %v for %q, got %v", prefix, test.valid, test.path, value)
+				}
+
+				value, err = event.GetFieldValue(prefix + ".sanitized_path")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.sanitized {
+					t.Errorf("expected `%s.sanitized_path` to be %q, got %q", prefix, test.sanitized, value)
+				}
+			}
+		})
+	}
+}
+
+func TestEventTypeConstants(t *testing.T) {
+	constants := []eval.EventType{
+		EventBind, EventBpf, EventCapset, EventChdir, EventChmod, EventChown, EventConnect, EventDns, EventExec,
+		EventExit, EventImds, EventLink, EventLoadModule, EventMkdir, EventMmap, EventMount, EventMprotect,
+		EventOndemand, EventOpen, EventPacket, EventPtrace, EventRemovexattr, EventRename, EventRmdir,
+		EventSelinux, EventSetgid, EventSetuid, EventSetxattr, EventSignal, EventSplice, EventUnlink,
+		EventUnloadModule, EventUtimes,
+	}
+
+	m := &Model{}
+	eventTypes := slices.Clone(m.GetEventTypes())
+	slices.Sort(eventTypes)
+	if !slices.Equal(eventTypes, constants) {
+		t.Errorf("expected the event types %v to be the constants %v", eventTypes, constants)
+	}
+
+	// the constants have to be the names of the event types of the probe
+	var names []eval.EventType
+	for eventType := FirstEventType; eventType < MaxAllEventType; eventType++ {
+		names = append(names, eval.EventType(eventType.String()))
+	}
+	for _, constant := range constants {
+		if !slices.Contains(names, constant) {
+			t.Errorf("`%s` isn't the name of an event type", constant)
+		}
+	}
+}
+
+func TestModelIteratorFields(t *testing.T) {
+	m := &Model{}
+	fields := m.IteratorFields()
+
+	for _, field := range []eval.Field{
+		"process.ancestors.args",
+		"process.ancestors.envs",
+		"process.ancestors.file.path",
+		"process.ancestors.pid",
+		"ptrace.tracee.ancestors.comm",
+		"signal.target.ancestors.uid",
+		"process.ancestors.ancestors.exec_count",
+	} {
+		if !slices.Contains(fields, field) {
+			t.Errorf("expected `%s` to be an iterator field", field)
+		}
+	}
+
+	// the scalar fields, the non iterated arrays and the lengths of the iterators are excluded
+	for _, field := range []eval.Field{
+		"process.pid",
+		"process.args",
+		"process.argv",
+		"process.envs",
+		"exec.file.path",
+		"process.ancestors.length",
+		"process.ancestors.exec_count",
+		"process.parent.pid",
+	} {
+		if slices.Contains(fields, field) {
+			t.Errorf("expected `%s` not to be an iterator field", field)
+		}
+	}
+
+	// every field of the ancestors is iterated, apart from their count and the scalar exec count of the process, its
+	// iterated counterpart being `*.ancestors.ancestors.exec_count`
+	var expected []eval.Field
+	for _, field := range NewFakeEvent().GetFields() {
+		_, name, found := strings.Cut(field, ".ancestors.")
+		if found && name != "length" && name != "exec_count" {
+			expected = append(expected, field)
+		}
+	}
+	slices.Sort(expected)
+	slices.Sort(fields)
+	if !slices.Equal(fields, expected) {
+		t.Errorf("expected the iterator fields to be the ancestors fields, got %d fields instead of %d", len(fields), len(expected))
+	}
+
+	t.Run("disabled-event-types", func(t *testing.T) {
+		m := &Model{}
+		m.DisableEventTypes("ptrace")
+
+		for _, field := range m.IteratorFields() {
+			if strings.HasPrefix(field, "ptrace.") {
+				t.Errorf("expected `%s` of a disabled event type to be skipped", field)
+			}
+		}
+	})
+}
+
+func TestDisableEventTypes(t *testing.T) {
+	m := &Model{}
+	m.DisableEventTypes("utimes")
+
+	if m.IsEventTypeEnabled("utimes") || !m.IsEventTypeEnabled("open") {
+		t.Error("expected only `utimes` to be disabled")
+	}
+
+	if slices.Contains(m.GetEventTypes(), "utimes") {
+		t.Error("expected `utimes` to be removed from the event types")
+	}
+	if !slices.Contains(m.GetEventTypes(), "open") {
+		t.Error("expected `open` to be part of the event types")
+	}
+
+	var notFound *eval.ErrFieldNotFound
+	if _, err := m.GetEvaluator("utimes.file.path", ""); !errors.As(err, &notFound) {
+		t.Errorf("expected a field not found error for `utimes.file.path`, got %v", err)
+	}
+	if _, err := m.GetEvaluator("open.file.path", ""); err != nil {
+		t.Errorf("expected `open.file.path` to be available: %v", err)
+	}
+	if _, err := m.GetEvaluator("process.file.path", ""); err != nil {
+		t.Errorf("expected `process.file.path` to be available: %v", err)
+	}
+
+	for expr, expected := range map[string]bool{
+		`utimes.file.path == "/etc/passwd"`:         false,
+		`open.file.path == "/etc/passwd"`:           true,
+		`process.file.name == "touch"`:              true,
+		`utimes.file.path == "/etc/passwd" || true`: false,
+	} {
+		rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := rule.GenEvaluator(m); (err == nil) != expected {
+			t.Errorf("unexpected compilation result for `%s`: %v", expr, err)
+		}
+	}
+
+	m.EnableEventTypes("utimes")
+	if _, err := m.GetEvaluator("utimes.file.path", ""); err != nil {
+		t.Errorf("expected `utimes.file.path` to be available once enabled back: %v", err)
+	}
+}
+
+func TestProcessAncestorsCmdLine(t *testing.T) {
+	event := newTestEvent(ExecEventType, &testFieldHandlers{})
+	event.ProcessContext = &ProcessContext{
+		Process: Process{Argv0: "curl", Argv: []string{"-s", "https://example.com/install.sh"}},
+		Ancestor: &ProcessCacheEntry{
+			ProcessContext: ProcessContext{
+				Process: Process{Argv0: "bash", Argv: []string{"-c", "curl -s https://example.com/install.sh | sh"}},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{
+						Process: Process{Argv0: "sshd:", Argv: []string{"user@pts/0"}},
+					},
+				},
+			},
+		},
+	}
+
+	value, err := event.GetFieldValue("process.ancestors.cmdline")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"bash -c curl -s https://example.com/install.sh | sh", "sshd: user@pts/0"}
+	if !slices.Equal(value.([]string), expected) {
+		t.Errorf("expected the ancestors command lines to be %v, got %v", expected, value)
+	}
+
+	rule, err := eval.NewRule("id", `process.ancestors.cmdline =~ "*curl * | sh*"`, ast.NewParsingContext(false), &eval.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rule.GenEvaluator(&Model{}); err != nil {
+		t.Fatal(err)
+	}
+	if !rule.Eval(eval.NewContext(event)) {
+		t.Error("expected the rule to match")
+	}
+
+	t.Run("setter", func(t *testing.T) {
+		if err := event.SetFieldValue("process.ancestors[1].cmdline", "init"); err != nil {
+			t.Fatal(err)
+		}
+		if cmdline := event.ProcessContext.Ancestor.Ancestor.CmdLine; cmdline != "init" {
+			t.Errorf("expected the command line of the second generation to be set, got %s", cmdline)
+		}
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		cmdline := BuildCmdLine("python3", []string{"-c", strings.Repeat("a", MaxCmdLineLength)})
+		if len(cmdline) != MaxCmdLineLength+len("...") || !strings.HasSuffix(cmdline, "...") {
+			t.Errorf("expected the command line to be truncated, got a length of %d", len(cmdline))
+		}
+	})
+}
+
+func TestProcessAncestorsUserIn(t *testing.T) {
+	newAncestor := func(user, euser string, ancestor *ProcessCacheEntry) *ProcessCacheEntry {
+		return &ProcessCacheEntry{
+			ProcessContext: ProcessContext{
+				Process:  Process{Credentials: Credentials{User: user, EUser: euser}},
+				Ancestor: ancestor,
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		ancestor *ProcessCacheEntry
+		user     bool
+		euser    bool
+	}{
+		{
+			name:     "listed-user",
+			ancestor: newAncestor("www-data", "www-data", newAncestor("deploy", "postgres", newAncestor("root", "root", nil))),
+			user:     true,
+			euser:    true,
+		},
+		{
+			name:     "listed-euser-only",
+			ancestor: newAncestor("www-data", "postgres", newAncestor("nobody", "nobody", nil)),
+			user:     false,
+			euser:    true,
+		},
+		{
+			name:     "none-matching",
+			ancestor: newAncestor("www-data", "www-data", newAncestor("nobody", "nobody", nil)),
+			user:     false,
+			euser:    false,
+		},
+		{
+			name:  "empty-chain",
+			user:  false,
+			euser: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = &ProcessContext{
+				Process:  Process{Credentials: Credentials{User: "root", EUser: "root"}},
+				Ancestor: test.ancestor,
+			}
+
+			for expr, expected := range map[string]bool{
+				`process.ancestors.user in [ "deploy", "postgres" ]`:  test.user,
+				`process.ancestors.euser in [ "deploy", "postgres" ]`: test.euser,
+				`process.ancestors.euser in [ ~"post*", "root" ]`:     test.euser,
+			} {
+				rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := rule.GenEvaluator(&Model{}); err != nil {
+					t.Fatal(err)
+				}
+
+				if result := rule.Eval(eval.NewContext(event)); result != expected {
+					t.Errorf("expected `%s` to be %v, got %v", expr, expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestFieldsForEventType(t *testing.T) {
+	event := NewFakeEvent()
+
+	fields := event.FieldsForEventType("open")
+	if len(fields) == 0 {
+		t.Fatal("expected some fields for `open`")
+	}
+
+	for _, field := range []eval.Field{"open.file.path", "open.flags", "process.file.path", "process.ancestors.file.path", "container.id"} {
+		if !slices.Contains(fields, field) {
+			t.Errorf("expected `%s` to be listed for `open`", field)
+		}
+	}
+
+	for _, field := range fields {
+		if strings.HasPrefix(field, "chmod.") {
+			t.Errorf("unexpected field `%s` listed for `open`", field)
+		}
+		if strings.HasPrefix(field, "network.") {
+			t.Errorf("unexpected field `%s` restricted to other event types listed for `open`", field)
+		}
+	}
+
+	if fields := event.FieldsForEventType("dns"); !slices.Contains(fields, "network.destination.ip") {
+		t.Error("expected `network.destination.ip` to be listed for `dns`")
+	}
+}
+
+func TestProcessFileHashes(t *testing.T) {
+	const (
+		md5    = "5d41402abc4b2a76b9719d911017c592"
+		sha256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+	)
+
+	tests := []struct {
+		name   string
+		hashes []string
+		md5    string
+		sha256 string
+	}{
+		{
+			name: "not-resolved",
+		},
+		{
+			name:   "resolved",
+			hashes: []string{"sha1:aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d", "sha256:" + sha256, "md5:" + md5},
+			md5:    md5,
+			sha256: sha256,
+		},
+		{
+			name:   "sha256-only",
+			hashes: []string{"sha256:" + sha256},
+			sha256: sha256,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{}
+			event.Exec.Process.FileEvent.Hashes = test.hashes
+			event.ProcessContext = &ProcessContext{Process: *event.Exec.Process}
+
+			for field, expected := range map[eval.Field]string{
+				"exec.file.md5":       test.md5,
+				"exec.file.sha256":    test.sha256,
+				"process.file.md5":    test.md5,
+				"process.file.sha256": test.sha256,
+			} {
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value := evaluator.Eval(eval.NewContext(event)).(string); value != expected {
+					t.Errorf("expected `%s` to be `%s`, got `%s`", field, expected, value)
+				}
+			}
+		})
+	}
+
+	t.Run("in", func(t *testing.T) {
+		rule, err := eval.NewRule("id", `exec.file.sha256 in ["`+sha256+`", "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9"]`, ast.NewParsingContext(false), &eval.Opts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := rule.GenEvaluator(&Model{}); err != nil {
+			t.Fatal(err)
+		}
+
+		event := newTestEvent(ExecEventType, &testFieldHandlers{})
+		event.Exec.Process = &Process{}
+
+		if rule.Eval(eval.NewContext(event)) {
+			t.Error("shouldn't match an executable without resolved hashes")
+		}
+
+		event.Exec.Process.FileEvent.Hashes = []string{"sha256:" + sha256}
+		if !rule.Eval(eval.NewContext(event)) {
+			t.Error("should match an executable with a listed hash")
+		}
+	})
+}
+
+func TestProcessFileIsMemfd(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     FileEvent
+		expected bool
+	}{
+		{
+			name:     "memfd",
+			file:     FileEvent{BasenameStr: "memfd:payload"},
+			expected: true,
+		},
+		{
+			name:     "memfd-path",
+			file:     FileEvent{PathnameStr: "memfd:payload (deleted)", BasenameStr: "memfd:payload (deleted)"},
+			expected: true,
+		},
+		{
+			name:     "anonymous",
+			file:     FileEvent{FileFields: FileFields{PathKey: PathKey{Inode: 1234}}},
+			expected: true,
+		},
+		{
+			name:     "deleted-file",
+			file:     FileEvent{PathnameStr: "/tmp/payload (deleted)", BasenameStr: "payload (deleted)", FileFields: FileFields{PathKey: PathKey{Inode: 1234, MountID: 27}}},
+			expected: false,
+		},
+		{
+			name:     "binary",
+			file:     FileEvent{PathnameStr: "/usr/bin/ls", BasenameStr: "ls", FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 27}}},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{FileEvent: test.file}
+			event.ProcessContext = &ProcessContext{Process: Process{FileEvent: test.file}}
+
+			for _, field := range []string{"exec.file.is_memfd", "process.file.is_memfd"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessFileLayerID(t *testing.T) {
+	const layerID = "sha256:2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+	tests := []struct {
+		name     string
+		file     FileEvent
+		expected string
+	}{
+		{
+			name:     "known-layer",
+			file:     FileEvent{Filesystem: "overlay", FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 42}, Flags: LowerLayer}, LayerID: layerID},
+			expected: layerID,
+		},
+		{
+			name:     "unknown-layer",
+			file:     FileEvent{Filesystem: "ext4", FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 27}}},
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{FileEvent: test.file}
+			event.ProcessContext = &ProcessContext{
+				Process: Process{FileEvent: test.file},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: Process{FileEvent: test.file}},
+				},
+			}
+
+			for _, field := range []string{"exec.file.layer_id", "process.file.layer_id"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %q, got %q", field, test.expected, value)
+				}
+			}
+
+			rule, err := eval.NewRule("id", `process.ancestors.file.layer_id == "`+layerID+`"`, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != (test.expected != "") {
+				t.Errorf("expected the ancestors rule to be %v, got %v", test.expected != "", result)
+			}
+		})
+	}
+}
+
+func TestProcessFileIsHostPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     FileEvent
+		expected bool
+	}{
+		{
+			name:     "host-path",
+			file:     FileEvent{Filesystem: "ext4", FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 27}}},
+			expected: true,
+		},
+		{
+			name:     "container-upper-layer",
+			file:     FileEvent{Filesystem: "overlay", FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 42}, Flags: UpperLayer}},
+			expected: false,
+		},
+		{
+			name:     "container-lower-layer",
+			file:     FileEvent{Filesystem: "overlay", FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 42}, Flags: LowerLayer}},
+			expected: false,
+		},
+		{
+			name:     "fileless",
+			file:     FileEvent{Filesystem: "tmpfs", FileFields: FileFields{PathKey: PathKey{Inode: 1234}}},
+			expected: false,
+		},
+		{
+			name:     "unknown-filesystem",
+			file:     FileEvent{FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 27}}},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{FileEvent: test.file}
+			event.ProcessContext = &ProcessContext{
+				Process: Process{FileEvent: test.file},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: Process{FileEvent: test.file}},
+				},
+			}
+
+			for _, field := range []string{"exec.file.is_host_path", "process.file.is_host_path"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.expected, value)
+				}
+			}
+
+			rule, err := eval.NewRule("id", `process.ancestors.file.is_host_path == true`, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `process.ancestors.file.is_host_path == true` to be %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestProcessIsInit(t *testing.T) {
+	tests := []struct {
+		name     string
+		process  Process
+		expected bool
+	}{
+		{
+			name:     "host-init",
+			process:  Process{PIDContext: PIDContext{Pid: 1}, NSPid: 1},
+			expected: true,
+		},
+		{
+			name:     "container-init",
+			process:  Process{PIDContext: PIDContext{Pid: 4312}, NSPid: 1},
+			expected: true,
+		},
+		{
+			name:     "non-init",
+			process:  Process{PIDContext: PIDContext{Pid: 4313}, NSPid: 2},
+			expected: false,
+		},
+		{
+			name:     "unresolved-namespaced-pid",
+			process:  Process{PIDContext: PIDContext{Pid: 4313}},
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = &ProcessContext{
+				Process: test.process,
+			}
+
+			value, err := event.GetFieldValue("process.is_init")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != test.expected {
+				t.Errorf("expected `process.is_init` to be %v, got %v", test.expected, value)
+			}
+		})
+
+		t.Run(test.name+"-ancestor", func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = &ProcessContext{
+				Process: Process{PIDContext: PIDContext{Pid: 5000}, NSPid: 5000},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: test.process},
+				},
+			}
+
+			rule, err := eval.NewRule("id", `process.ancestors.is_init == true`, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `process.ancestors.is_init == true` to be %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestProcessSessionType(t *testing.T) {
+	tests := []struct {
+		name     string
+		process  Process
+		expected string
+	}{
+		{
+			name:     "pts",
+			process:  Process{TTYName: "/dev/pts/0"},
+			expected: TTYSessionTypePTS,
+		},
+		{
+			name:     "kernel-pts",
+			process:  Process{TTYName: "pts3"},
+			expected: TTYSessionTypePTS,
+		},
+		{
+			name:     "ssh",
+			process:  Process{TTYName: "pts1", Envs: []string{"HOME", "SSH_TTY"}},
+			expected: TTYSessionTypeSSH,
+		},
+		{
+			name:     "console",
+			process:  Process{TTYName: "tty1"},
+			expected: TTYSessionTypeConsole,
+		},
+		{
+			name:     "none",
+			process:  Process{},
+			expected: TTYSessionTypeNone,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &test.process
+			event.ProcessContext = &ProcessContext{
+				Process: test.process,
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: test.process},
+				},
+			}
+
+			for _, field := range []string{"exec.session_type", "process.session_type"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %s, got %v", field, test.expected, value)
+				}
+			}
+
+			expr := `process.ancestors.session_type == "` + test.expected + `"`
+			rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if !rule.Eval(eval.NewContext(event)) {
+				t.Errorf("expected `%s` to match", expr)
+			}
+		})
+	}
+}
+
+func TestFileOwnerLazyResolution(t *testing.T) {
+	var userCalls, groupCalls int
+
+	m := &Model{}
+	m.SetFileOwnerCallbacks(
+		func(uid uint32, _ containerutils.ContainerID) string {
+			userCalls++
+			return "user-" + strconv.Itoa(int(uid))
+		},
+		func(gid uint32, _ containerutils.ContainerID) string {
+			groupCalls++
+			return "group-" + strconv.Itoa(int(gid))
+		},
+	)
+
+	newEvent := func() *Event {
+		event := newTestEvent(FileOpenEventType, &testFieldHandlers{fileOwners: m.FileOwnerResolver()})
+		event.Open.File.UID = 1000
+		event.Open.File.GID = 100
+		event.Open.File.PathnameStr = "/tmp/test"
+		return event
+	}
+
+	t.Run("not-accessed", func(t *testing.T) {
+		userCalls, groupCalls = 0, 0
+
+		event := newEvent()
+		for _, field := range []string{"open.file.path", "open.file.uid", "open.file.gid"} {
+			if _, err := event.GetFieldValue(field); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if userCalls != 0 || groupCalls != 0 {
+			t.Errorf("expected no resolution, got %d user and %d group resolutions", userCalls, groupCalls)
+		}
+	})
+
+	t.Run("accessed", func(t *testing.T) {
+		userCalls, groupCalls = 0, 0
+
+		event := newEvent()
+		for i := 0; i < 3; i++ {
+			if value, err := event.GetFieldValue("open.file.user"); err != nil || value != "user-1000" {
+				t.Fatalf("unexpected user: %v (%v)", value, err)
+			}
+		}
+
+		evaluator, err := m.GetEvaluator("open.file.group", "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 3; i++ {
+			if value := evaluator.Eval(eval.NewContext(event)); value != "group-100" {
+				t.Fatalf("unexpected group: %v", value)
+			}
+		}
+
+		if userCalls != 1 || groupCalls != 1 {
+			t.Errorf("expected one resolution per field, got %d user and %d group resolutions", userCalls, groupCalls)
+		}
+
+		// the names are cached per event
+		if value, err := newEvent().GetFieldValue("open.file.user"); err != nil || value != "user-1000" {
+			t.Fatalf("unexpected user: %v (%v)", value, err)
+		}
+		if userCalls != 2 {
+			t.Errorf("expected a resolution for the new event, got %d user resolutions", userCalls)
+		}
+	})
+
+	t.Run("not-resolved", func(t *testing.T) {
+		var calls int
+		users := map[uint32]string{}
+
+		m := &Model{}
+		m.SetFileOwnerCallbacks(
+			func(uid uint32, _ containerutils.ContainerID) string {
+				calls++
+				return users[uid]
+			},
+			nil,
+		)
+
+		event := newEvent()
+		event.FieldHandlers = &testFieldHandlers{fileOwners: m.FileOwnerResolver()}
+
+		for i := 0; i < 2; i++ {
+			if value, err := event.GetFieldValue("open.file.user"); err != nil || value != "" {
+				t.Fatalf("unexpected user: %v (%v)", value, err)
+			}
+		}
+		if calls != 2 {
+			t.Errorf("expected a resolution per access until resolved, got %d user resolutions", calls)
+		}
+
+		// the user is resolved once known, and cached from then on
+		users[1000] = "user-1000"
+		for i := 0; i < 2; i++ {
+			if value, err := event.GetFieldValue("open.file.user"); err != nil || value != "user-1000" {
+				t.Fatalf("unexpected user: %v (%v)", value, err)
+			}
+		}
+		if calls != 3 {
+			t.Errorf("expected the resolved user to be cached, got %d user resolutions", calls)
+		}
+	})
+}
+
+func TestOwnerNumericFallback(t *testing.T) {
+	names := map[uint32]string{0: "root"}
+	resolve := func(id uint32, _ containerutils.ContainerID) string {
+		return names[id]
+	}
+
+	tests := []struct {
+		name          string
+		fallback      bool
+		id            int64
+		expectedUser  string
+		expectedGroup string
+	}{
+		{
+			name:          "resolved",
+			fallback:      true,
+			id:            0,
+			expectedUser:  "root",
+			expectedGroup: "root",
+		},
+		{
+			name:          "unresolved",
+			fallback:      true,
+			id:            1234,
+			expectedUser:  "1234",
+			expectedGroup: "1234",
+		},
+		{
+			name:     "disabled",
+			fallback: false,
+			id:       1234,
+		},
+		{
+			name:     "unchanged",
+			fallback: true,
+			id:       -1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &Model{}
+			m.SetFileOwnerCallbacks(resolve, resolve)
+			m.SetOwnerNumericFallback(test.fallback)
+
+			event := newTestEvent(FileChownEventType, &testFieldHandlers{fileOwners: m.FileOwnerResolver()})
+			event.Chown.UID = test.id
+			event.Chown.GID = test.id
+
+			expected := map[string]string{
+				"chown.file.destination.user":  test.expectedUser,
+				"chown.file.destination.group": test.expectedGroup,
+			}
+			// the current owner of the file is resolved the same way
+			if test.id >= 0 {
+				event.Chown.File.UID = uint32(test.id)
+				event.Chown.File.GID = uint32(test.id)
+				expected["chown.file.user"] = test.expectedUser
+				expected["chown.file.group"] = test.expectedGroup
+			}
+
+			for field, value := range expected {
+				evaluator, err := m.GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if result := evaluator.Eval(eval.NewContext(event)); result != value {
+					t.Errorf("expected `%s` for %s, got `%v`", value, field, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCredentialsNumericFallback(t *testing.T) {
+	resolve := func(_ uint32, _ containerutils.ContainerID) string {
+		return ""
+	}
+
+	tests := []struct {
+		name        string
+		fallback    bool
+		credentials Credentials
+		expected    string
+	}{
+		{
+			name:     "resolved",
+			fallback: true,
+			credentials: Credentials{
+				UID: 0, User: "root", GID: 0, Group: "root",
+				EUID: 0, EUser: "root", EGID: 0, EGroup: "root",
+				FSUID: 0, FSUser: "root", FSGID: 0, FSGroup: "root",
+			},
+			expected: "root",
+		},
+		{
+			name:     "unresolved",
+			fallback: true,
+			credentials: Credentials{
+				UID: 1234, GID: 1234, EUID: 1234, EGID: 1234, FSUID: 1234, FSGID: 1234,
+			},
+			expected: "1234",
+		},
+		{
+			name:     "disabled",
+			fallback: false,
+			credentials: Credentials{
+				UID: 1234, GID: 1234, EUID: 1234, EGID: 1234, FSUID: 1234, FSGID: 1234,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := &Model{}
+			m.SetFileOwnerCallbacks(resolve, resolve)
+			m.SetOwnerNumericFallback(test.fallback)
+
+			event := newTestEvent(ExecEventType, &testFieldHandlers{fileOwners: m.FileOwnerResolver()})
+			event.ProcessContext = &ProcessContext{
+				Process: Process{Credentials: test.credentials},
+			}
+
+			for _, field := range []eval.Field{
+				"process.user",
+				"process.group",
+				"process.euser",
+				"process.egroup",
+				"process.fsuser",
+				"process.fsgroup",
+			} {
+				evaluator, err := m.GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if result := evaluator.Eval(eval.NewContext(event)); result != test.expected {
+					t.Errorf("expected `%s` for %s, got `%v`", test.expected, field, result)
+				}
+			}
+
+			// the fallback isn't stored in the credentials shared by the events of the process
+			if test.credentials.User == "" && event.ProcessContext.Process.Credentials.User != "" {
+				t.Errorf("expected the user of the process not to be stored, got `%s`", event.ProcessContext.Process.Credentials.User)
+			}
+		})
+	}
+}
+
+func TestProcessFileIsSetuid(t *testing.T) {
+	tests := []struct {
+		name           string
+		mode           uint16
+		expectedSetuid bool
+		expectedSetgid bool
+	}{
+		{
+			name:           "setuid",
+			mode:           syscall.S_IFREG | syscall.S_ISUID | 0755,
+			expectedSetuid: true,
+		},
+		{
+			name:           "setgid",
+			mode:           syscall.S_IFREG | syscall.S_ISGID | 0755,
+			expectedSetgid: true,
+		},
+		{
+			name: "normal",
+			mode: syscall.S_IFREG | 0755,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file := FileEvent{FileFields: FileFields{Mode: test.mode}}
+
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{FileEvent: file}
+			event.ProcessContext = &ProcessContext{Process: Process{FileEvent: file}}
+
+			for field, expected := range map[string]bool{
+				"exec.file.is_setuid":    test.expectedSetuid,
+				"process.file.is_setuid": test.expectedSetuid,
+				"exec.file.is_setgid":    test.expectedSetgid,
+				"process.file.is_setgid": test.expectedSetgid,
+			} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessAncestorsFileIsSetuid(t *testing.T) {
+	newAncestor := func(pid uint32, mode uint16, ancestor *ProcessCacheEntry) *ProcessCacheEntry {
+		return &ProcessCacheEntry{
+			ProcessContext: ProcessContext{
+				Process: Process{
+					PIDContext: PIDContext{Pid: pid},
+					FileEvent:  FileEvent{FileFields: FileFields{Mode: mode}},
+				},
+				Ancestor: ancestor,
+			},
+		}
+	}
+
+	// bash <- sudo (setuid) <- login (setgid) <- systemd
+	chain := newAncestor(10, syscall.S_IFREG|0755,
+		newAncestor(9, syscall.S_IFREG|syscall.S_ISUID|0755,
+			newAncestor(8, syscall.S_IFREG|syscall.S_ISGID|0755,
+				newAncestor(1, syscall.S_IFREG|0755, nil))))
+
+	newEvent := func(ancestor *ProcessCacheEntry) *Event {
+		event := newTestEvent(ExecEventType, &testFieldHandlers{})
+		event.ProcessContext = &ProcessContext{
+			Process:  Process{PIDContext: PIDContext{Pid: 11}},
+			Ancestor: ancestor,
+		}
+		return event
+	}
+
+	for field, expected := range map[eval.Field][]bool{
+		"process.ancestors.file.is_setuid": {false, true, false, false},
+		"process.ancestors.file.is_setgid": {false, false, true, false},
+	} {
+		value, err := newEvent(chain).GetFieldValue(field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(value, expected) {
+			t.Errorf("expected `%s` to be %v, got %v", field, expected, value)
+		}
+	}
+
+	tests := []struct {
+		expr     string
+		ancestor *ProcessCacheEntry
+		expected bool
+	}{
+		{expr: `process.ancestors.file.is_setuid == true`, ancestor: chain, expected: true},
+		{expr: `process.ancestors.file.is_setgid == true`, ancestor: chain, expected: true},
+		{expr: `process.ancestors.file.is_setuid == true`, ancestor: chain.Ancestor.Ancestor, expected: false},
+		{expr: `process.ancestors.file.is_setuid == true`, expected: false},
+	}
+
+	for _, test := range tests {
+		rule, err := eval.NewRule("id", test.expr, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := rule.GenEvaluator(&Model{}); err != nil {
+			t.Fatal(err)
+		}
+		if value := rule.Eval(eval.NewContext(newEvent(test.ancestor))); value != test.expected {
+			t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, value)
+		}
+	}
+
+	// the value set on the first ancestor is read back through the default handlers
+	event := NewFakeEvent()
+	if err := event.SetFieldValue("process.ancestors.file.is_setuid", true); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := event.GetFieldValue("process.ancestors.file.is_setuid"); err != nil || !reflect.DeepEqual(value, []bool{true}) {
+		t.Errorf("expected `process.ancestors.file.is_setuid` to be read back, got %v (%v)", value, err)
+	}
+}
+
+func TestEventIsMetadataOnly(t *testing.T) {
+	for eventType, expected := range map[EventType]bool{
+		FileChmodEventType:       true,
+		FileChownEventType:       true,
+		FileUtimesEventType:      true,
+		FileSetXAttrEventType:    true,
+		FileRemoveXAttrEventType: true,
+		FileOpenEventType:        false,
+		FileRenameEventType:      false,
+	} {
+		t.Run(eventType.String(), func(t *testing.T) {
+			event := newTestEvent(eventType, &testFieldHandlers{})
+
+			value, err := event.GetFieldValue("event.is_metadata_only")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != expected {
+				t.Errorf("expected `event.is_metadata_only` to be %v, got %v", expected, value)
+			}
+		})
+	}
+}
+
+func TestProcessCwd(t *testing.T) {
+	event := newTestEvent(ExecEventType, &testFieldHandlers{})
+	event.ProcessContext = &ProcessContext{
+		Process: Process{Cwd: "/tmp"},
+		Ancestor: &ProcessCacheEntry{
+			ProcessContext: ProcessContext{
+				Process: Process{Cwd: "/home/user"},
+			},
+		},
+	}
+	event.Exec.Process = &event.ProcessContext.Process
+
+	t.Run("populated", func(t *testing.T) {
+		for _, field := range []string{"exec.cwd", "process.cwd"} {
+			value, err := event.GetFieldValue(field)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != "/tmp" {
+				t.Errorf("expected `%s` to be `/tmp`, got `%v`", field, value)
+			}
+		}
+	})
+
+	t.Run("ancestors", func(t *testing.T) {
+		value, err := event.GetFieldValue("process.ancestors.cwd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if values, ok := value.([]string); !ok || len(values) != 1 || values[0] != "/home/user" {
+			t.Errorf("expected `process.ancestors.cwd` to be `[/home/user]`, got `%v`", value)
+		}
+
+		rule, err := eval.NewRule("id", `process.ancestors.cwd == "/home/user" && process.cwd == "/tmp"`, ast.NewParsingContext(false), &eval.Opts{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := rule.GenEvaluator(&Model{}); err != nil {
+			t.Fatal(err)
+		}
+		if !rule.Eval(eval.NewContext(event)) {
+			t.Error("expected the rule to match the ancestor working directory")
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		event.ProcessContext.Process.Cwd = ""
+
+		value, err := event.GetFieldValue("exec.cwd")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != "" {
+			t.Errorf("expected `exec.cwd` to be empty, got `%v`", value)
+		}
+	})
+
+	t.Run("fork-exec", func(t *testing.T) {
+		parent := NewPlaceholderProcessCacheEntry(42, 42, false)
+		parent.Cwd = "/tmp"
+		parent.CwdPathKey = PathKey{Inode: 33, MountID: 44}
+
+		child := NewPlaceholderProcessCacheEntry(43, 43, false)
+		parent.Fork(child)
+		if child.Cwd != "/tmp" || child.CwdPathKey != parent.CwdPathKey {
+			t.Errorf("expected the forked process to inherit the working directory, got `%s`", child.Cwd)
+		}
+
+		exec := NewPlaceholderProcessCacheEntry(43, 43, false)
+		child.Cwd = "/home/user"
+		child.Exec(exec)
+		if exec.Cwd != "" {
+			t.Errorf("expected the working directory to come from the exec event, got `%s`", exec.Cwd)
+		}
+	})
+}
+
+func TestProcessAncestorsExecCount(t *testing.T) {
+	newProcessContext := func(pid uint32, ancestorPids ...uint32) *ProcessContext {
+		pc := &ProcessContext{Process: Process{PIDContext: PIDContext{Pid: pid}}}
+		current := pc
+		for _, ancestorPid := range ancestorPids {
+			current.Ancestor = &ProcessCacheEntry{ProcessContext: ProcessContext{Process: Process{PIDContext: PIDContext{Pid: ancestorPid}}}}
+			current = &current.Ancestor.ProcessContext
+		}
+		return pc
+	}
+
+	tests := []struct {
+		name     string
+		pc       *ProcessContext
+		expected int
+	}{
+		{
+			name:     "fork-chain",
+			pc:       newProcessContext(300, 200, 100, 1),
+			expected: 0,
+		},
+		{
+			name:     "re-exec-chain",
+			pc:       newProcessContext(300, 300, 300, 300, 100, 1),
+			expected: 3,
+		},
+		{
+			name:     "no-ancestor",
+			pc:       newProcessContext(300),
+			expected: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.ProcessContext = test.pc
+
+			value, err := event.GetFieldValue("process.ancestors.exec_count")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != test.expected {
+				t.Errorf("expected `process.ancestors.exec_count` to be %d, got %v", test.expected, value)
+			}
+
+			rule, err := eval.NewRule("id", `process.ancestors.exec_count >= 2`, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != (test.expected >= 2) {
+				t.Errorf("expected `process.ancestors.exec_count >= 2` to be %v, got %v", test.expected >= 2, result)
+			}
+		})
+	}
+}
+
+func TestProcessFileIsDeleted(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     FileEvent
+		expected bool
+	}{
+		{
+			name:     "present",
+			file:     FileEvent{PathnameStr: "/usr/bin/curl"},
+			expected: false,
+		},
+		{
+			name:     "deleted-suffix",
+			file:     FileEvent{PathnameStr: "/tmp/payload" + DeletedPathSuffix},
+			expected: true,
+		},
+		{
+			name:     "unlinked",
+			file:     FileEvent{PathnameStr: "/tmp/payload", FileFields: FileFields{Deleted: true}},
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{FileEvent: test.file}
+			event.ProcessContext = &ProcessContext{
+				Process: Process{FileEvent: test.file},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: Process{FileEvent: test.file}},
+				},
+			}
+
+			for _, field := range []string{"exec.file.is_deleted", "process.file.is_deleted"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.expected, value)
+				}
+			}
+
+			rule, err := eval.NewRule("id", `process.ancestors.file.is_deleted == true`, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `process.ancestors.file.is_deleted` to be %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestSetuidChanged(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous int
+		value    int
+		expected bool
+	}{
+		{name: "change", previous: 1000, value: 0, expected: true},
+		{name: "no-op", previous: 1000, value: 1000, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, eventType := range []struct {
+				eventType EventType
+				prefix    string
+				previous  string
+				value     string
+			}{
+				{eventType: SetuidEventType, prefix: "setuid", previous: "setuid.previous_uid", value: "setuid.uid"},
+				{eventType: SetgidEventType, prefix: "setgid", previous: "setgid.previous_gid", value: "setgid.gid"},
+			} {
+				event := newTestEvent(eventType.eventType, &testFieldHandlers{})
+
+				if err := event.SetFieldValue(eventType.previous, test.previous); err != nil {
+					t.Fatal(err)
+				}
+				if err := event.SetFieldValue(eventType.value, test.value); err != nil {
+					t.Fatal(err)
+				}
+
+				value, err := event.GetFieldValue(eventType.previous)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.previous {
+					t.Errorf("expected `%s` to be %d, got %v", eventType.previous, test.previous, value)
+				}
+
+				value, err = event.GetFieldValue(eventType.prefix + ".changed")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s.changed` to be %v, got %v", eventType.prefix, test.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestFileIsLog(t *testing.T) {
+	m := &Model{}
+	m.RegisterLogDirectories("/home/user")
+
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "log",
+			path:     "/var/log/auth.log",
+			expected: true,
+		},
+		{
+			name:     "journald",
+			path:     "/run/log/journal/0b4f4c6f/system.journal",
+			expected: true,
+		},
+		{
+			name:     "custom-directory",
+			path:     "/home/user/app.log",
+			expected: true,
+		},
+		{
+			name:     "non-log",
+			path:     "/etc/hostname",
+			expected: false,
+		},
+		{
+			name:     "directory-prefix",
+			path:     "/var/logs/auth.log",
+			expected: false,
+		},
+		{
+			name:     "empty",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileUnlinkEventType, &testFieldHandlers{logPaths: m.LogPaths()})
+			event.Unlink.File = FileEvent{PathnameStr: test.path}
+			event.Rename.Old = FileEvent{PathnameStr: test.path}
+			event.Link.Source = FileEvent{PathnameStr: test.path}
+
+			for _, field := range []string{"unlink.file.is_log", "rename.file.is_log", "link.file.is_log"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestFileIsCritical(t *testing.T) {
+	m := &Model{}
+	m.RegisterCriticalPaths("/opt/app/config/credentials.json")
+
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "critical",
+			path:     "/etc/shadow",
+			expected: true,
+		},
+		{
+			name:     "non-critical",
+			path:     "/etc/hostname",
+			expected: false,
+		},
+		{
+			name:     "custom-critical",
+			path:     "/opt/app/config/credentials.json",
+			expected: true,
+		},
+		{
+			name:     "empty",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileChmodEventType, &testFieldHandlers{criticalPaths: m.CriticalPaths()})
+			event.Chmod.File = FileEvent{PathnameStr: test.path}
+			event.Chown.File = FileEvent{PathnameStr: test.path}
+
+			for _, field := range []string{"chmod.file.is_critical", "chown.file.is_critical"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, test.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessFileNameEntropy(t *testing.T) {
+	for name, expected := range map[string]float64{
+		"":     0,
+		"aaaa": 0,
+		"ab":   1,
+		"abcd": 2,
+		"aab":  0.918,
+	} {
+		if entropy := NameEntropy(name); math.Abs(entropy-expected) > 0.001 {
+			t.Errorf("expected the entropy of `%s` to be %f, got %f", name, expected, entropy)
+		}
+	}
+
+	resolve := func(basename string) float64 {
+		file := FileEvent{BasenameStr: basename}
+
+		event := newTestEvent(ExecEventType, &testFieldHandlers{})
+		event.Exec.Process = &Process{FileEvent: file}
+		event.ProcessContext = &ProcessContext{Process: Process{FileEvent: file}}
+
+		value, err := event.GetFieldValue("exec.file.name_entropy")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if processValue, _ := event.GetFieldValue("process.file.name_entropy"); processValue != value {
+			t.Errorf("expected `process.file.name_entropy` to be %v, got %v", value, processValue)
+		}
+		return value.(float64)
+	}
+
+	// a randomly generated name scores well above dictionary names
+	random := resolve("xk9Qz2fLp7VwR4")
+	for _, basename := range []string{"systemd", "nginx", "containerd"} {
+		if entropy := resolve(basename); random-entropy < 0.5 {
+			t.Errorf("expected `%s` to score below the random name, got %f and %f", basename, entropy, random)
+		}
+	}
+
+	rule, err := eval.NewRule("id", `exec.file.name_entropy > 3.5`, ast.NewParsingContext(false), &eval.Opts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rule.GenEvaluator(&Model{}); err != nil {
+		t.Fatal(err)
+	}
+
+	event := newTestEvent(ExecEventType, &testFieldHandlers{})
+	event.Exec.Process = &Process{FileEvent: FileEvent{BasenameStr: "xk9Qz2fLp7VwR4"}}
+	if !rule.Eval(eval.NewContext(event)) {
+		t.Error("expected the random name to match")
+	}
+	event.Exec.Process = &Process{FileEvent: FileEvent{BasenameStr: "systemd"}}
+	if rule.Eval(eval.NewContext(event)) {
+		t.Error("expected the dictionary name not to match")
+	}
+}
+
+func TestProcessFileInterpreterClass(t *testing.T) {
+	m := &Model{}
+	m.RegisterInterpreters(InterpreterClassScripting, "deno")
+
+	tests := []struct {
+		name     string
+		basename string
+		expected string
+	}{
+		{name: "shell", basename: "bash", expected: InterpreterClassShell},
+		{name: "scripting", basename: "python3", expected: InterpreterClassScripting},
+		{name: "package-manager", basename: "apt", expected: InterpreterClassPackageManager},
+		{name: "registered", basename: "deno", expected: InterpreterClassScripting},
+		{name: "unknown", basename: "nginx", expected: InterpreterClassNone},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			file := FileEvent{BasenameStr: test.basename}
+
+			event := newTestEvent(ExecEventType, &testFieldHandlers{interpreterClasses: m.InterpreterClassifier()})
+			event.Exec.Process = &Process{FileEvent: file}
+			event.ProcessContext = &ProcessContext{
+				Process: Process{FileEvent: file},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: Process{FileEvent: file}},
+				},
+			}
+
+			for _, field := range []string{"exec.file.interpreter_class", "process.file.interpreter_class"} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != test.expected {
+					t.Errorf("expected `%s` to be %q, got %q", field, test.expected, value)
+				}
+			}
+
+			rule, err := eval.NewRule("id", `process.ancestors.file.interpreter_class == "`+test.expected+`"`, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(m); err != nil {
+				t.Fatal(err)
+			}
+			if !rule.Eval(eval.NewContext(event)) {
+				t.Errorf("expected the ancestors rule to match `%s`", test.expected)
+			}
+		})
+	}
+}
+
+func TestSyscallSucceeded(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType EventType
+		retval    int64
+		succeeded bool
+	}{
+		{
+			name:      "open-success",
+			eventType: FileOpenEventType,
+			retval:    3,
+			succeeded: true,
+		},
+		{
+			name:      "open-eacces",
+			eventType: FileOpenEventType,
+			retval:    -int64(syscall.EACCES),
+			succeeded: false,
+		},
+		{
+			name:      "chmod-success",
+			eventType: FileChmodEventType,
+			retval:    0,
+			succeeded: true,
+		},
+		{
+			name:      "chmod-eperm",
+			eventType: FileChmodEventType,
+			retval:    -int64(syscall.EPERM),
+			succeeded: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(test.eventType, &testFieldHandlers{})
+			event.Open.Retval = test.retval
+			event.Chmod.Retval = test.retval
+
+			for field, expected := range map[eval.Field]bool{
+				test.eventType.String() + ".succeeded": test.succeeded,
+				test.eventType.String() + ".failed":    !test.succeeded,
+			} {
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value := evaluator.Eval(eval.NewContext(event)).(bool); value != expected {
+					t.Errorf("expected `%s` to be %v for retval %d, got %v", field, expected, test.retval, value)
+				}
+			}
+		})
+	}
+}
+
+func TestProcessIsAgent(t *testing.T) {
+	const agentPid = 4242
+
+	tests := []struct {
+		name     string
+		pid      uint32
+		expected bool
+	}{
+		{
+			name:     "agent",
+			pid:      agentPid,
+			expected: true,
+		},
+		{
+			name:     "other",
+			pid:      1337,
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			process := Process{PIDContext: PIDContext{Pid: test.pid}}
+
+			event := newTestEvent(ExecEventType, &testFieldHandlers{agentPid: agentPid})
+			event.Exec.Process = &process
+			event.ProcessContext = &ProcessContext{Process: process}
+
+			for _, field := range []eval.Field{"process.is_agent", "exec.is_agent"} {
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value := evaluator.Eval(eval.NewContext(event)).(bool); value != test.expected {
+					t.Errorf("expected `%s` to be %v for pid %d, got %v", field, test.expected, test.pid, value)
+				}
+			}
+		})
+	}
+}
+
+func TestDestinationContainsDotDot(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     string
+		expected bool
+	}{
+		{
+			name:     "traversal",
+			path:     "/a/../b",
+			expected: true,
+		},
+		{
+			name:     "relative-traversal",
+			path:     "../../etc/cron.d/job",
+			expected: true,
+		},
+		{
+			name:     "trailing-traversal",
+			path:     "/tmp/a/..",
+			expected: true,
+		},
+		{
+			name:     "clean",
+			path:     "/usr/local/bin/tool",
+			expected: false,
+		},
+		{
+			name:     "dotdot-in-filename",
+			path:     "/tmp/..hidden/file..",
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, eventType := range []EventType{FileRenameEventType, FileLinkEventType} {
+				event := newTestEvent(eventType, &testFieldHandlers{})
+				event.Rename.SyscallContext.StrArg2 = test.path
+				event.Link.SyscallContext.StrArg2 = test.path
+
+				field := eventType.String() + ".file.destination.contains_dotdot"
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value := evaluator.Eval(eval.NewContext(event)).(bool); value != test.expected {
+					t.Errorf("expected `%s` to be %v for `%s`, got %v", field, test.expected, test.path, value)
+				}
+			}
+		})
+	}
+}
+
+func TestCrossMount(t *testing.T) {
+	tests := []struct {
+		name               string
+		sourceMountID      uint32
+		destinationMountID uint32
+		expected           bool
+	}{
+		{
+			name:               "same-mount",
+			sourceMountID:      27,
+			destinationMountID: 27,
+			expected:           false,
+		},
+		{
+			name:               "different-mount",
+			sourceMountID:      27,
+			destinationMountID: 312,
+			expected:           true,
+		},
+		{
+			name:               "unknown-mount",
+			sourceMountID:      27,
+			destinationMountID: 0,
+			expected:           false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, eventType := range []EventType{FileRenameEventType, FileLinkEventType} {
+				event := newTestEvent(eventType, &testFieldHandlers{})
+				event.Rename.Old.MountID = test.sourceMountID
+				event.Rename.New.MountID = test.destinationMountID
+				event.Link.Source.MountID = test.sourceMountID
+				event.Link.Target.MountID = test.destinationMountID
+
+				expr := eventType.String() + ".cross_mount == true"
+				rule, err := eval.NewRule("test", expr, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := rule.GenEvaluator(&Model{}); err != nil {
+					t.Fatal(err)
+				}
+				if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", expr, test.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestBasenameChanged(t *testing.T) {
+	tests := []struct {
+		name                string
+		sourceBasename      string
+		destinationBasename string
+		expected            bool
+	}{
+		{
+			name:                "move",
+			sourceBasename:      "passwd",
+			destinationBasename: "passwd",
+			expected:            false,
+		},
+		{
+			name:                "rename",
+			sourceBasename:      "passwd",
+			destinationBasename: "passwd.bak",
+			expected:            true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, eventType := range []EventType{FileRenameEventType, FileLinkEventType} {
+				event := newTestEvent(eventType, &testFieldHandlers{})
+				event.Rename.Old.SetPathnameStr("/etc/" + test.sourceBasename)
+				event.Rename.Old.SetBasenameStr(test.sourceBasename)
+				event.Rename.New.SetPathnameStr("/tmp/" + test.destinationBasename)
+				event.Rename.New.SetBasenameStr(test.destinationBasename)
+				event.Link.Source = event.Rename.Old
+				event.Link.Target = event.Rename.New
+
+				expr := eventType.String() + ".basename_changed == true"
+				rule, err := eval.NewRule("test", expr, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := rule.GenEvaluator(&Model{}); err != nil {
+					t.Fatal(err)
+				}
+				if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+					t.Errorf("expected `%s` to be %v, got %v", expr, test.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestCaseInsensitivePaths(t *testing.T) {
+	tests := []struct {
+		name                 string
+		caseInsensitivePaths bool
+		expr                 string
+		expected             bool
+	}{
+		{
+			name:                 "enabled",
+			caseInsensitivePaths: true,
+			expr:                 `open.file.path == "/mnt/USB/Autorun.inf"`,
+			expected:             true,
+		},
+		{
+			name:                 "enabled-in",
+			caseInsensitivePaths: true,
+			expr:                 `open.file.path in [ "/MNT/usb/autorun.INF", "/tmp/test" ]`,
+			expected:             true,
+		},
+		{
+			name:                 "enabled-pattern",
+			caseInsensitivePaths: true,
+			expr:                 `open.file.path =~ "/mnt/usb/*.INF"`,
+			expected:             true,
+		},
+		{
+			name:                 "enabled-ancestors",
+			caseInsensitivePaths: true,
+			expr:                 `process.ancestors.file.path == "/USR/BIN/BASH"`,
+			expected:             true,
+		},
+		{
+			name:     "disabled",
+			expr:     `open.file.path == "/mnt/USB/Autorun.inf"`,
+			expected: false,
+		},
+		{
+			name:     "disabled-exact",
+			expr:     `open.file.path == "/mnt/usb/autorun.inf"`,
+			expected: true,
+		},
+		{
+			name:     "disabled-ancestors",
+			expr:     `process.ancestors.file.path == "/USR/BIN/BASH"`,
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := NewFakeEvent()
+			event.Type = uint32(FileOpenEventType)
+			event.Open.File.PathnameStr = "/mnt/usb/autorun.inf"
+			event.ProcessContext = &ProcessContext{
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{
+						Process: Process{FileEvent: FileEvent{PathnameStr: "/usr/bin/bash"}},
+					},
+				},
+			}
+
+			rule, err := eval.NewRule("id", test.expr, ast.NewParsingContext(false), &eval.Opts{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{CaseInsensitivePaths: test.caseInsensitivePaths}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+		})
+	}
+}
+
+func TestOpenIsWrite(t *testing.T) {
+	tests := []struct {
+		name       string
+		flags      uint32
+		write      bool
+		truncating bool
+	}{
+		{
+			name:  "read-only",
+			flags: unix.O_RDONLY | unix.O_CLOEXEC,
+		},
+		{
+			name:  "write-only",
+			flags: unix.O_WRONLY,
+			write: true,
+		},
+		{
+			name:  "read-write",
+			flags: unix.O_RDWR,
+			write: true,
+		},
+		{
+			name:  "append",
+			flags: unix.O_APPEND,
+			write: true,
+		},
+		{
+			name:  "create",
+			flags: unix.O_CREAT | unix.O_EXCL,
+			write: true,
+		},
+		{
+			name:       "truncating",
+			flags:      unix.O_WRONLY | unix.O_CREAT | unix.O_TRUNC,
+			write:      true,
+			truncating: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileOpenEventType, &testFieldHandlers{})
+			event.Open.Flags = test.flags
+
+			for field, expected := range map[string]bool{
+				"open.is_write":      test.write,
+				"open.is_truncating": test.truncating,
+			} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestChownToRoot(t *testing.T) {
+	tests := []struct {
+		name     string
+		uid      int64
+		gid      int64
+		expected bool
+	}{
+		{
+			name:     "root-user",
+			uid:      0,
+			gid:      -1,
+			expected: true,
+		},
+		{
+			name:     "root-group",
+			uid:      -1,
+			gid:      0,
+			expected: true,
+		},
+		{
+			name:     "regular-user",
+			uid:      1000,
+			gid:      1000,
+			expected: false,
+		},
+		{
+			name:     "unchanged",
+			uid:      -1,
+			gid:      -1,
+			expected: false,
+		},
+	}
+
+	evaluator, err := (&Model{}).GetEvaluator("chown.to_root", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileChownEventType, &testFieldHandlers{})
+			event.Chown.UID = test.uid
+			event.Chown.GID = test.gid
+
+			if value := evaluator.Eval(eval.NewContext(event)).(bool); value != test.expected {
+				t.Errorf("expected `chown.to_root` to be %v for %d:%d, got %v", test.expected, test.uid, test.gid, value)
+			}
+		})
+	}
+
+	t.Run("setter", func(t *testing.T) {
+		event := NewFakeEvent()
+		if err := event.SetFieldValue("chown.to_root", true); err != nil {
+			t.Fatal(err)
+		}
+		if !event.Chown.ToRoot {
+			t.Error("expected `chown.to_root` to be set")
+		}
+
+		value, err := event.GetFieldValue("chown.to_root")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != true {
+			t.Errorf("expected `chown.to_root` to be true, got %v", value)
+		}
+
+		if err := event.SetFieldValue("chown.to_root", "true"); err == nil {
+			t.Error("expected a type mismatch error")
+		}
+	})
+}
+
+func TestUnlinkIsSelfDelete(t *testing.T) {
+	binary := FileEvent{FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 27}}}
+
+	tests := []struct {
+		name     string
+		unlinked FileEvent
+		process  FileEvent
+		expected bool
+	}{
+		{
+			name:     "own-binary",
+			unlinked: binary,
+			process:  binary,
+			expected: true,
+		},
+		{
+			name:     "other-file",
+			unlinked: FileEvent{FileFields: FileFields{PathKey: PathKey{Inode: 1234, MountID: 27}}},
+			process:  binary,
+			expected: false,
+		},
+		{
+			name:     "same-inode-other-mount",
+			unlinked: FileEvent{FileFields: FileFields{PathKey: PathKey{Inode: 5678, MountID: 31}}},
+			process:  binary,
+			expected: false,
+		},
+		{
+			name:     "unresolved-binary",
+			unlinked: FileEvent{},
+			process:  FileEvent{},
+			expected: false,
+		},
+	}
+
+	evaluator, err := (&Model{}).GetEvaluator("unlink.is_self_delete", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(FileUnlinkEventType, &testFieldHandlers{})
+			event.Unlink.File = test.unlinked
+			event.ProcessContext = &ProcessContext{Process: Process{FileEvent: test.process}}
+
+			if value := evaluator.Eval(eval.NewContext(event)).(bool); value != test.expected {
+				t.Errorf("expected `unlink.is_self_delete` to be %v, got %v", test.expected, value)
+			}
+		})
+	}
+
+	t.Run("no-process-context", func(t *testing.T) {
+		event := newTestEvent(FileUnlinkEventType, &testFieldHandlers{})
+		event.Unlink.File = binary
+
+		if value := evaluator.Eval(eval.NewContext(event)).(bool); value {
+			t.Error("expected `unlink.is_self_delete` to be false without process context")
+		}
+	})
+}
+
+func TestCredentialsMismatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		credentials Credentials
+		uidMismatch bool
+		gidMismatch bool
+	}{
+		{
+			name:        "matching",
+			credentials: Credentials{UID: 1000, EUID: 1000, GID: 1000, EGID: 1000},
+		},
+		{
+			name:        "setuid",
+			credentials: Credentials{UID: 1000, EUID: 0, GID: 1000, EGID: 1000},
+			uidMismatch: true,
+		},
+		{
+			name:        "setgid",
+			credentials: Credentials{UID: 1000, EUID: 1000, GID: 1000, EGID: 42},
+			gidMismatch: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := newTestEvent(ExecEventType, &testFieldHandlers{})
+			event.Exec.Process = &Process{Credentials: test.credentials}
+			event.ProcessContext = &ProcessContext{
+				Process: Process{Credentials: test.credentials},
+				Ancestor: &ProcessCacheEntry{
+					ProcessContext: ProcessContext{Process: Process{Credentials: test.credentials}},
+				},
+			}
+
+			for field, expected := range map[string]bool{
+				"exec.uid_mismatch":    test.uidMismatch,
+				"exec.gid_mismatch":    test.gidMismatch,
+				"process.uid_mismatch": test.uidMismatch,
+				"process.gid_mismatch": test.gidMismatch,
+			} {
+				value, err := event.GetFieldValue(field)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value != expected {
+					t.Errorf("expected `%s` to be %v, got %v", field, expected, value)
+				}
+			}
+
+			rule, err := eval.NewRule("id", `process.ancestors.uid_mismatch == true`, ast.NewParsingContext(false), &eval.Opts{Constants: SECLConstants()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != test.uidMismatch {
+				t.Errorf("expected the ancestors rule to be %v, got %v", test.uidMismatch, result)
+			}
+		})
+	}
+}
+
+func TestSetFieldNativeValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		field    string
+		value    interface{}
+		expected int
+	}{
+		{name: "uint32-uid", field: "process.uid", value: uint32(1000), expected: 1000},
+		{name: "uint16-mode", field: "chmod.file.destination.mode", value: uint16(0o4755), expected: 0o4755},
+		{name: "uint64-inode", field: "open.file.inode", value: uint64(42), expected: 42},
+		{name: "int64-chown-uid", field: "chown.file.destination.uid", value: int64(-1), expected: -1},
+		{name: "int32-pid", field: "process.pid", value: int32(1), expected: 1},
+		{name: "int", field: "process.uid", value: 0, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			event := NewFakeEvent()
+			if err := event.SetFieldNativeValue(test.field, test.value); err != nil {
+				t.Fatal(err)
+			}
+
+			value, err := event.GetFieldValue(test.field)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != test.expected {
+				t.Errorf("expected `%s` to be %d, got %v", test.field, test.expected, value)
+			}
+
+			// the strict setter only accepts an int
+			var typeMismatchError *eval.ErrValueTypeMismatch
+			if _, isInt := test.value.(int); !isInt && !errors.As(event.SetFieldValue(test.field, test.value), &typeMismatchError) {
+				t.Errorf("expected a type mismatch error for %T", test.value)
+			}
+		})
+	}
+
+	t.Run("out-of-range", func(t *testing.T) {
+		var outOfRangeError *eval.ErrValueOutOfRange
+		if err := NewFakeEvent().SetFieldNativeValue("exec.file.mode", uint32(math.MaxUint16+1)); !errors.As(err, &outOfRangeError) {
+			t.Errorf("expected an out of range error, got %v", err)
+		}
+		if err := NewFakeEvent().SetFieldNativeValue("process.uid", int64(-1)); !errors.As(err, &outOfRangeError) {
+			t.Errorf("expected an out of range error, got %v", err)
+		}
+	})
+
+	t.Run("type-mismatch", func(t *testing.T) {
+		var typeMismatchError *eval.ErrValueTypeMismatch
+		if err := NewFakeEvent().SetFieldNativeValue("process.comm", uint32(1)); !errors.As(err, &typeMismatchError) {
+			t.Errorf("expected a type mismatch error, got %v", err)
+		}
+	})
+}
+
+func TestSetFieldValueOutOfRange(t *testing.T) {
+	tests := []struct {
+		field      string
+		value      int
+		outOfRange bool
+	}{
+		{field: "process.uid", value: -1, outOfRange: true},
+		{field: "process.uid", value: math.MaxUint32 + 1, outOfRange: true},
+		{field: "process.uid", value: math.MaxUint32},
+		{field: "exec.file.mode", value: -1, outOfRange: true},
+		{field: "chmod.file.destination.mode", value: -1, outOfRange: true},
+		{field: "chmod.file.destination.mode", value: 0o4755},
+		{field: "open.file.inode", value: -1, outOfRange: true},
+		{field: "unlink.file.inode", value: -1, outOfRange: true},
+		{field: "unlink.file.inode", value: math.MaxInt64},
+		// the chown uid is signed, -1 meaning that the owner is left unchanged
+		{field: "chown.file.destination.uid", value: -1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.field+"="+strconv.Itoa(test.value), func(t *testing.T) {
+			event := NewFakeEvent()
+
+			var outOfRangeError *eval.ErrValueOutOfRange
+			err := event.SetFieldValue(test.field, test.value)
+			if test.outOfRange {
+				if !errors.As(err, &outOfRangeError) {
+					t.Fatalf("expected an out of range error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			value, err := event.GetFieldValue(test.field)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != test.value {
+				t.Errorf("expected `%s` to be %d, got %v", test.field, test.value, value)
+			}
+		})
+	}
+}
+
+func TestGetFieldIntKind(t *testing.T) {
+	event := NewFakeEvent()
+
+	for field, kind := range map[eval.Field]reflect.Kind{
+		"bind.addr.family":           reflect.Uint16,
+		"process.uid":                reflect.Uint32,
+		"unlink.file.inode":          reflect.Uint64,
+		"chown.file.destination.uid": reflect.Int64,
+		"process.file.path.length":   reflect.Int,
+		"process.file.path":          reflect.Invalid,
+		"unknown.field":              reflect.Invalid,
+	} {
+		if got := event.GetFieldIntKind(field); got != kind {
+			t.Errorf("expected kind %s for `%s`, got %s", kind, field, got)
+		}
+	}
+}
+
+func TestProcessParent(t *testing.T) {
+	newEntry := func(pid uint32, comm string) *ProcessCacheEntry {
+		pce := NewProcessCacheEntry(nil)
+		pce.Pid = pid
+		pce.Comm = comm
+		pce.UID = pid
+		return pce
+	}
+
+	t.Run("parent", func(t *testing.T) {
+		grandParent := newEntry(1, "systemd")
+		parent := newEntry(42, "sshd")
+		parent.SetAncestor(grandParent)
+		child := newEntry(4242, "bash")
+		child.SetAncestor(parent)
+
+		event := NewFakeEvent()
+		event.Type = uint32(ExecEventType)
+		event.ProcessContext = &child.ProcessContext
+
+		for field, expected := range map[string]interface{}{
+			"process.parent.comm": "sshd",
+			"process.parent.pid":  42,
+			"process.parent.uid":  42,
+		} {
+			value, err := event.GetFieldValue(field)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if value != expected {
+				t.Errorf("expected `%s` to be %v, got %v", field, expected, value)
+			}
+		}
+
+		// the parent is the first generation of the ancestors
+		var it ProcessAncestorsIterator
+		if front := it.Front(eval.NewContext(event)); front == nil || &front.Process != event.ProcessContext.Parent {
+			t.Error("expected the parent to be the front of the ancestors")
+		}
+
+		for expr, expected := range map[string]bool{
+			`process.parent.comm == "sshd"`:       true,
+			`process.parent.comm == "systemd"`:    false,
+			`process.ancestors.comm == "systemd"`: true,
+		} {
+			rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != expected {
+				t.Errorf("expected `%s` to be %v, got %v", expr, expected, result)
+			}
+		}
+	})
+
+	t.Run("no-parent", func(t *testing.T) {
+		event := NewFakeEvent()
+		event.Type = uint32(ExecEventType)
+		event.ProcessContext = &newEntry(1, "systemd").ProcessContext
+
+		var notSupportedError *eval.ErrNotSupported
+		if _, err := event.GetFieldValue("process.parent.comm"); !errors.As(err, &notSupportedError) {
+			t.Errorf("expected a not supported error, got %v", err)
+		}
+
+		for expr, expected := range map[string]bool{
+			`process.parent.comm == ""`: true,
+			`process.parent.pid == 0`:   true,
+		} {
+			rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), (&eval.Opts{}).WithPredicates(SECLPredicates))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := rule.GenEvaluator(&Model{}); err != nil {
+				t.Fatal(err)
+			}
+			if result := rule.Eval(eval.NewContext(event)); result != expected {
+				t.Errorf("expected `%s` to be %v, got %v", expr, expected, result)
+			}
+		}
+	})
+
+	t.Run("setter", func(t *testing.T) {
+		event := NewFakeEvent()
+		if err := event.SetFieldValue("process.parent.comm", "sshd"); err != nil {
+			t.Fatal(err)
+		}
+		if event.ProcessContext.Parent == nil || event.ProcessContext.Parent.Comm != "sshd" {
+			t.Error("expected the parent to be set")
+		}
+		if event.ProcessContext.Ancestor != nil {
+			t.Error("expected the ancestors to be left untouched")
+		}
+	})
+}
+
+func TestSetFieldValueIndexedAncestors(t *testing.T) {
+	event := NewFakeEvent()
+	event.Type = uint32(ExecEventType)
+
+	for field, value := range map[string]interface{}{
+		"process.ancestors[2].comm": "systemd",
+		"process.ancestors[0].comm": "bash",
+		"process.ancestors[1].comm": "sshd",
+		"process.ancestors[1].pid":  1234,
+	} {
+		if err := event.SetFieldValue(field, value); err != nil {
+			t.Fatalf("failed to set `%s`: %v", field, err)
+		}
+	}
+
+	var (
+		it    ProcessAncestorsIterator
+		comms []string
+	)
+	ctx := eval.NewContext(event)
+	for pce := it.Front(ctx); pce != nil; pce = it.Next() {
+		comms = append(comms, pce.Comm)
+	}
+	if expected := []string{"bash", "sshd", "systemd"}; !slices.Equal(comms, expected) {
+		t.Errorf("expected the ancestors to be %v, got %v", expected, comms)
+	}
+
+	if pce := it.At(ctx, "test", 1); pce == nil || pce.Pid != 1234 {
+		t.Errorf("expected the pid of the second generation to be set, got %v", pce)
+	}
+
+	t.Run("non-indexed", func(t *testing.T) {
+		if err := event.SetFieldValue("process.ancestors.comm", "zsh"); err != nil {
+			t.Fatal(err)
+		}
+		if comm := event.ProcessContext.Ancestor.Comm; comm != "zsh" {
+			t.Errorf("expected the first generation to be set, got %s", comm)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var fieldNotFoundError *eval.ErrFieldNotFound
+		for _, field := range []string{"process.ancestors[-1].comm", "process.ancestors[a].comm", "process.ancestors[1]comm", "process.ancestors[1].unknown"} {
+			if err := event.SetFieldValue(field, "aaa"); !errors.As(err, &fieldNotFoundError) {
+				t.Errorf("expected a field not found error for `%s`, got %v", field, err)
+			}
+		}
+	})
+}
+
+func TestFileMountPath(t *testing.T) {
+	mountPaths := map[uint32]string{
+		0:  "/",
+		42: "/var/lib/docker/overlay2/8f2b7c1e/merged",
+	}
+
+	tests := []struct {
+		name     string
+		mountID  uint32
+		inode    uint64
+		expected string
+	}{
+		{
+			name:     "resolvable",
+			mountID:  42,
+			expected: "/var/lib/docker/overlay2/8f2b7c1e/merged",
+		},
+		{
+			name:     "unresolvable",
+			mountID:  1337,
+			expected: "",
+		},
+		{
+			name:     "fileless",
+			inode:    1234,
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			for _, eventType := range []EventType{FileOpenEventType, FileChmodEventType} {
+				event := newTestEvent(eventType, &testFieldHandlers{mountPaths: mountPaths})
+				event.Open.File.MountID = test.mountID
+				event.Open.File.Inode = test.inode
+				event.Chmod.File.MountID = test.mountID
+				event.Chmod.File.Inode = test.inode
+
+				field := eventType.String() + ".file.mount_path"
+				evaluator, err := (&Model{}).GetEvaluator(field, "")
+				if err != nil {
+					t.Fatal(err)
+				}
+				if value := evaluator.Eval(eval.NewContext(event)).(string); value != test.expected {
+					t.Errorf("expected `%s` to be `%s`, got `%s`", field, test.expected, value)
+				}
+			}
+		})
+	}
+}
+
+func TestLengthFieldChecks(t *testing.T) {
+	event := NewFakeEvent()
+	event.Init()
+	event.ProcessContext.Parent = nil
+
+	// the length fields share the checks of the field they measure, the event having neither parent nor interpreter
+	for _, field := range []eval.Field{"process.parent.file.path.length", "exec.interpreter.file.name.length"} {
+		var notSupported *eval.ErrNotSupported
+		if _, err := event.GetFieldValue(field); !errors.As(err, &notSupported) {
+			t.Errorf("expected `%s` not to be supported, got: %v", field, err)
+		}
+
+		evaluator, err := (&Model{}).GetEvaluator(field, "")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value := evaluator.Eval(eval.NewContext(event)); value != 0 {
+			t.Errorf("expected `%s` to be 0, got: %v", field, value)
+		}
+	}
+}
+
+// desyncedEvent is an event whose metadata getter misses a field, as if generated separately
+type desyncedEvent struct {
+	*Event
+	missing eval.Field
+}
+
+func (ev *desyncedEvent) GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kind, error) {
+	if field == ev.missing {
+		return "", reflect.Invalid, &eval.ErrFieldNotFound{Field: field}
+	}
+	return ev.Event.GetFieldMetadata(field)
+}
+
+func TestModelValidate(t *testing.T) {
+	if err := (&Model{}).Validate(); err != nil {
+		t.Fatalf("expected the model to be consistent, got: %v", err)
+	}
+
+	event := NewFakeEvent()
+	event.Init()
+	desynced := &desyncedEvent{Event: event, missing: "open.file.path"}
+
+	err := validateAccessors(&Model{}, desynced, []eval.Field{"open.file.name", "open.file.path", "exec.file.path"})
+	if err == nil {
+		t.Fatal("expected the desynced field to be reported")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "`open.file.path` not handled by the metadata getter") || strings.Contains(msg, "open.file.name") || strings.Contains(msg, "exec.file.path") {
+		t.Errorf("expected only `open.file.path` to be reported, got: %v", err)
+	}
+}
@@ -120,7 +120,9 @@ func (cg *CGroupContext) Merge(cg2 *CGroupContext) {
 
 // SyscallEvent contains common fields for all the event
 type SyscallEvent struct {
-	Retval int64 `field:"retval"` // SECLDoc[retval] Definition:`Return value of the syscall` Constants:`Error constants`
+	Retval    int64 `field:"retval"`                                    // SECLDoc[retval] Definition:`Return value of the syscall` Constants:`Error constants`
+	Succeeded bool  `field:"succeeded,handler:ResolveSyscallSucceeded"` // SECLDoc[succeeded] Definition:`Indicates whether the syscall succeeded, based on the sign of its return value`
+	Failed    bool  `field:"failed,handler:ResolveSyscallFailed"`       // SECLDoc[failed] Definition:`Indicates whether the syscall failed, based on the sign of its return value`
 }
 
 // SyscallContext contains syscall context
@@ -154,11 +156,12 @@ type ChmodEvent struct {
 type ChownEvent struct {
 	SyscallEvent
 	SyscallContext
-	File  FileEvent `field:"file"`
-	UID   int64     `field:"file.destination.uid"`                           // SECLDoc[file.destination.uid] Definition:`New UID of the chown-ed file's owner`
-	User  string    `field:"file.destination.user,handler:ResolveChownUID"`  // SECLDoc[file.destination.user] Definition:`New user of the chown-ed file's owner`
-	GID   int64     `field:"file.destination.gid"`                           // SECLDoc[file.destination.gid] Definition:`New GID of the chown-ed file's owner`
-	Group string    `field:"file.destination.group,handler:ResolveChownGID"` // SECLDoc[file.destination.group] Definition:`New group of the chown-ed file's owner`
+	File   FileEvent `field:"file"`
+	UID    int64     `field:"file.destination.uid"`                           // SECLDoc[file.destination.uid] Definition:`New UID of the chown-ed file's owner`
+	User   string    `field:"file.destination.user,handler:ResolveChownUID"`  // SECLDoc[file.destination.user] Definition:`New user of the chown-ed file's owner`
+	GID    int64     `field:"file.destination.gid"`                           // SECLDoc[file.destination.gid] Definition:`New GID of the chown-ed file's owner`
+	Group  string    `field:"file.destination.group,handler:ResolveChownGID"` // SECLDoc[file.destination.group] Definition:`New group of the chown-ed file's owner`
+	ToRoot bool      `field:"to_root,handler:ResolveChownToRoot"`             // SECLDoc[to_root] Definition:`Indicates whether the file is chown-ed to the root user or the root group`
 
 	// Syscall context aliases
 	SyscallPath string `field:"syscall.path,ref:chown.syscall.str1"` // SECLDoc[syscall.path] Definition:`Path argument of the syscall`
@@ -174,6 +177,9 @@ type SetuidEvent struct {
 	EUser  string `field:"euser,handler:ResolveSetuidEUser"`   // SECLDoc[euser] Definition:`New effective user of the process`
 	FSUID  uint32 `field:"fsuid"`                              // SECLDoc[fsuid] Definition:`New FileSystem UID of the process`
 	FSUser string `field:"fsuser,handler:ResolveSetuidFSUser"` // SECLDoc[fsuser] Definition:`New FileSystem user of the process`
+
+	PreviousUID uint32 `field:"previous_uid"`                         // SECLDoc[previous_uid] Definition:`UID of the process before the call`
+	Changed     bool   `field:"changed,handler:ResolveSetuidChanged"` // SECLDoc[changed] Definition:`Indicates whether the call changed the UID of the process` Example:`setuid.changed && setuid.uid == 0` Description:`Matches the processes actually switching to root, ignoring the calls keeping the same UID.`
 }
 
 // SetgidEvent represents a setgid event
@@ -184,6 +190,9 @@ type SetgidEvent struct {
 	EGroup  string `field:"egroup,handler:ResolveSetgidEGroup"`   // SECLDoc[egroup] Definition:`New effective group of the process`
 	FSGID   uint32 `field:"fsgid"`                                // SECLDoc[fsgid] Definition:`New FileSystem GID of the process`
 	FSGroup string `field:"fsgroup,handler:ResolveSetgidFSGroup"` // SECLDoc[fsgroup] Definition:`New FileSystem group of the process`
+
+	PreviousGID uint32 `field:"previous_gid"`                         // SECLDoc[previous_gid] Definition:`GID of the process before the call`
+	Changed     bool   `field:"changed,handler:ResolveSetgidChanged"` // SECLDoc[changed] Definition:`Indicates whether the call changed the GID of the process`
 }
 
 // CapsetEvent represents a capset event
@@ -194,25 +203,29 @@ type CapsetEvent struct {
 
 // Credentials represents the kernel credentials of a process
 type Credentials struct {
-	UID   uint32 `field:"uid"`   // SECLDoc[uid] Definition:`UID of the process`
-	GID   uint32 `field:"gid"`   // SECLDoc[gid] Definition:`GID of the process`
-	User  string `field:"user"`  // SECLDoc[user] Definition:`User of the process` Example:`process.user == "root"` Description:`Constrain an event to be triggered by a process running as the root user.`
-	Group string `field:"group"` // SECLDoc[group] Definition:`Group of the process`
+	UID   uint32 `field:"uid"`                                   // SECLDoc[uid] Definition:`UID of the process`
+	GID   uint32 `field:"gid"`                                   // SECLDoc[gid] Definition:`GID of the process`
+	User  string `field:"user,handler:ResolveCredentialsUser"`   // SECLDoc[user] Definition:`User of the process` Example:`process.user == "root"` Description:`Constrain an event to be triggered by a process running as the root user.`
+	Group string `field:"group,handler:ResolveCredentialsGroup"` // SECLDoc[group] Definition:`Group of the process`
+
+	EUID   uint32 `field:"euid"`                                    // SECLDoc[euid] Definition:`Effective UID of the process`
+	EGID   uint32 `field:"egid"`                                    // SECLDoc[egid] Definition:`Effective GID of the process`
+	EUser  string `field:"euser,handler:ResolveCredentialsEUser"`   // SECLDoc[euser] Definition:`Effective user of the process`
+	EGroup string `field:"egroup,handler:ResolveCredentialsEGroup"` // SECLDoc[egroup] Definition:`Effective group of the process`
 
-	EUID   uint32 `field:"euid"`   // SECLDoc[euid] Definition:`Effective UID of the process`
-	EGID   uint32 `field:"egid"`   // SECLDoc[egid] Definition:`Effective GID of the process`
-	EUser  string `field:"euser"`  // SECLDoc[euser] Definition:`Effective user of the process`
-	EGroup string `field:"egroup"` // SECLDoc[egroup] Definition:`Effective group of the process`
+	UIDMismatch bool `field:"uid_mismatch,handler:ResolveCredentialsUIDMismatch"` // SECLDoc[uid_mismatch] Definition:`Indicates whether the effective UID of the process differs from its real UID, as in a setuid context` Example:`process.uid_mismatch && process.euid == 0` Description:`Matches the events of a process running with root effective privileges granted by a setuid binary.`
+	GIDMismatch bool `field:"gid_mismatch,handler:ResolveCredentialsGIDMismatch"` // SECLDoc[gid_mismatch] Definition:`Indicates whether the effective GID of the process differs from its real GID, as in a setgid context`
 
-	FSUID   uint32 `field:"fsuid"`   // SECLDoc[fsuid] Definition:`FileSystem-uid of the process`
-	FSGID   uint32 `field:"fsgid"`   // SECLDoc[fsgid] Definition:`FileSystem-gid of the process`
-	FSUser  string `field:"fsuser"`  // SECLDoc[fsuser] Definition:`FileSystem-user of the process`
-	FSGroup string `field:"fsgroup"` // SECLDoc[fsgroup] Definition:`FileSystem-group of the process`
+	FSUID   uint32 `field:"fsuid"`                                     // SECLDoc[fsuid] Definition:`FileSystem-uid of the process`
+	FSGID   uint32 `field:"fsgid"`                                     // SECLDoc[fsgid] Definition:`FileSystem-gid of the process`
+	FSUser  string `field:"fsuser,handler:ResolveCredentialsFSUser"`   // SECLDoc[fsuser] Definition:`FileSystem-user of the process`
+	FSGroup string `field:"fsgroup,handler:ResolveCredentialsFSGroup"` // SECLDoc[fsgroup] Definition:`FileSystem-group of the process`
 
 	AUID uint32 `field:"auid"` // SECLDoc[auid] Definition:`Login UID of the process`
 
 	CapEffective uint64 `field:"cap_effective"` // SECLDoc[cap_effective] Definition:`Effective capability set of the process` Constants:`Kernel Capability constants`
 	CapPermitted uint64 `field:"cap_permitted"` // SECLDoc[cap_permitted] Definition:`Permitted capability set of the process` Constants:`Kernel Capability constants`
+	CapAmbient   uint64 `field:"cap_ambient"`   // SECLDoc[cap_ambient] Definition:`Ambient capability set of the process, the capabilities kept across the execution of non privileged binaries` Constants:`Kernel Capability constants` Example:`exec.cap_ambient & CAP_NET_RAW > 0` Description:`Matches the execution of a process keeping CAP_NET_RAW across exec through the ambient set.`
 }
 
 // LinuxBinprm contains content from the linux_binprm struct, which holds the arguments used for loading binaries
@@ -224,7 +237,17 @@ type LinuxBinprm struct {
 type Process struct {
 	PIDContext
 
-	FileEvent FileEvent `field:"file,check:IsNotKworker"`
+	FileEvent            FileEvent `field:"file,check:IsNotKworker"`
+	FileMD5              string    `field:"file.md5,handler:ResolveProcessFileMD5,check:IsNotKworker"`                            // SECLDoc[file.md5] Definition:`MD5 hash of the process executable, when resolved` Example:`exec.file.md5 in ["d41d8cd98f00b204e9800998ecf8427e"]` Description:`Matches the execution of a file with a known MD5 hash.`
+	FileSHA256           string    `field:"file.sha256,handler:ResolveProcessFileSHA256,check:IsNotKworker"`                      // SECLDoc[file.sha256] Definition:`SHA256 hash of the process executable, when resolved`
+	FileMemfd            bool      `field:"file.is_memfd,handler:ResolveProcessFileIsMemfd,check:IsNotKworker"`                   // SECLDoc[file.is_memfd] Definition:`Indicates whether the process executable is a memfd or an anonymous file, i.e. a fileless execution` Example:`exec.file.is_memfd` Description:`Matches the execution of a binary loaded in memory with memfd_create.`
+	FileHostPath         bool      `field:"file.is_host_path,handler:ResolveProcessFileIsHostPath,check:IsNotKworker"`            // SECLDoc[file.is_host_path] Definition:`Indicates whether the process executable resides on a host filesystem rather than in a container overlay layer` Example:`exec.file.is_host_path && container.id != ""` Description:`Matches the execution, from a container, of a binary coming from the host filesystem.`
+	FileSetuid           bool      `field:"file.is_setuid,handler:ResolveProcessFileIsSetuid,check:IsNotKworker"`                 // SECLDoc[file.is_setuid] Definition:`Indicates whether the process executable has the setuid bit set` Example:`exec.file.is_setuid && process.uid != 0` Description:`Matches the execution of a setuid binary by a non root user.`
+	FileSetgid           bool      `field:"file.is_setgid,handler:ResolveProcessFileIsSetgid,check:IsNotKworker"`                 // SECLDoc[file.is_setgid] Definition:`Indicates whether the process executable has the setgid bit set`
+	FileDeleted          bool      `field:"file.is_deleted,handler:ResolveProcessFileIsDeleted,check:IsNotKworker"`               // SECLDoc[file.is_deleted] Definition:`Indicates whether the process executable was deleted from the disk` Example:`process.file.is_deleted` Description:`Matches the events of a process running from a binary that was deleted after its execution.`
+	FileInterpreterClass string    `field:"file.interpreter_class,handler:ResolveProcessFileInterpreterClass,check:IsNotKworker"` // SECLDoc[file.interpreter_class] Definition:`Class of the process executable when it's a well-known interpreter, one of shell, scripting, package_manager, or none` Example:`exec.file.interpreter_class == "shell" && process.parent.file.interpreter_class == "package_manager"` Description:`Matches a shell spawned by a package manager, as done by malicious install scripts.`
+	FileNameEntropy      float64   `field:"file.name_entropy,handler:ResolveProcessFileNameEntropy,check:IsNotKworker"`           // SECLDoc[file.name_entropy] Definition:`Shannon entropy of the basename of the process executable, in bits per character` Example:`exec.file.name_entropy > 3.5 && exec.file.path =~ "/tmp/*"` Description:`Matches the execution from /tmp of a binary with a randomly generated name.`
+	FileLayerID          string    `field:"file.layer_id,handler:ResolveProcessFileLayerID,check:IsNotKworker"`                   // SECLDoc[file.layer_id] Definition:`ID of the overlay layer providing the process executable, as named by the container storage driver, empty if the executable isn't on an overlay filesystem` Example:`exec.file.layer_id == "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"` Description:`Matches the execution of a binary coming from a known image layer.`
 
 	CGroup      CGroupContext              `field:"cgroup"`                                         // SECLDoc[cgroup] Definition:`CGroup`
 	ContainerID containerutils.ContainerID `field:"container.id,handler:ResolveProcessContainerID"` // SECLDoc[container.id] Definition:`Container ID`
@@ -232,9 +255,15 @@ type Process struct {
 	SpanID  uint64          `field:"-"`
 	TraceID mathutil.Int128 `field:"-"`
 
-	TTYName     string      `field:"tty_name"`                         // SECLDoc[tty_name] Definition:`Name of the TTY associated with the process`
-	Comm        string      `field:"comm"`                             // SECLDoc[comm] Definition:`Comm attribute of the process`
-	LinuxBinprm LinuxBinprm `field:"interpreter,check:HasInterpreter"` // Script interpreter as identified by the shebang
+	TTYName           string      `field:"tty_name"`                                                    // SECLDoc[tty_name] Definition:`Name of the TTY associated with the process`
+	TTYSessionType    string      `field:"session_type,handler:ResolveProcessSessionType"`              // SECLDoc[session_type] Definition:`Type of the interactive session of the process derived from its TTY, one of "console", "pts", "ssh" or "none"` Example:`exec.session_type == "ssh" && exec.file.name == "sudo"` Description:`Matches the executions of sudo from an SSH session.`
+	Comm              string      `field:"comm"`                                                        // SECLDoc[comm] Definition:`Comm attribute of the process, limited to 15 characters by the kernel`
+	CommTruncated     bool        `field:"comm_truncated,handler:ResolveProcessCommTruncated"`          // SECLDoc[comm_truncated] Definition:`Indicates whether the comm attribute of the process may have been truncated to the 15 characters kernel limit`
+	CommMatchesBinary bool        `field:"comm_matches_binary,handler:ResolveProcessCommMatchesBinary"` // SECLDoc[comm_matches_binary] Definition:`Indicates whether the comm attribute of the process matches the basename of its executable, accounting for the kernel truncation` Example:`exec.comm_matches_binary == false` Description:`Matches the execution of a process whose comm differs from its binary name.`
+	IsAgent           bool        `field:"is_agent,handler:ResolveProcessIsAgent"`                      // SECLDoc[is_agent] Definition:`Indicates whether the process is the agent itself` Example:`open.file.path == "/etc/shadow" && !process.is_agent` Description:`Matches the opening of /etc/shadow by any process other than the agent.`
+	Cwd               string      `field:"cwd,handler:ResolveProcessCwd"`                               // SECLDoc[cwd] Definition:`Working directory of the process, as captured at its last exec` Example:`exec.file.name == "sh" && exec.cwd == "/tmp"` Description:`Matches a shell launched from /tmp.`
+	CwdPathKey        PathKey     `field:"-"`                                                           // path key of the working directory captured at exec
+	LinuxBinprm       LinuxBinprm `field:"interpreter,check:HasInterpreter"`                            // Script interpreter as identified by the shebang
 
 	// pid_cache_t
 	ForkTime time.Time `field:"fork_time,opts:getters_only"`
@@ -246,6 +275,7 @@ type Process struct {
 
 	Cookie uint64 `field:"-"`
 	PPid   uint32 `field:"ppid"` // SECLDoc[ppid] Definition:`Parent process ID`
+	NSPid  uint32 `field:"-"`    // pid of the process in its own pid namespace, 0 when not resolved yet
 
 	// credentials_t section of pid_cache_t
 	Credentials
@@ -265,10 +295,17 @@ type Process struct {
 	Args          string   `field:"args,handler:ResolveProcessArgs,weight:500,opts:skip_ad"`                                                                                                                                                                 // SECLDoc[args] Definition:`Arguments of the process (as a string, excluding argv0)` Example:`exec.args == "-sV -p 22,53,110,143,4564 198.116.0-255.1-127"` Description:`Matches any process with these exact arguments.` Example:`exec.args =~ "* -F * http*"` Description:`Matches any process that has the "-F" argument anywhere before an argument starting with "http".`
 	Argv          []string `field:"argv,handler:ResolveProcessArgv,weight:500; cmdargv,handler:ResolveProcessCmdArgv,opts:getters_only; args_flags,handler:ResolveProcessArgsFlags,opts:helper; args_options,handler:ResolveProcessArgsOptions,opts:helper"` // SECLDoc[argv] Definition:`Arguments of the process (as an array, excluding argv0)` Example:`exec.argv in ["127.0.0.1"]` Description:`Matches any process that has this IP address as one of its arguments.` SECLDoc[args_flags] Definition:`Flags in the process arguments` Example:`exec.args_flags in ["s"] && exec.args_flags in ["V"]` Description:`Matches any process with both "-s" and "-V" flags in its arguments. Also matches "-sV".` SECLDoc[args_options] Definition:`Argument of the process as options` Example:`exec.args_options in ["p=0-1024"]` Description:`Matches any process that has either "-p 0-1024" or "--p=0-1024" in its arguments.`
 	ArgsTruncated bool     `field:"args_truncated,handler:ResolveProcessArgsTruncated"`                                                                                                                                                                      // SECLDoc[args_truncated] Definition:`Indicator of arguments truncation`
-	Envs          []string `field:"envs,handler:ResolveProcessEnvs,weight:100"`                                                                                                                                                                              // SECLDoc[envs] Definition:`Environment variable names of the process`
+	Envs          []string `field:"envs,handler:ResolveProcessEnvs,weight:100,opts:length"`                                                                                                                                                                  // SECLDoc[envs] Definition:`Environment variable names of the process`
 	Envp          []string `field:"envp,handler:ResolveProcessEnvp,weight:100"`                                                                                                                                                                              // SECLDoc[envp] Definition:`Environment variables of the process`
 	EnvsTruncated bool     `field:"envs_truncated,handler:ResolveProcessEnvsTruncated"`                                                                                                                                                                      // SECLDoc[envs_truncated] Definition:`Indicator of environment variables truncation`
 
+	EnvsHasSecretLike     bool `field:"envs.has_secret_like,handler:ResolveProcessEnvsHasSecretLike"`         // SECLDoc[envs.has_secret_like] Definition:`Indicates whether the name of one of the environment variables of the process looks like a credential, for example *_TOKEN or *_SECRET`
+	ArgsHasShellMetachars bool `field:"args.has_shell_metachars,handler:ResolveProcessArgsHasShellMetachars"` // SECLDoc[args.has_shell_metachars] Definition:`Indicates whether one of the arguments of the process contains a shell metacharacter, for example a backtick, $( or ;` Example:`exec.args.has_shell_metachars && exec.file.name == "curl"` Description:`Matches curl executions whose arguments look like a command injection payload.`
+	ArgsDistinctCount     int  `field:"args.distinct_count,handler:ResolveProcessArgsDistinctCount"`          // SECLDoc[args.distinct_count] Definition:`Number of distinct arguments of the process, argv0 excluded` Example:`exec.file.name == "curl" && exec.args.distinct_count > 50` Description:`Matches curl executions with an unusually high number of distinct arguments.`
+	EnvsDistinctCount     int  `field:"envs.distinct_count,handler:ResolveProcessEnvsDistinctCount"`          // SECLDoc[envs.distinct_count] Definition:`Number of distinct environment variable names of the process`
+
+	CmdLine string `field:"cmdline,handler:ResolveProcessCmdLine,weight:500,opts:skip_ad"` // SECLDoc[cmdline] Definition:`Command line of the process, argv0 followed by the arguments, truncated when too long` Example:`process.ancestors.cmdline =~ "*curl * | sh*"` Description:`Matches any process having an ancestor whose command line pipes curl into a shell.`
+
 	ArgsScrubbed string   `field:"args_scrubbed,handler:ResolveProcessArgsScrubbed,opts:getters_only"`
 	ArgvScrubbed []string `field:"argv_scrubbed,handler:ResolveProcessArgvScrubbed,opts:getters_only"`
 
@@ -283,6 +320,7 @@ type Process struct {
 	// IsThread is the negation of IsExec and should be manipulated directly
 	IsThread        bool `field:"is_thread,handler:ResolveProcessIsThread"` // SECLDoc[is_thread] Definition:`Indicates whether the process is considered a thread (that is, a child process that hasn't executed another program)`
 	IsExec          bool `field:"is_exec"`                                  // SECLDoc[is_exec] Definition:`Indicates whether the process entry is from a new binary execution`
+	IsInit          bool `field:"is_init,handler:ResolveProcessIsInit"`     // SECLDoc[is_init] Definition:`Indicates whether the process is the init process of its pid namespace, that is the host init or the entrypoint of a container` Example:`process.parent.is_init && process.container.id != ""` Description:`Matches any process spawned directly by the entrypoint of a container.`
 	IsExecExec      bool `field:"-"`                                        // Indicates whether the process is an exec following another exec
 	IsParentMissing bool `field:"-"`                                        // Indicates the direct parent is missing
 
@@ -313,12 +351,17 @@ type FileFields struct {
 	MTime uint64 `field:"modification_time"`                             // SECLDoc[modification_time] Definition:`Modification time (mtime) of the file`
 
 	PathKey
+	ID     string `field:"id,handler:ResolveFileFieldsID"` // SECLDoc[id] Definition:`Identifier of the file, combining its mount ID and inode so that it is unique across mounts`
 	Device uint32 `field:"-"`
 
 	InUpperLayer bool `field:"in_upper_layer,handler:ResolveFileFieldsInUpperLayer"` // SECLDoc[in_upper_layer] Definition:`Indicator of the file layer, for example, in an OverlayFS`
 
-	NLink uint32 `field:"-"`
-	Flags int32  `field:"-"`
+	NLink   uint32 `field:"-"`
+	Flags   int32  `field:"-"`
+	Deleted bool   `field:"-"`
+
+	IsUserResolved  bool `field:"-"`
+	IsGroupResolved bool `field:"-"`
 }
 
 // FileEvent is the common file event type
@@ -329,10 +372,20 @@ type FileEvent struct {
 	BasenameStr string `field:"name,handler:ResolveFileBasename,opts:length" op_override:"ProcessSymlinkBasename"` // SECLDoc[name] Definition:`File's basename` Example:`exec.file.name == "apt"` Description:`Matches the execution of any file named apt.`
 	Filesystem  string `field:"filesystem,handler:ResolveFileFilesystem"`                                          // SECLDoc[filesystem] Definition:`File's filesystem`
 
-	MountPath   string `field:"-"`
+	ResolvedPathnameStr string `field:"resolved_path,handler:ResolveFileResolvedPath,opts:length"`   // SECLDoc[resolved_path] Definition:`File's path with all its symlinks resolved, the requested path if it can't be resolved` Example:`open.file.resolved_path == "/etc/passwd"` Description:`Matches any process opening the /etc/passwd file, directly or through a symlink.`
+	ParentPathStr       string `field:"parent_path,handler:ResolveFileParentPath"`                   // SECLDoc[parent_path] Definition:`Path of the directory containing the file` Example:`rename.file.destination.parent_path == "/etc/cron.d"` Description:`Matches any file moved into the cron directory.`
+	Depth               int    `field:"depth,handler:ResolveFileDepth"`                              // SECLDoc[depth] Definition:`Number of segments of the file's path` Example:`mkdir.file.depth > 10` Description:`Matches the creation of deeply nested directories.`
+	IsCritical          bool   `field:"is_critical,handler:ResolveFileIsCritical"`                   // SECLDoc[is_critical] Definition:`Indicates whether the file's path is one of the registered critical paths, like /etc/passwd or /etc/shadow` Example:`chmod.file.is_critical` Description:`Matches any change of the permissions of a critical file.`
+	IsLog               bool   `field:"is_log,handler:ResolveFileIsLog"`                             // SECLDoc[is_log] Definition:`Indicates whether the file is located below one of the registered log directories, like /var/log or the journald directories` Example:`unlink.file.is_log` Description:`Matches the deletion of a log file, a common anti-forensics step.`
+	PathIsValidUTF8     bool   `field:"path_is_valid_utf8,handler:ResolveFilePathIsValidUTF8"`       // SECLDoc[path_is_valid_utf8] Definition:`Indicates whether the file's path is valid UTF-8 and free of control characters` Example:`open.file.path_is_valid_utf8 == false` Description:`Matches the files opened with a path containing invalid UTF-8 sequences or control characters, which can be used to evade detection.`
+	SanitizedPathStr    string `field:"sanitized_path,handler:ResolveFileSanitizedPath,opts:length"` // SECLDoc[sanitized_path] Definition:`File's path with its invalid UTF-8 bytes and control characters escaped`
+
+	MountPath   string `field:"mount_path,handler:ResolveFileMountPath"` // SECLDoc[mount_path] Definition:`Path of the mount point of the file's mount, empty if it can't be resolved`
 	MountSource uint32 `field:"-"`
 	MountOrigin uint32 `field:"-"`
 
+	LayerID string `field:"-"` // ID of the overlay layer providing the file, set when resolved
+
 	PathResolutionError error `field:"-"`
 
 	PkgName       string `field:"package.name,handler:ResolvePackageName"`                    // SECLDoc[package.name] Definition:`[Experimental] Name of the package that provided this file`
@@ -345,6 +398,7 @@ type FileEvent struct {
 	// used to mark as already resolved, can be used in case of empty path
 	IsPathnameStrResolved bool `field:"-"`
 	IsBasenameStrResolved bool `field:"-"`
+	IsLayerIDResolved     bool `field:"-"`
 }
 
 // InvalidateDentryEvent defines a invalidate dentry event
@@ -365,6 +419,14 @@ type LinkEvent struct {
 	Source FileEvent `field:"file"`
 	Target FileEvent `field:"file.destination"`
 
+	DestinationContainsDotDot bool `field:"file.destination.contains_dotdot,handler:ResolveLinkDestinationContainsDotDot,weight:900"` // SECLDoc[file.destination.contains_dotdot] Definition:`Indicates whether the destination path argument of the syscall contains ".." segments`
+	CrossMount                bool `field:"cross_mount,handler:ResolveLinkCrossMount"`                                                // SECLDoc[cross_mount] Definition:`Indicates whether the source and the destination files are on different mount points`
+	BasenameChanged           bool `field:"basename_changed,handler:ResolveLinkBasenameChanged"`                                      // SECLDoc[basename_changed] Definition:`Indicates whether the basename of the destination file differs from the basename of the source file`
+
+	IsSymbolic bool   `field:"-"`
+	Type       string `field:"type,handler:ResolveLinkType"` // SECLDoc[type] Definition:`Type of the link, either "hard" or "symbolic"`
+	TargetPath string `field:"target_path"`                  // SECLDoc[target_path] Definition:`Target stored in the symbolic link, empty for hard links`
+
 	// Syscall context aliases
 	SyscallPath            string `field:"syscall.path,ref:link.syscall.str1"`             // SECLDoc[syscall.path] Definition:`Path argument of the syscall`
 	SyscallDestinationPath string `field:"syscall.destination.path,ref:link.syscall.str2"` // SECLDoc[syscall.destination.path] Definition:`Destination path argument of the syscall`
@@ -442,6 +504,9 @@ type OpenEvent struct {
 	Flags uint32    `field:"flags"`                 // SECLDoc[flags] Definition:`Flags used when opening the file` Constants:`Open flags`
 	Mode  uint32    `field:"file.destination.mode"` // SECLDoc[file.destination.mode] Definition:`Mode of the created file` Constants:`File mode constants`
 
+	ForWriting bool `field:"is_write,handler:ResolveOpenIsWrite"`           // SECLDoc[is_write] Definition:`Indicates whether the file is opened for writing, i.e. with O_WRONLY, O_RDWR, O_APPEND or O_CREAT` Example:`open.is_write && open.file.path == "/etc/passwd"` Description:`Matches any process opening /etc/passwd for writing.`
+	Truncating bool `field:"is_truncating,handler:ResolveOpenIsTruncating"` // SECLDoc[is_truncating] Definition:`Indicates whether the file is truncated when opened, i.e. with O_TRUNC`
+
 	// Syscall context aliases
 	SyscallPath  string `field:"syscall.path,ref:open.syscall.str1"`  // SECLDoc[syscall.path] Definition:`Path argument of the syscall`
 	SyscallFlags uint32 `field:"syscall.flags,ref:open.syscall.int2"` // SECLDoc[syscall.flags] Definition:`Flags argument of the syscall`
@@ -460,13 +525,13 @@ type SELinuxEvent struct {
 
 // PIDContext holds the process context of a kernel event
 type PIDContext struct {
-	Pid       uint32 `field:"pid"` // SECLDoc[pid] Definition:`Process ID of the process (also called thread group ID)`
-	Tid       uint32 `field:"tid"` // SECLDoc[tid] Definition:`Thread ID of the thread`
-	NetNS     uint32 `field:"-"`
-	IsKworker bool   `field:"is_kworker"` // SECLDoc[is_kworker] Definition:`Indicates whether the process is a kworker`
-	ExecInode uint64 `field:"-"`          // used to track exec and event loss
-	// used for ebpfless
-	NSID uint64 `field:"-"`
+	Pid       uint32 `field:"pid"`                                       // SECLDoc[pid] Definition:`Process ID of the process (also called thread group ID)`
+	Tid       uint32 `field:"tid"`                                       // SECLDoc[tid] Definition:`Thread ID of the thread`
+	NetNS     uint32 `field:"net_ns,handler:ResolveProcessNetNamespace"` // SECLDoc[net_ns] Definition:`ID of the network namespace of the process, 0 when unknown`
+	MntNS     uint64 `field:"mnt_ns,handler:ResolveProcessMntNamespace"` // SECLDoc[mnt_ns] Definition:`ID of the mount namespace of the process, 0 when unknown` Example:`exec.mnt_ns != process.parent.mnt_ns` Description:`Matches the executions in a mount namespace different from the one of the parent process, as after an unshare or a setns.`
+	IsKworker bool   `field:"is_kworker"`                                // SECLDoc[is_kworker] Definition:`Indicates whether the process is a kworker`
+	ExecInode uint64 `field:"-"`                                         // used to track exec and event loss
+	NSID      uint64 `field:"pid_ns,handler:ResolveProcessPIDNamespace"` // SECLDoc[pid_ns] Definition:`ID of the PID namespace of the process, 0 when unknown`
 }
 
 // RenameEvent represents a rename event
@@ -476,6 +541,10 @@ type RenameEvent struct {
 	Old FileEvent `field:"file"`
 	New FileEvent `field:"file.destination"`
 
+	DestinationContainsDotDot bool `field:"file.destination.contains_dotdot,handler:ResolveRenameDestinationContainsDotDot,weight:900"` // SECLDoc[file.destination.contains_dotdot] Definition:`Indicates whether the destination path argument of the syscall contains ".." segments`
+	CrossMount                bool `field:"cross_mount,handler:ResolveRenameCrossMount"`                                                // SECLDoc[cross_mount] Definition:`Indicates whether the source and the destination files are on different mount points, in which case the syscall fails and tools like mv fall back to copying and deleting the file`
+	BasenameChanged           bool `field:"basename_changed,handler:ResolveRenameBasenameChanged"`                                      // SECLDoc[basename_changed] Definition:`Indicates whether the file was renamed rather than only moved to another directory, that is whether the basename of the destination file differs from the basename of the source file` Example:`rename.file.path =~ "/etc/cron.d/*" && rename.basename_changed` Description:`Matches the renaming of a cron file, the moves keeping the name being ignored.`
+
 	// Syscall context aliases
 	SyscallPath            string `field:"syscall.path,ref:rename.syscall.str1"`             // SECLDoc[syscall.path] Definition:`Path argument of the syscall`
 	SyscallDestinationPath string `field:"syscall.destination.path,ref:rename.syscall.str2"` // SECLDoc[syscall.destination.path] Definition:`Destination path argument of the syscall`
@@ -498,6 +567,9 @@ type SetXAttrEvent struct {
 	Namespace string    `field:"file.destination.namespace,handler:ResolveXAttrNamespace"` // SECLDoc[file.destination.namespace] Definition:`Namespace of the extended attribute`
 	Name      string    `field:"file.destination.name,handler:ResolveXAttrName"`           // SECLDoc[file.destination.name] Definition:`Name of the extended attribute`
 
+	IsSecurityLabel  bool `field:"file.destination.is_security_label,handler:ResolveXAttrIsSecurityLabel"`  // SECLDoc[file.destination.is_security_label] Definition:`Indicates whether the extended attribute is a security label (SELinux, AppArmor, Smack)`
+	AffectsIntegrity bool `field:"file.destination.affects_integrity,handler:ResolveXAttrAffectsIntegrity"` // SECLDoc[file.destination.affects_integrity] Definition:`Indicates whether the extended attribute holds integrity measurements or signatures (IMA, EVM)` Example:`removexattr.file.destination.affects_integrity` Description:`Matches the removal of the IMA or EVM extended attributes of a file.`
+
 	NameRaw [200]byte `field:"-"`
 }
 
@@ -505,8 +577,9 @@ type SetXAttrEvent struct {
 type UnlinkEvent struct {
 	SyscallEvent
 	SyscallContext
-	File  FileEvent `field:"file"`
-	Flags uint32    `field:"flags"` // SECLDoc[flags] Definition:`Flags of the unlink syscall` Constants:`Unlink flags`
+	File       FileEvent `field:"file"`
+	Flags      uint32    `field:"flags"`                                            // SECLDoc[flags] Definition:`Flags of the unlink syscall` Constants:`Unlink flags`
+	SelfDelete bool      `field:"is_self_delete,handler:ResolveUnlinkIsSelfDelete"` // SECLDoc[is_self_delete] Definition:`Indicates whether the process deletes its own executable` Example:`unlink.is_self_delete` Description:`Matches a process deleting its own binary, a common anti-forensics technique.`
 
 	// Syscall context aliases
 	SyscallDirFd uint64 `field:"syscall.dirfd,ref:unlink.syscall.int1"` // SECLDoc[syscall.dirfd] Definition:`Directory file descriptor argument of the syscall`
@@ -0,0 +1,60 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// fieldAccessors holds the event accessors generated, each one with its own switch over the fields
+type fieldAccessors interface {
+	GetFieldValue(field eval.Field) (interface{}, error)
+	GetFieldMetadata(field eval.Field) (eval.EventType, reflect.Kind, error)
+	SetFieldValue(field eval.Field, value interface{}) error
+}
+
+// Validate checks that each field of the model is handled by all the generated accessors, the evaluators, the value
+// getter and setter and the metadata getter, returning an error listing the fields missing from some of them. The
+// fields of the disabled event types are skipped.
+func (m *Model) Validate() error {
+	// allocate the nested members, the accessors expecting them
+	event := NewFakeEvent()
+	event.Init()
+
+	fields := slices.DeleteFunc(event.GetFields(), m.isFieldDisabled)
+	return validateAccessors(m, event, fields)
+}
+
+func validateAccessors(m eval.Model, ev fieldAccessors, fields []eval.Field) error {
+	var errs []error
+	var notFoundError *eval.ErrFieldNotFound
+
+	for _, field := range fields {
+		if _, err := m.GetEvaluator(field, ""); errors.As(err, &notFoundError) {
+			errs = append(errs, fmt.Errorf("`%s` not handled by the evaluators: %w", field, err))
+		}
+		if _, _, err := ev.GetFieldMetadata(field); errors.As(err, &notFoundError) {
+			errs = append(errs, fmt.Errorf("`%s` not handled by the metadata getter: %w", field, err))
+		}
+
+		// only the unknown fields are reported, the unsupported, read-only or mismatching values being handled
+		value, err := ev.GetFieldValue(field)
+		if errors.As(err, &notFoundError) {
+			errs = append(errs, fmt.Errorf("`%s` not handled by the value getter: %w", field, err))
+		}
+		if err := ev.SetFieldValue(field, value); errors.As(err, &notFoundError) {
+			errs = append(errs, fmt.Errorf("`%s` not handled by the value setter: %w", field, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
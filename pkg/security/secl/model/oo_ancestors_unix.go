@@ -0,0 +1,65 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build unix
+
+// Package model holds model related files
+package model
+
+import (
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// ancestorsFilePathContains replaces the evaluation of the comparison of a constant against the paths of the ancestors,
+// so that the ancestor chain is walked only until the first match instead of gathering the paths of all the ancestors.
+// The evaluator is kept as it is if the field uses a register, or if the value to compare isn't a constant.
+func ancestorsFilePathContains(evaluator *eval.BoolEvaluator, a *eval.StringEvaluator, b *eval.StringArrayEvaluator, state *eval.State) (*eval.BoolEvaluator, error) {
+	if evaluator.EvalFnc == nil || a.EvalFnc != nil || a.Field != "" || b.EvalFnc == nil || state.HasRegister("process.ancestors") {
+		return evaluator, nil
+	}
+
+	cmp := func(path string) bool {
+		return path == a.Value
+	}
+
+	matcher, err := a.ToStringMatcher(b.StringCmpOpts)
+	if err != nil {
+		return nil, err
+	}
+	if matcher != nil {
+		cmp = matcher.Matches
+	}
+
+	field := b.Field
+	evaluator.EvalFnc = func(ctx *eval.Context) bool {
+		ctx.AppendResolvedField(field)
+
+		// reuse the paths already gathered by another evaluation
+		if paths, ok := ctx.StringCache[field]; ok {
+			for _, path := range paths {
+				if cmp(path) {
+					return true
+				}
+			}
+			return false
+		}
+
+		ev := ctx.Event.(*Event)
+
+		iterator := &ProcessAncestorsIterator{}
+		for pce := iterator.Front(ctx); pce != nil; pce = iterator.Next() {
+			var path string
+			if pce.ProcessContext.Process.IsNotKworker() {
+				path = ev.FieldHandlers.ResolveFilePath(ev, &pce.ProcessContext.Process.FileEvent)
+			}
+			if cmp(path) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return evaluator, nil
+}
@@ -0,0 +1,159 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build unix
+
+// Package model holds model related files
+package model
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/ast"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// countingFieldHandlers counts the file path resolutions
+type countingFieldHandlers struct {
+	FakeFieldHandlers
+
+	resolved int
+}
+
+func (fh *countingFieldHandlers) ResolveFilePath(_ *Event, e *FileEvent) string {
+	fh.resolved++
+	return e.PathnameStr
+}
+
+// newAncestorsEvent returns an exec event whose ancestors have the given paths, the direct parent first
+func newAncestorsEvent(paths ...string) (*Event, *countingFieldHandlers) {
+	fh := &countingFieldHandlers{}
+
+	event := NewFakeEvent()
+	event.FieldHandlers = fh
+	event.Type = uint32(ExecEventType)
+	event.ProcessContext = &ProcessContext{}
+
+	var ancestor *ProcessCacheEntry
+	for i := len(paths) - 1; i >= 0; i-- {
+		entry := &ProcessCacheEntry{}
+		entry.Pid = uint32(i + 2)
+		entry.FileEvent.PathnameStr = paths[i]
+		entry.Ancestor = ancestor
+		ancestor = entry
+	}
+	event.ProcessContext.Ancestor = ancestor
+
+	return event, fh
+}
+
+func newAncestorsRule(tb testing.TB, expr string) *eval.Rule {
+	rule, err := eval.NewRule("id", expr, ast.NewParsingContext(false), &eval.Opts{})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err := rule.GenEvaluator(&Model{}); err != nil {
+		tb.Fatal(err)
+	}
+	return rule
+}
+
+func TestAncestorsFilePathShortCircuit(t *testing.T) {
+	paths := []string{"/usr/bin/bash", "/usr/bin/containerd-shim", "/usr/bin/containerd", "/sbin/init"}
+
+	tests := []struct {
+		name     string
+		expr     string
+		expected bool
+		resolved int
+	}{
+		{
+			name:     "early-match",
+			expr:     `process.ancestors.file.path == "/usr/bin/bash"`,
+			expected: true,
+			resolved: 1,
+		},
+		{
+			name:     "late-match",
+			expr:     `process.ancestors.file.path == "/sbin/init"`,
+			expected: true,
+			resolved: len(paths),
+		},
+		{
+			name:     "no-match",
+			expr:     `process.ancestors.file.path == "/usr/bin/dockerd"`,
+			expected: false,
+			resolved: len(paths),
+		},
+		{
+			name:     "glob-match",
+			expr:     `process.ancestors.file.path == ~"/usr/bin/containerd-*"`,
+			expected: true,
+			resolved: 2,
+		},
+		{
+			name:     "register",
+			expr:     `process.ancestors[A].file.path == "/usr/bin/containerd" && process.ancestors[A].pid == 4`,
+			expected: true,
+		},
+		{
+			name:     "register-mismatch",
+			expr:     `process.ancestors[A].file.path == "/usr/bin/containerd" && process.ancestors[A].pid == 2`,
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rule := newAncestorsRule(t, test.expr)
+
+			event, fh := newAncestorsEvent(paths...)
+			if result := rule.Eval(eval.NewContext(event)); result != test.expected {
+				t.Errorf("expected `%s` to be %v, got %v", test.expr, test.expected, result)
+			}
+			if test.resolved != 0 && fh.resolved != test.resolved {
+				t.Errorf("expected %d ancestor paths to be resolved, got %d", test.resolved, fh.resolved)
+			}
+		})
+	}
+
+	t.Run("serialization", func(t *testing.T) {
+		event, _ := newAncestorsEvent(paths...)
+
+		values, err := event.GetFieldValue("process.ancestors.file.path")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values.([]string)) != len(paths) {
+			t.Errorf("expected all the ancestor paths to be gathered, got %v", values)
+		}
+	})
+}
+
+func BenchmarkAncestorsFilePath(b *testing.B) {
+	paths := make([]string, 200)
+	for i := range paths {
+		paths[i] = fmt.Sprintf("/usr/bin/ancestor-%d", i)
+	}
+
+	for name, path := range map[string]string{"early-match": paths[0], "late-match": paths[len(paths)-1]} {
+		b.Run(name, func(b *testing.B) {
+			rule := newAncestorsRule(b, fmt.Sprintf(`process.ancestors.file.path == "%s"`, path))
+			event, _ := newAncestorsEvent(paths...)
+			ctx := eval.NewContext(event)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				ctx.Reset()
+				ctx.SetEvent(event)
+				if !rule.Eval(ctx) {
+					b.Fatal("should match")
+				}
+			}
+		})
+	}
+}
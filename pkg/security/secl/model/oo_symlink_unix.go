@@ -129,6 +129,10 @@ var (
 				return nil, err
 			}
 
+			if b.Field == "process.ancestors.file.path" {
+				return ancestorsFilePathContains(path, a, b, state)
+			}
+
 			// currently only override exec events
 			if a.Field == "exec.file.path" || a.Field == "process.file.path" {
 				se1, err := eval.GlobCmp.StringArrayContains(symlinkPathnameEvaluators[0](a.Field), b, state)
@@ -0,0 +1,55 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package model holds model related files
+package model
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+var (
+	// SECLPredicates set of predicates
+	SECLPredicates = map[string]eval.Predicate{
+		"has_env": func(args []string) (*eval.BoolEvaluator, error) {
+			if len(args) != 1 || args[0] == "" {
+				return nil, errors.New("expects the name of an environment variable")
+			}
+			return HasEnvEvaluator(args[0]), nil
+		},
+	}
+)
+
+// HasEnv returns whether the environment variable `name` is defined, envs being the resolved envs of the process
+func (p *Process) HasEnv(name string, envs []string) bool {
+	if p.EnvsEntry != nil {
+		return p.EnvsEntry.Has(name)
+	}
+
+	for _, env := range envs {
+		if k, _, _ := strings.Cut(env, "="); k == name {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEnvEvaluator returns an evaluator checking the presence of the environment variable `name` in `exec.envs`,
+// the evaluator of the `has_env(name)` predicate
+func HasEnvEvaluator(name string) *eval.BoolEvaluator {
+	return &eval.BoolEvaluator{
+		Field: "exec.envs",
+		EvalFnc: func(ctx *eval.Context) bool {
+			ctx.AppendResolvedField("exec.envs")
+			ev := ctx.Event.(*Event)
+			envs := ev.FieldHandlers.ResolveProcessEnvs(ev, ev.Exec.Process)
+			return ev.Exec.Process.HasEnv(name, envs)
+		},
+		Weight: eval.HandlerWeight,
+	}
+}
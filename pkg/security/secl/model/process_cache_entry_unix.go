@@ -72,6 +72,24 @@ func (pc *ProcessCacheEntry) Exit(exitTime time.Time) {
 	pc.ExitTime = exitTime
 }
 
+// CountFileEvent increments the file events count of the process if the given event type is an open, a rename or an
+// unlink, and its metadata change count if the event only changes the metadata of a file, like a chmod, a chown, a
+// utimes or a setxattr. The counts aren't inherited on fork nor kept across exec, the new entry starting back from zero.
+// The placeholder entries of the processes that couldn't be resolved, possibly shared by several processes, are never
+// counted.
+func (pc *ProcessCacheEntry) CountFileEvent(eventType EventType) {
+	if pc.Source == ProcessCacheEntryFromPlaceholder {
+		return
+	}
+
+	switch {
+	case eventType == FileOpenEventType || eventType == FileRenameEventType || eventType == FileUnlinkEventType:
+		pc.FileEventsCount++
+	case eventType.IsMetadataOnly():
+		pc.MetadataChangeCount++
+	}
+}
+
 func copyProcessContext(parent, child *ProcessCacheEntry) {
 	// inherit the container ID from the parent if necessary. If a container is already running when system-probe
 	// starts, the in-kernel process cache will have out of sync container ID values for the processes of that
@@ -117,6 +135,22 @@ func (pc *ProcessCacheEntry) Exec(entry *ProcessCacheEntry) {
 
 	// keep some context
 	copyProcessContext(pc, entry)
+
+	// the pid, and thus the namespaced pid, is kept across exec
+	if entry.NSPid == 0 {
+		entry.NSPid = pc.NSPid
+	}
+
+	// the namespaces are kept across exec
+	if entry.NSID == 0 {
+		entry.NSID = pc.NSID
+	}
+	if entry.MntNS == 0 {
+		entry.MntNS = pc.MntNS
+	}
+	if entry.NetNS == 0 {
+		entry.NetNS = pc.NetNS
+	}
 }
 
 // GetContainerPIDs return the pids
@@ -153,6 +187,8 @@ func (pc *ProcessCacheEntry) Fork(childEntry *ProcessCacheEntry) {
 	childEntry.PPid = pc.Pid
 	childEntry.TTYName = pc.TTYName
 	childEntry.Comm = pc.Comm
+	childEntry.Cwd = pc.Cwd
+	childEntry.CwdPathKey = pc.CwdPathKey
 	childEntry.FileEvent = pc.FileEvent
 	childEntry.ContainerID = pc.ContainerID
 	childEntry.CGroup = pc.CGroup
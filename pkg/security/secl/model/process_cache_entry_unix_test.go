@@ -108,3 +108,72 @@ func TestEntryEquals(t *testing.T) {
 	e1.ArgsEntry = &ArgsEntry{Values: []string{"aaa"}}
 	assert.True(t, e1.Equals(e2))
 }
+
+func TestCountFileEvent(t *testing.T) {
+	parent := NewProcessCacheEntry(nil)
+	for _, eventType := range []EventType{FileOpenEventType, FileRenameEventType, FileUnlinkEventType, FileMkdirEventType, ExecEventType} {
+		parent.CountFileEvent(eventType)
+	}
+	assert.Equal(t, 3, parent.FileEventsCount)
+	assert.Equal(t, 0, parent.MetadataChangeCount)
+
+	for _, eventType := range []EventType{FileChmodEventType, FileChownEventType, FileSetXAttrEventType, FileUtimesEventType, FileRemoveXAttrEventType} {
+		parent.CountFileEvent(eventType)
+	}
+	assert.Equal(t, 3, parent.FileEventsCount)
+	assert.Equal(t, 5, parent.MetadataChangeCount)
+
+	// not inherited on fork
+	child := NewProcessCacheEntry(nil)
+	parent.Fork(child)
+	assert.Equal(t, 0, child.FileEventsCount)
+	assert.Equal(t, 0, child.MetadataChangeCount)
+
+	// not kept across exec
+	exec := NewProcessCacheEntry(nil)
+	parent.Exec(exec)
+	assert.Equal(t, 0, exec.FileEventsCount)
+	assert.Equal(t, 0, exec.MetadataChangeCount)
+
+	// reset along with the entry
+	parent.Reset()
+	assert.Equal(t, 0, parent.FileEventsCount)
+	assert.Equal(t, 0, parent.MetadataChangeCount)
+}
+
+func TestCountFileEventPlaceholder(t *testing.T) {
+	// two unresolved processes sharing the placeholder entry
+	first := GetPlaceholderProcessCacheEntry(100, 100, false)
+	for _, eventType := range []EventType{FileOpenEventType, FileRenameEventType, FileChmodEventType} {
+		first.CountFileEvent(eventType)
+	}
+
+	second := GetPlaceholderProcessCacheEntry(200, 200, false)
+	assert.Equal(t, 0, second.FileEventsCount)
+	assert.Equal(t, 0, second.MetadataChangeCount)
+
+	// nor the placeholder entries allocated per event
+	entry := NewPlaceholderProcessCacheEntry(300, 300, false)
+	entry.CountFileEvent(FileUnlinkEventType)
+	entry.CountFileEvent(FileChownEventType)
+	assert.Equal(t, 0, entry.FileEventsCount)
+	assert.Equal(t, 0, entry.MetadataChangeCount)
+}
+
+func TestExecNamespaces(t *testing.T) {
+	parent := NewProcessCacheEntry(nil)
+	parent.NSID, parent.MntNS, parent.NetNS = 4026531836, 4026531841, 4026531840
+
+	// kept across exec
+	exec := NewProcessCacheEntry(nil)
+	parent.Exec(exec)
+	assert.Equal(t, uint64(4026531836), exec.NSID)
+	assert.Equal(t, uint64(4026531841), exec.MntNS)
+	assert.Equal(t, uint32(4026531840), exec.NetNS)
+
+	// unless already resolved for the new entry
+	exec = NewProcessCacheEntry(nil)
+	exec.MntNS = 4026532294
+	parent.Exec(exec)
+	assert.Equal(t, uint64(4026532294), exec.MntNS)
+}
@@ -0,0 +1,142 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+// Package model holds model related files
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// AssertRoundTrip reads each of the given fields, writes the value back to the event and reads it again, returning
+// an error if a value changed on the way
+func AssertRoundTrip(e *Event, fields []eval.Field) error {
+	for _, field := range fields {
+		before, err := e.GetFieldValue(field)
+		if err != nil {
+			return fmt.Errorf("failed to get `%s`: %w", field, err)
+		}
+		if err := e.SetFieldValue(field, before); err != nil {
+			return fmt.Errorf("failed to set `%s`: %w", field, err)
+		}
+		after, err := e.GetFieldValue(field)
+		if err != nil {
+			return fmt.Errorf("failed to get `%s` back: %w", field, err)
+		}
+		if !reflect.DeepEqual(before, after) {
+			return fmt.Errorf("`%s` changed from %v to %v", field, before, after)
+		}
+	}
+	return nil
+}
+
+func TestRoundTrip(t *testing.T) {
+	values := map[reflect.Kind]interface{}{
+		reflect.String: "aaa",
+		reflect.Int:    123,
+		reflect.Bool:   true,
+	}
+
+	// pick one settable scalar field of each kind per event type
+	fieldsByEventType := make(map[eval.EventType][]eval.Field)
+	kindsByEventType := make(map[eval.EventType]map[reflect.Kind]bool)
+
+	var readOnlyError *eval.ErrFieldReadOnly
+	event := NewFakeEvent()
+	fields := event.GetFields()
+	slices.Sort(fields)
+	for _, field := range fields {
+		eventType, kind, err := event.GetFieldMetadata(field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		value, ok := values[kind]
+		if !ok || eventType == "" || kindsByEventType[eventType][kind] || strings.Contains(field, ".ancestors.") {
+			continue
+		}
+
+		if err := event.SetFieldValue(field, value); err != nil {
+			if errors.As(err, &readOnlyError) {
+				continue
+			}
+			t.Fatalf("failed to set `%s`: %v", field, err)
+		}
+		if got, err := event.GetFieldValue(field); err != nil || !reflect.DeepEqual(got, value) {
+			// array fields or fields computed by a handler
+			continue
+		}
+
+		if kindsByEventType[eventType] == nil {
+			kindsByEventType[eventType] = make(map[reflect.Kind]bool)
+		}
+		kindsByEventType[eventType][kind] = true
+		fieldsByEventType[eventType] = append(fieldsByEventType[eventType], field)
+	}
+
+	tests := []struct {
+		name   string
+		event  func() *Event
+		fields []eval.Field
+	}{
+		{
+			name: "exec-args",
+			event: func() *Event {
+				event := NewFakeEvent()
+				event.Type = uint32(ExecEventType)
+				event.Exec.Process = &Process{
+					Args: "-la /tmp",
+					Argv: []string{"-la", "/tmp"},
+					Envs: []string{"PATH=/usr/bin", "HOME=/root"},
+				}
+				return event
+			},
+			fields: []eval.Field{"exec.args", "exec.argv", "exec.envs"},
+		},
+	}
+
+	for eventType, fields := range fieldsByEventType {
+		kind := EventType(0)
+		for i := EventType(0); i < MaxAllEventType; i++ {
+			if i.String() == eventType {
+				kind = i
+			}
+		}
+
+		tests = append(tests, struct {
+			name   string
+			event  func() *Event
+			fields []eval.Field
+		}{
+			name: eventType,
+			event: func() *Event {
+				event := NewFakeEvent()
+				event.Type = uint32(kind)
+				for _, field := range fields {
+					_, fieldKind, _ := event.GetFieldMetadata(field)
+					_ = event.SetFieldValue(field, values[fieldKind])
+				}
+				return event
+			},
+			fields: fields,
+		})
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := AssertRoundTrip(test.event(), test.fields); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -148,7 +148,7 @@ func (e *CapsetEvent) UnmarshalBinary(data []byte) (int, error) {
 
 // UnmarshalBinary unmarshalls a binary representation of itself
 func (e *Credentials) UnmarshalBinary(data []byte) (int, error) {
-	if len(data) < 48 {
+	if len(data) < 56 {
 		return 0, ErrNotEnoughData
 	}
 
@@ -164,7 +164,8 @@ func (e *Credentials) UnmarshalBinary(data []byte) (int, error) {
 	}
 	e.CapEffective = binary.NativeEndian.Uint64(data[32:40])
 	e.CapPermitted = binary.NativeEndian.Uint64(data[40:48])
-	return 48, nil
+	e.CapAmbient = binary.NativeEndian.Uint64(data[48:56])
+	return 56, nil
 }
 
 // UnmarshalBinary unmarshalls a binary representation of itself
@@ -228,7 +229,7 @@ func (e *Process) UnmarshalProcEntryBinary(data []byte) (int, error) {
 
 // UnmarshalPidCacheBinary unmarshalls Unmarshal pid_cache_t
 func (e *Process) UnmarshalPidCacheBinary(data []byte) (int, error) {
-	const size = 88
+	const size = 104
 	if len(data) < size {
 		return 0, ErrNotEnoughData
 	}
@@ -241,9 +242,7 @@ func (e *Process) UnmarshalPidCacheBinary(data []byte) (int, error) {
 		e.Cookie = cookie
 	}
 	e.PPid = binary.NativeEndian.Uint32(data[8:12])
-
-	// padding
-
+	e.NSPid = binary.NativeEndian.Uint32(data[12:16])
 	e.ForkTime = unmarshalTime(data[16:24])
 	e.ExitTime = unmarshalTime(data[24:32])
 	e.UserSession.ID = binary.NativeEndian.Uint64(data[32:40])
@@ -255,12 +254,17 @@ func (e *Process) UnmarshalPidCacheBinary(data []byte) (int, error) {
 	}
 	read += 40
 
+	// namespaces at exec, 0 when the kernel couldn't provide them
+	e.MntNS = uint64(binary.NativeEndian.Uint32(data[read : read+4]))
+	e.NSID = uint64(binary.NativeEndian.Uint32(data[read+4 : read+8]))
+	read += 8
+
 	return validateReadSize(size, read)
 }
 
 // UnmarshalBinary unmarshalls a binary representation of itself
 func (e *Process) UnmarshalBinary(data []byte) (int, error) {
-	const size = 288 // size of struct exec_event_t starting from process_entry_t, inclusive
+	const size = 320 // size of struct exec_event_t starting from process_entry_t, inclusive
 	if len(data) < size {
 		return 0, ErrNotEnoughData
 	}
@@ -292,6 +296,13 @@ func (e *Process) UnmarshalBinary(data []byte) (int, error) {
 		e.LinuxBinprm.FileEvent.PathKey = pathKey
 	}
 
+	// working directory part
+	n, err = e.CwdPathKey.UnmarshalBinary(data[read:])
+	if err != nil {
+		return 0, err
+	}
+	read += n
+
 	if len(data[read:]) < 24 {
 		return 0, ErrNotEnoughData
 	}
@@ -10,6 +10,7 @@ package model
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -102,3 +103,39 @@ func TestSyscallsEvent_UnmarshalBinary(t *testing.T) {
 		})
 	}
 }
+
+func TestPidCacheMarshalBinary(t *testing.T) {
+	process := Process{
+		PPid: 42,
+		Credentials: Credentials{
+			UID:          1000,
+			EUID:         1000,
+			CapEffective: 1 << 10,
+			CapPermitted: 1<<10 | 1<<13,
+			CapAmbient:   1 << 10,
+		},
+	}
+	process.NSPid = 1
+	process.MntNS = 4026531841
+	process.NSID = 4026531836
+
+	data := make([]byte, 104)
+	written, err := process.MarshalPidCache(data, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 104, written)
+
+	var result Process
+	read, err := result.UnmarshalPidCacheBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 104, read)
+	assert.Equal(t, process.PPid, result.PPid)
+	assert.True(t, process.Credentials.Equals(&result.Credentials))
+	assert.Equal(t, process.CapAmbient, result.CapAmbient)
+	assert.Equal(t, process.NSPid, result.NSPid)
+	assert.Equal(t, process.MntNS, result.MntNS)
+	assert.Equal(t, process.NSID, result.NSID)
+}
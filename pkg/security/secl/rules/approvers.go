@@ -9,6 +9,7 @@ package rules
 import (
 	"errors"
 	"math"
+	"reflect"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
 )
@@ -18,8 +19,10 @@ type Approvers map[eval.Field]FilterValues
 
 func partialEval(event eval.Event, ctx *eval.Context, rule *Rule, field eval.Field, value interface{}) (bool, error) {
 	var readOnlyError *eval.ErrFieldReadOnly
+	var outOfRangeError *eval.ErrValueOutOfRange
 	if err := event.SetFieldValue(field, value); err != nil {
-		if errors.As(err, &readOnlyError) {
+		// a value out of the range of the type of the field can't be matched
+		if errors.As(err, &readOnlyError) || errors.As(err, &outOfRangeError) {
 			return false, nil
 		}
 		return false, err
@@ -27,6 +30,35 @@ func partialEval(event eval.Event, ctx *eval.Context, rule *Rule, field eval.Fie
 	return rule.PartialEval(ctx, field)
 }
 
+// FieldIntKindGetter is implemented by the events reporting the Go type of their int fields
+type FieldIntKindGetter interface {
+	GetFieldIntKind(field eval.Field) reflect.Kind
+}
+
+// fitNotValue truncates the NOT of an int value to the width of the unsigned field it's compared to, the NOT of a
+// positive value being otherwise negative and then rejected by the field
+func fitNotValue(event eval.Event, field eval.Field, notValue interface{}) interface{} {
+	v, ok := notValue.(int)
+	if !ok {
+		return notValue
+	}
+
+	getter, ok := event.(FieldIntKindGetter)
+	if !ok {
+		return notValue
+	}
+
+	switch getter.GetFieldIntKind(field) {
+	case reflect.Uint8:
+		return v & math.MaxUint8
+	case reflect.Uint16:
+		return v & math.MaxUint16
+	case reflect.Uint32:
+		return v & math.MaxUint32
+	}
+	return notValue
+}
+
 func isAnIntLesserEqualThanApprover(event eval.Event, ctx *eval.Context, rule *Rule, fieldCap FieldCapability, value interface{}) (bool, interface{}, error) {
 	min := math.MinInt
 	if fieldCap.RangeFilterValue != nil {
@@ -140,6 +172,7 @@ func isAnApprover(event eval.Event, ctx *eval.Context, rule *Rule, fieldCap Fiel
 	if err != nil {
 		return false, fieldValueType, value, err
 	}
+	notValue = fitNotValue(event, fieldCap.Field, notValue)
 
 	result, err := isaaFnc(value, notValue)
 	if result || err != nil {
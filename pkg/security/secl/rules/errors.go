@@ -190,6 +190,17 @@ func (e *ErrFieldNotAvailable) Error() string {
 	return fmt.Sprintf("field `%s` not available for event type `%v`, available for `%v`", e.Field, e.EventType, e.RestrictedTo)
 }
 
+// ErrFieldMayBeEmpty is reported as a rule warning, not as an error, when a rule uses a field that may be empty for
+// its event type, like the process fields of the events not attributed to a process, the rule then never matching
+type ErrFieldMayBeEmpty struct {
+	Field     eval.Field
+	EventType eval.EventType
+}
+
+func (e *ErrFieldMayBeEmpty) Error() string {
+	return fmt.Sprintf("field `%s` may be empty for event type `%v`, the rule may never match", e.Field, e.EventType)
+}
+
 // ErrActionNotAvailable is returned when an action is not available
 type ErrActionNotAvailable struct {
 	ActionName string
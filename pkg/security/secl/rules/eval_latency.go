@@ -0,0 +1,100 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+// Package rules holds rules related files
+package rules
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+)
+
+// EvalLatencyBuckets defines the upper bounds of the buckets of the evaluation latency histograms. The latencies
+// above the last bound are counted in an additional bucket.
+var EvalLatencyBuckets = []time.Duration{
+	time.Microsecond,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+}
+
+// EvalLatencyHistogram holds the latencies of the evaluations of the events of a given type
+type EvalLatencyHistogram struct {
+	// Counts holds the number of evaluations per bucket of EvalLatencyBuckets, plus one for the latencies above
+	Counts []uint64
+	// Sum is the total time spent evaluating the events
+	Sum time.Duration
+}
+
+// Count returns the number of recorded evaluations
+func (h *EvalLatencyHistogram) Count() uint64 {
+	var count uint64
+	for _, c := range h.Counts {
+		count += c
+	}
+	return count
+}
+
+func (h *EvalLatencyHistogram) add(latency time.Duration) {
+	bucket := len(EvalLatencyBuckets)
+	for i, bound := range EvalLatencyBuckets {
+		if latency <= bound {
+			bucket = i
+			break
+		}
+	}
+	h.Counts[bucket]++
+	h.Sum += latency
+}
+
+// evalLatencyTelemetry records the latency of the evaluations, per event type
+type evalLatencyTelemetry struct {
+	enabled atomic.Bool
+
+	lock       sync.Mutex
+	histograms map[eval.EventType]*EvalLatencyHistogram
+}
+
+func (t *evalLatencyTelemetry) record(eventType eval.EventType, start time.Time) {
+	latency := time.Since(start)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.histograms == nil {
+		t.histograms = make(map[eval.EventType]*EvalLatencyHistogram)
+	}
+
+	histogram, exists := t.histograms[eventType]
+	if !exists {
+		histogram = &EvalLatencyHistogram{Counts: make([]uint64, len(EvalLatencyBuckets)+1)}
+		t.histograms[eventType] = histogram
+	}
+	histogram.add(latency)
+}
+
+func (t *evalLatencyTelemetry) getAndReset() map[eval.EventType]*EvalLatencyHistogram {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	histograms := t.histograms
+	t.histograms = nil
+	return histograms
+}
+
+// SetEvalLatencyTelemetry enables or disables the recording of the evaluation latencies. When disabled, the only
+// cost left on the evaluation path is an atomic load.
+func (rs *RuleSet) SetEvalLatencyTelemetry(enabled bool) {
+	rs.evalLatency.enabled.Store(enabled)
+}
+
+// GetAndResetEvalLatencies returns the evaluation latency histograms recorded since the last call, per event type
+func (rs *RuleSet) GetAndResetEvalLatencies() map[eval.EventType]*EvalLatencyHistogram {
+	return rs.evalLatency.getAndReset()
+}
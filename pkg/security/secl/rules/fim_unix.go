@@ -11,6 +11,9 @@ package rules
 import (
 	"fmt"
 	"strings"
+
+	"github.com/DataDog/datadog-agent/pkg/security/secl/compiler/eval"
+	"github.com/DataDog/datadog-agent/pkg/security/secl/model"
 )
 
 type expandedRule struct {
@@ -29,9 +32,9 @@ func expandFim(baseID, groupID, baseExpr string) []expandedRule {
 	}
 
 	var expandedRules []expandedRule
-	for _, eventType := range []string{"open", "chmod", "chown", "link", "rename", "unlink", "utimes"} {
+	for _, eventType := range []eval.EventType{model.EventOpen, model.EventChmod, model.EventChown, model.EventLink, model.EventRename, model.EventUnlink, model.EventUtimes} {
 		expr := strings.Replace(baseExpr, "fim.write.file.", fmt.Sprintf("%s.file.", eventType), -1)
-		if eventType == "open" {
+		if eventType == model.EventOpen {
 			expr = fmt.Sprintf("(%s) && open.flags & (O_CREAT|O_TRUNC|O_APPEND|O_RDWR|O_WRONLY) > 0", expr)
 		}
 
@@ -41,7 +44,7 @@ func expandFim(baseID, groupID, baseExpr string) []expandedRule {
 			expr: expr,
 		})
 
-		if eventType == "rename" {
+		if eventType == model.EventRename {
 			expr := strings.Replace(baseExpr, "fim.write.file.", "rename.file.destination.", -1)
 			id := fmt.Sprintf("__fim_expanded_%s_%s_%s", "rename_destination", groupID, baseID)
 			expandedRules = append(expandedRules, expandedRule{
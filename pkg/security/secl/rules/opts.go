@@ -103,6 +103,7 @@ func NewEvalOpts() *eval.Opts {
 	evalOpts.
 		WithConstants(model.SECLConstants()).
 		WithLegacyFields(model.SECLLegacyFields).
+		WithPredicates(model.SECLPredicates).
 		WithVariables(model.SECLVariables)
 
 	return &evalOpts
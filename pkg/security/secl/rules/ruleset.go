@@ -13,6 +13,7 @@ import (
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/spf13/cast"
 
@@ -30,6 +31,14 @@ type Rule struct {
 	*PolicyRule
 	*eval.Rule
 	NoDiscarder bool
+	// Warnings lists the issues found while compiling the rule that don't prevent it from being loaded
+	Warnings []error
+}
+
+// OptionalFieldsModel is implemented by the models knowing which fields may be empty depending on the type of the
+// events
+type OptionalFieldsModel interface {
+	IsFieldOptional(field eval.Field, eventType eval.EventType) bool
 }
 
 // RuleSetListener describes the methods implemented by an object used to be
@@ -63,6 +72,8 @@ type RuleSet struct {
 	// event collector, used for tests
 	eventCollector EventCollector
 
+	evalLatency evalLatencyTelemetry
+
 	OnDemandHookPoints []OnDemandHookPoint
 }
 
@@ -85,6 +96,43 @@ func (rs *RuleSet) GetOnDemandHookPoints() []OnDemandHookPoint {
 	return rs.OnDemandHookPoints
 }
 
+// AddBloomFilter registers a bloom filter usable by the rules with the `in_bloom` operator. The filter has to be
+// registered before the rules using it are added. Rules using it have to take into account that it can report false
+// positives: `in_bloom` is certain only when it is false.
+func (rs *RuleSet) AddBloomFilter(name string, filter *eval.BloomFilter) {
+	rs.evalOpts.AddBloomFilter(name, filter)
+}
+
+// AddGlobSet registers a set of glob patterns usable by the rules with the `in_globs` operator. The set has to be
+// registered before the rules using it are added.
+func (rs *RuleSet) AddGlobSet(name string, set *eval.GlobSet) {
+	rs.evalOpts.AddGlobSet(name, set)
+}
+
+// AddStringSet registers a hash set of strings usable by the rules with the `in_set` operator. The set has to be
+// registered before the rules using it are added.
+func (rs *RuleSet) AddStringSet(name string, set *eval.StringSet) {
+	rs.evalOpts.AddStringSet(name, set)
+}
+
+// FlushStringSetHits returns, for each string set registered on the rule set, the number of matches of each of its
+// entries since the last flush
+func (rs *RuleSet) FlushStringSetHits() map[string]map[string]uint64 {
+	hits := make(map[string]map[string]uint64)
+	for name, set := range rs.evalOpts.StringSets {
+		if setHits := set.FlushHits(); len(setHits) > 0 {
+			hits[name] = setHits
+		}
+	}
+	return hits
+}
+
+// AddGitIgnoreMatcher registers a matcher of gitignore patterns usable by the rules with the `in_gitignore` operator.
+// The matcher has to be registered before the rules using it are added.
+func (rs *RuleSet) AddGitIgnoreMatcher(name string, matcher *eval.GitIgnoreMatcher) {
+	rs.evalOpts.AddGitIgnoreMatcher(name, matcher)
+}
+
 // ListMacroIDs returns the list of MacroIDs from the ruleset
 func (rs *RuleSet) ListMacroIDs() []MacroID {
 	var ids []string
@@ -362,6 +410,15 @@ func (rs *RuleSet) innerAddExpandedRule(parsingContext *ast.ParsingContext, pRul
 		}
 	}
 
+	// warn about the fields that may be empty for the event type, the rule then silently never matching
+	if m, ok := rs.model.(OptionalFieldsModel); ok {
+		for _, field := range rule.GetFields() {
+			if m.IsFieldOptional(field, eventType) {
+				rule.Warnings = append(rule.Warnings, &ErrFieldMayBeEmpty{Field: field, EventType: eventType})
+			}
+		}
+	}
+
 	// ignore event types not supported
 	if _, exists := rs.opts.EventTypeEnabled["*"]; !exists {
 		if enabled, exists := rs.opts.EventTypeEnabled[eventType]; !exists || !enabled {
@@ -599,6 +656,10 @@ func (rs *RuleSet) Evaluate(event eval.Event) bool {
 		return false
 	}
 
+	if rs.evalLatency.enabled.Load() {
+		defer rs.evalLatency.record(eventType, time.Now())
+	}
+
 	// Since logger is an interface this call cannot be inlined, requiring to pass the trace call arguments
 	// through the heap. To improve this situation we first check if we actually need to call the function.
 	if rs.logger.IsTracing() {
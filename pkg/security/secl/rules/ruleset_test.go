@@ -9,8 +9,10 @@
 package rules
 
 import (
+	"errors"
 	"math"
 	"reflect"
+	"strconv"
 	"strings"
 	"syscall"
 	"testing"
@@ -1107,3 +1109,235 @@ func TestGetRuleEventType(t *testing.T) {
 		}
 	})
 }
+
+func TestRuleSetEvalLatencyTelemetry(t *testing.T) {
+	rs := newRuleSet()
+	AddTestRuleExpr(t, rs, `open.file.path == "/etc/passwd"`)
+
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.FileOpenEventType)
+	event.SetFieldValue("open.file.path", "/etc/passwd")
+
+	t.Run("enabled", func(t *testing.T) {
+		rs.SetEvalLatencyTelemetry(true)
+
+		if !rs.Evaluate(event) {
+			t.Fatal("expected the event to match")
+		}
+
+		latencies := rs.GetAndResetEvalLatencies()
+		histogram, exists := latencies["open"]
+		if !exists || histogram.Count() != 1 {
+			t.Fatalf("expected one `open` sample, got %+v", latencies)
+		}
+		if len(histogram.Counts) != len(EvalLatencyBuckets)+1 {
+			t.Errorf("unexpected number of buckets: %d", len(histogram.Counts))
+		}
+
+		if latencies := rs.GetAndResetEvalLatencies(); len(latencies) != 0 {
+			t.Errorf("expected the latencies to be reset, got %+v", latencies)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		rs.SetEvalLatencyTelemetry(false)
+
+		if !rs.Evaluate(event) {
+			t.Fatal("expected the event to match")
+		}
+
+		if latencies := rs.GetAndResetEvalLatencies(); len(latencies) != 0 {
+			t.Errorf("expected no sample, got %+v", latencies)
+		}
+	})
+}
+
+func TestFitNotValue(t *testing.T) {
+	event := model.NewFakeEvent()
+
+	for field, expected := range map[eval.Field]interface{}{
+		"bind.addr.family":           ^2 & math.MaxUint16,
+		"open.flags":                 ^2 & math.MaxUint32,
+		"chown.file.destination.uid": ^2,
+		"process.file.path":          ^2,
+	} {
+		if notValue := fitNotValue(event, field, ^2); notValue != expected {
+			t.Errorf("expected %v for `%s`, got %v", expected, field, notValue)
+		}
+	}
+
+	if notValue := fitNotValue(event, "process.file.path", "abc"); notValue != "abc" {
+		t.Errorf("expected the non int value to be left unchanged, got %v", notValue)
+	}
+}
+
+func TestRuleSetFieldMayBeEmptyWarning(t *testing.T) {
+	rs := newRuleSet()
+	AddTestRuleExpr(t, rs,
+		`dns.question.name == "example.com" && process.file.name == "curl"`,
+		`open.file.path == "/etc/passwd" && process.file.name == "curl" && container.id != ""`,
+		`dns.question.name == "example.com" && dns.question.type == A`,
+	)
+
+	rules := rs.GetRules()
+
+	warnings := rules["ID0"].Warnings
+	if len(warnings) != 1 {
+		t.Fatalf("expected one warning, got %v", warnings)
+	}
+	var fieldErr *ErrFieldMayBeEmpty
+	if !errors.As(warnings[0], &fieldErr) || fieldErr.Field != "process.file.name" || fieldErr.EventType != "dns" {
+		t.Errorf("unexpected warning: %v", warnings[0])
+	}
+
+	for _, id := range []string{"ID1", "ID2"} {
+		if warnings := rules[id].Warnings; len(warnings) != 0 {
+			t.Errorf("expected no warning for `%s`, got %v", id, warnings)
+		}
+	}
+}
+
+func TestRuleSetBloomFilter(t *testing.T) {
+	filter, err := eval.NewBloomFilter(1000, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 1000; i++ {
+		filter.Add("/usr/lib/known-" + strconv.Itoa(i))
+	}
+
+	rs := newRuleSet()
+	rs.AddBloomFilter("known_paths", filter)
+	AddTestRuleExpr(t, rs, `open.file.path =~ "/usr/lib/*" && not (open.file.path in_bloom "known_paths")`)
+
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.FileOpenEventType)
+
+	event.SetFieldValue("open.file.path", "/usr/lib/known-42")
+	if rs.Evaluate(event) {
+		t.Error("expected a known path not to match")
+	}
+
+	event.SetFieldValue("open.file.path", "/usr/lib/unknown")
+	if !rs.Evaluate(event) {
+		t.Error("expected an unknown path to match")
+	}
+
+	t.Run("not-registered", func(t *testing.T) {
+		rule := &PolicyRule{
+			Def: &RuleDefinition{ID: "unknown_filter", Expression: `open.file.path in_bloom "unknown"`},
+		}
+		if err := newRuleSet().AddRules(ast.NewParsingContext(false), []*PolicyRule{rule}); err == nil {
+			t.Error("expected an error for a bloom filter not registered")
+		}
+	})
+}
+
+func TestRuleSetGlobSet(t *testing.T) {
+	set := eval.NewGlobSet(false)
+	for _, pattern := range []string{"/etc/ssh/*_key", "/etc/shadow", "/root/.ssh/*"} {
+		if err := set.Add(pattern); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rs := newRuleSet()
+	rs.AddGlobSet("sensitive_files", set)
+	AddTestRuleExpr(t, rs, `open.file.path in_globs "sensitive_files"`)
+
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.FileOpenEventType)
+
+	for path, expected := range map[string]bool{
+		"/etc/ssh/ssh_host_rsa_key":  true,
+		"/etc/shadow":                true,
+		"/root/.ssh/authorized_keys": true,
+		"/etc/ssh/sshd_config":       false,
+		"/tmp/shadow":                false,
+	} {
+		event.SetFieldValue("open.file.path", path)
+		if rs.Evaluate(event) != expected {
+			t.Errorf("expected `%s` to match: %v", path, expected)
+		}
+	}
+
+	t.Run("not-registered", func(t *testing.T) {
+		rule := &PolicyRule{
+			Def: &RuleDefinition{ID: "unknown_set", Expression: `open.file.path in_globs "unknown"`},
+		}
+		if err := newRuleSet().AddRules(ast.NewParsingContext(false), []*PolicyRule{rule}); err == nil {
+			t.Error("expected an error for a glob set not registered")
+		}
+	})
+}
+
+func TestRuleSetGitIgnoreMatcher(t *testing.T) {
+	matcher := eval.NewGitIgnoreMatcher(false)
+	for _, pattern := range []string{"**/secrets/*", "!**/secrets/README", "/etc/ssh/"} {
+		if err := matcher.Add(pattern); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rs := newRuleSet()
+	rs.AddGitIgnoreMatcher("allowed_paths", matcher)
+	AddTestRuleExpr(t, rs, `open.file.path in_gitignore "allowed_paths"`)
+
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.FileOpenEventType)
+
+	for path, expected := range map[string]bool{
+		"/opt/app/secrets/token":    true,
+		"/opt/app/secrets/README":   false,
+		"/etc/ssh/sshd_config":      true,
+		"/etc/ssh/ssh_host_rsa_key": true,
+		"/var/lib/ssh/sshd_config":  false,
+	} {
+		event.SetFieldValue("open.file.path", path)
+		if rs.Evaluate(event) != expected {
+			t.Errorf("expected `%s` to match: %v", path, expected)
+		}
+	}
+
+	t.Run("not-registered", func(t *testing.T) {
+		rule := &PolicyRule{
+			Def: &RuleDefinition{ID: "unknown_matcher", Expression: `open.file.path in_gitignore "unknown"`},
+		}
+		if err := newRuleSet().AddRules(ast.NewParsingContext(false), []*PolicyRule{rule}); err == nil {
+			t.Error("expected an error for a gitignore matcher not registered")
+		}
+	})
+}
+
+func TestRuleSetStringSet(t *testing.T) {
+	set := eval.NewStringSet()
+	for i := 0; i != 1000; i++ {
+		set.Add("miner-" + strconv.Itoa(i))
+	}
+
+	rs := newRuleSet()
+	rs.AddStringSet("known_bad_comms", set)
+	AddTestRuleExpr(t, rs, `exec.comm in_set "known_bad_comms" && process.comm in_set "known_bad_comms"`)
+
+	event := model.NewFakeEvent()
+	event.Type = uint32(model.ExecEventType)
+
+	for comm, expected := range map[string]bool{
+		"miner-404": true,
+		"kworker":   false,
+	} {
+		event.SetFieldValue("exec.comm", comm)
+		event.SetFieldValue("process.comm", comm)
+		if rs.Evaluate(event) != expected {
+			t.Errorf("expected `%s` to match: %v", comm, expected)
+		}
+	}
+
+	hits := rs.FlushStringSetHits()
+	if len(hits) != 1 || hits["known_bad_comms"]["miner-404"] == 0 || len(hits["known_bad_comms"]) != 1 {
+		t.Errorf("unexpected hits: %v", hits)
+	}
+	if hits := rs.FlushStringSetHits(); len(hits) != 0 {
+		t.Errorf("expected the hits to be flushed, got %v", hits)
+	}
+}
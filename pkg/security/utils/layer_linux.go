@@ -0,0 +1,124 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+//go:build linux
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/moby/sys/mountinfo"
+
+	"github.com/DataDog/datadog-agent/pkg/util/kernel"
+)
+
+// GetOverlayLayerID returns the ID of the overlay layer providing the file at the given path, as seen by the given pid.
+// The file is looked up in the mount with the given ID, or in the mount holding its path if the mount ID is unknown.
+// An empty string is returned if the file doesn't reside on an overlay filesystem or if its layer can't be resolved.
+func GetOverlayLayerID(pid uint32, mountID uint32, pathname string) string {
+	if pathname == "" {
+		return ""
+	}
+
+	mounts, err := kernel.ParseMountInfoFile(int32(pid))
+	if err != nil {
+		return ""
+	}
+
+	mnt := findFileMount(mounts, mountID, pathname)
+	if mnt == nil || mnt.FSType != "overlay" {
+		return ""
+	}
+
+	rel, err := filepath.Rel(mnt.Mountpoint, pathname)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+
+	// the layer directories are host paths, look them up through the root of the host pid 1
+	return findOverlayLayerID(ProcRootPath(1), overlayLayerDirs(mnt.VFSOptions), filepath.Join(mnt.Root, rel))
+}
+
+// findFileMount returns the mount with the given ID, or the deepest mount holding the given path if the ID is unknown
+func findFileMount(mounts []*mountinfo.Info, mountID uint32, pathname string) *mountinfo.Info {
+	var found *mountinfo.Info
+	for _, mnt := range mounts {
+		if mountID != 0 {
+			if uint32(mnt.ID) == mountID {
+				return mnt
+			}
+			continue
+		}
+
+		if mnt.Mountpoint != "/" && pathname != mnt.Mountpoint && !strings.HasPrefix(pathname, mnt.Mountpoint+"/") {
+			continue
+		}
+		// the mounts are listed in mount order, later mounts shadow the previous ones
+		if found == nil || len(mnt.Mountpoint) >= len(found.Mountpoint) {
+			found = mnt
+		}
+	}
+	return found
+}
+
+// overlayLayerDirs returns the layer directories of an overlay mount from its super block options, from the topmost
+// (upper) to the bottommost layer
+func overlayLayerDirs(options string) []string {
+	var upper string
+	var lowers []string
+
+	for _, opt := range strings.Split(options, ",") {
+		name, value, _ := strings.Cut(opt, "=")
+		switch name {
+		case "upperdir":
+			upper = value
+		case "lowerdir":
+			lowers = strings.Split(value, ":")
+		}
+	}
+
+	if upper != "" {
+		return append([]string{upper}, lowers...)
+	}
+	return lowers
+}
+
+// findOverlayLayerID returns the ID of the topmost layer, looked up under root, holding the given path
+func findOverlayLayerID(root string, layers []string, pathname string) string {
+	for _, layer := range layers {
+		if layer == "" {
+			continue
+		}
+
+		if _, err := os.Lstat(filepath.Join(root, layer, pathname)); err != nil {
+			continue
+		}
+
+		// lower layers are usually short symlinks to the real layer directory
+		dir := filepath.Join(root, layer)
+		if resolved, err := filepath.EvalSymlinks(dir); err == nil {
+			dir = resolved
+		}
+		return layerIDFromDir(dir)
+	}
+	return ""
+}
+
+// layerIDFromDir returns the ID of a layer from its directory, the content of overlay2 and containerd layers being
+// stored in a `diff` or `fs` sub-directory of the layer directory
+func layerIDFromDir(dir string) string {
+	switch filepath.Base(dir) {
+	case "diff", "fs":
+		dir = filepath.Dir(dir)
+	}
+
+	if id := filepath.Base(dir); id != "/" && id != "." {
+		return id
+	}
+	return ""
+}
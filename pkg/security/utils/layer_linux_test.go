@@ -0,0 +1,77 @@
+// Unless explicitly stated otherwise all files in this repository are licensed
+// under the Apache License Version 2.0.
+// This product includes software developed at Datadog (https://www.datadoghq.com/).
+// Copyright 2016-present Datadog, Inc.
+
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/moby/sys/mountinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayLayerDirs(t *testing.T) {
+	assert.Equal(t, []string{"/upper", "/lower1", "/lower2"}, overlayLayerDirs("rw,lowerdir=/lower1:/lower2,upperdir=/upper,workdir=/work"))
+	assert.Equal(t, []string{"/lower1"}, overlayLayerDirs("ro,lowerdir=/lower1"))
+	assert.Empty(t, overlayLayerDirs("rw,relatime"))
+}
+
+func TestFindFileMount(t *testing.T) {
+	mounts := []*mountinfo.Info{
+		{ID: 10, Mountpoint: "/"},
+		{ID: 11, Mountpoint: "/usr"},
+		{ID: 12, Mountpoint: "/usr/local"},
+	}
+
+	assert.Equal(t, 11, findFileMount(mounts, 11, "/usr/local/bin/app").ID)
+	assert.Equal(t, 12, findFileMount(mounts, 0, "/usr/local/bin/app").ID)
+	assert.Equal(t, 11, findFileMount(mounts, 0, "/usr/bin/app").ID)
+	assert.Equal(t, 10, findFileMount(mounts, 0, "/usrbin/app").ID)
+	assert.Nil(t, findFileMount(mounts, 42, "/usr/bin/app"))
+}
+
+func TestFindOverlayLayerID(t *testing.T) {
+	root := t.TempDir()
+
+	// docker overlay2 layout, with the lower layers referenced through their short links
+	for _, layer := range []string{"upper-id", "middle-id", "bottom-id"} {
+		if err := os.MkdirAll(filepath.Join(root, "overlay2", layer, "diff", "usr", "bin"), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.MkdirAll(filepath.Join(root, "overlay2", "l"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for link, layer := range map[string]string{"MIDDLE": "middle-id", "BOTTOM": "bottom-id"} {
+		if err := os.Symlink(filepath.Join("..", layer, "diff"), filepath.Join(root, "overlay2", "l", link)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for pathname, layer := range map[string]string{"/usr/bin/app": "middle-id", "/usr/bin/base": "bottom-id", "/usr/bin/shadowed": "bottom-id"} {
+		if err := os.WriteFile(filepath.Join(root, "overlay2", layer, "diff", pathname), nil, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(root, "overlay2", "upper-id", "diff", "usr", "bin", "shadowed"), nil, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	layers := []string{"/overlay2/upper-id/diff", "/overlay2/l/MIDDLE", "/overlay2/l/BOTTOM"}
+	assert.Equal(t, "middle-id", findOverlayLayerID(root, layers, "/usr/bin/app"))
+	assert.Equal(t, "bottom-id", findOverlayLayerID(root, layers, "/usr/bin/base"))
+	assert.Equal(t, "upper-id", findOverlayLayerID(root, layers, "/usr/bin/shadowed"))
+	assert.Equal(t, "", findOverlayLayerID(root, layers, "/usr/bin/missing"))
+
+	// containerd snapshotter layout
+	if err := os.MkdirAll(filepath.Join(root, "snapshots", "42", "fs", "bin"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "snapshots", "42", "fs", "bin", "sh"), nil, 0755); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "42", findOverlayLayerID(root, []string{"/snapshots/42/fs"}, "/bin/sh"))
+}
@@ -7,9 +7,12 @@ package utils
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
 	"slices"
 	"strings"
+	"syscall"
 
 	"github.com/DataDog/datadog-agent/pkg/security/secl/rules"
 )
@@ -329,3 +332,34 @@ func CheckForPatterns(path string) string {
 	}
 	return fmt.Sprintf("\"%s\"", strings.Join(subpaths, "/"))
 }
+
+// maxSymlinks is the maximum number of symlinks followed when resolving a path, like the MAXSYMLINKS of the kernel
+const maxSymlinks = 40
+
+// ResolveSymlinks returns the given absolute path with the symlinks of its last component resolved, the path and the
+// absolute targets of the symlinks being looked up from the given root, like the root directory of a process in /proc
+func ResolveSymlinks(root string, path string) (string, error) {
+	for i := 0; i < maxSymlinks; i++ {
+		rootedPath := filepath.Join(root, path)
+
+		info, err := os.Lstat(rootedPath)
+		if err != nil {
+			return "", err
+		}
+		if info.Mode()&os.ModeSymlink == 0 {
+			return path, nil
+		}
+
+		target, err := os.Readlink(rootedPath)
+		if err != nil {
+			return "", err
+		}
+		if filepath.IsAbs(target) {
+			path = filepath.Clean(target)
+		} else {
+			path = filepath.Join(filepath.Dir(path), target)
+		}
+	}
+
+	return "", syscall.ELOOP
+}
@@ -6,6 +6,9 @@
 package utils
 
 import (
+	"os"
+	"path/filepath"
+	"syscall"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -532,3 +535,53 @@ func BenchmarkPathPatternMatch(b *testing.B) {
 		}
 	})
 }
+
+func TestResolveSymlinks(t *testing.T) {
+	root := t.TempDir()
+
+	if err := os.MkdirAll(filepath.Join(root, "etc"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(root, "etc/passwd"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	symlinks := map[string]string{
+		"absolute": "/etc/passwd",
+		"relative": "etc/passwd",
+		"chained":  "/absolute",
+		"loop1":    "/loop2",
+		"loop2":    "/loop1",
+	}
+	for name, target := range symlinks {
+		if err := os.Symlink(target, filepath.Join(root, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		Path     string
+		Expected string
+		Error    error
+	}{
+		{Path: "/etc/passwd", Expected: "/etc/passwd"},
+		{Path: "/absolute", Expected: "/etc/passwd"},
+		{Path: "/relative", Expected: "/etc/passwd"},
+		{Path: "/chained", Expected: "/etc/passwd"},
+		{Path: "/loop1", Error: syscall.ELOOP},
+		{Path: "/missing", Error: os.ErrNotExist},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Path, func(t *testing.T) {
+			resolved, err := ResolveSymlinks(root, test.Path)
+			if test.Error != nil {
+				assert.ErrorIs(t, err, test.Error)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.Expected, resolved)
+		})
+	}
+}
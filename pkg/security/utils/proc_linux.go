@@ -105,6 +105,11 @@ func ProcExePath(pid uint32) string {
 	return procPidPath(pid, "exe")
 }
 
+// ProcCwdPath returns the path to the cwd link of a pid in /proc
+func ProcCwdPath(pid uint32) string {
+	return procPidPath(pid, "cwd")
+}
+
 // StatusPath returns the path to the status file of a pid in /proc
 func StatusPath(pid uint32) string {
 	return procPidPath(pid, "status")
@@ -180,10 +185,16 @@ func GetLoginUID(pid uint32) (uint32, error) {
 
 // CapEffCapEprm returns the effective and permitted kernel capabilities of a process
 func CapEffCapEprm(pid uint32) (uint64, uint64, error) {
-	var capEff, capPrm uint64
+	capEff, capPrm, _, err := CapEffCapEprmCapAmb(pid)
+	return capEff, capPrm, err
+}
+
+// CapEffCapEprmCapAmb returns the effective, permitted and ambient kernel capabilities of a process
+func CapEffCapEprmCapAmb(pid uint32) (uint64, uint64, uint64, error) {
+	var capEff, capPrm, capAmb uint64
 	contents, err := os.ReadFile(StatusPath(uint32(pid)))
 	if err != nil {
-		return 0, 0, err
+		return 0, 0, 0, err
 	}
 	lines := strings.Split(string(contents), "\n")
 	for _, line := range lines {
@@ -196,16 +207,21 @@ func CapEffCapEprm(pid uint32) (uint64, uint64, error) {
 		case "CapEff":
 			capEff, err = strconv.ParseUint(value, 16, 64)
 			if err != nil {
-				return 0, 0, err
+				return 0, 0, 0, err
 			}
 		case "CapPrm":
 			capPrm, err = strconv.ParseUint(value, 16, 64)
 			if err != nil {
-				return 0, 0, err
+				return 0, 0, 0, err
+			}
+		case "CapAmb":
+			capAmb, err = strconv.ParseUint(value, 16, 64)
+			if err != nil {
+				return 0, 0, 0, err
 			}
 		}
 	}
-	return capEff, capPrm, nil
+	return capEff, capPrm, capAmb, nil
 }
 
 // PidTTY returns the TTY of the given pid
@@ -398,22 +414,31 @@ func FetchLoadedModules() (map[string]ProcFSModule, error) {
 
 // GetProcessPidNamespace returns the PID namespace of the given PID
 func GetProcessPidNamespace(pid uint32) (uint64, error) {
-	nspidPath := procPidPath(pid, "ns/pid")
-	link, err := os.Readlink(nspidPath)
+	return getProcessNamespace(pid, "pid")
+}
+
+// GetProcessMntNamespace returns the mount namespace of the given PID
+func GetProcessMntNamespace(pid uint32) (uint64, error) {
+	return getProcessNamespace(pid, "mnt")
+}
+
+func getProcessNamespace(pid uint32, nsType string) (uint64, error) {
+	link, err := os.Readlink(procPidPath(pid, "ns/"+nsType))
 	if err != nil {
 		return 0, err
 	}
 	// link should be in for of: pid:[4026532294]
-	if !strings.HasPrefix(link, "pid:[") {
-		return 0, fmt.Errorf("Failed to retrieve PID NS, pid ns malformated: (%s) err: %v", link, err)
+	prefix := nsType + ":["
+	if !strings.HasPrefix(link, prefix) {
+		return 0, fmt.Errorf("Failed to retrieve %s NS, %s ns malformated: (%s) err: %v", nsType, nsType, link, err)
 	}
 
-	link = strings.TrimPrefix(link, "pid:[")
+	link = strings.TrimPrefix(link, prefix)
 	link = strings.TrimSuffix(link, "]")
 
 	ns, err := strconv.ParseUint(link, 10, 64)
 	if err != nil {
-		return 0, fmt.Errorf("Failed to retrieve PID NS, pid ns malformated: (%s) err: %v", link, err)
+		return 0, fmt.Errorf("Failed to retrieve %s NS, %s ns malformated: (%s) err: %v", nsType, nsType, link, err)
 	}
 	return ns, nil
 }